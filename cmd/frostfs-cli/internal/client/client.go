@@ -3,12 +3,23 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"io"
-
+	"strconv"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/acl"
+	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/refs"
+	rpcapi "github.com/TrueCloudLab/frostfs-api-go/v2/rpc"
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	v2session "github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/signature"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-sdk-go/accounting"
 	"github.com/TrueCloudLab/frostfs-sdk-go/client"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
 	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/eacl"
@@ -639,6 +650,11 @@ type SearchObjectsPrm struct {
 	containerIDPrm
 
 	filters object.SearchFilters
+
+	key *ecdsa.PrivateKey
+
+	cursor *oid.ID
+	limit  uint32
 }
 
 // SetFilters sets search filters.
@@ -646,6 +662,23 @@ func (x *SearchObjectsPrm) SetFilters(filters object.SearchFilters) {
 	x.filters = filters
 }
 
+// SetPrivateKey sets the key to sign the request with.
+//
+// Required if the filters contain numeric match types.
+func (x *SearchObjectsPrm) SetPrivateKey(key *ecdsa.PrivateKey) {
+	x.key = key
+}
+
+// SetCursor sets the identifier of the last object from the previous page.
+func (x *SearchObjectsPrm) SetCursor(cursor *oid.ID) {
+	x.cursor = cursor
+}
+
+// SetLimit sets the maximum number of the returned objects.
+func (x *SearchObjectsPrm) SetLimit(limit uint32) {
+	x.limit = limit
+}
+
 // SearchObjectsRes groups the resulting values of SearchObjects operation.
 type SearchObjectsRes struct {
 	ids []oid.ID
@@ -660,6 +693,21 @@ func (x SearchObjectsRes) IDList() []oid.ID {
 //
 // Returns any error which prevented the operation from completing correctly in error return.
 func SearchObjects(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
+	if prm.cursor != nil {
+		prm.xHeaders = append(prm.xHeaders, objectcore.XHeaderSearchCursor, prm.cursor.EncodeToString())
+	}
+
+	if prm.limit != 0 {
+		prm.xHeaders = append(prm.xHeaders, objectcore.XHeaderSearchLimit, strconv.FormatUint(uint64(prm.limit), 10))
+	}
+
+	for i := range prm.filters {
+		if objectcore.IsNumericMatch(prm.filters[i].Operation()) {
+			// SDK does not support numeric match types, so the request is sent directly.
+			return searchObjectsRaw(prm)
+		}
+	}
+
 	var cliPrm client.PrmObjectSearch
 	cliPrm.InContainer(prm.cnrID)
 	cliPrm.SetFilters(prm.filters)
@@ -708,6 +756,102 @@ func SearchObjects(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
 	}, nil
 }
 
+func searchObjectsRaw(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
+	if prm.key == nil {
+		return nil, errors.New("private key is required for the numeric search filters")
+	}
+
+	var cidV2 refs.ContainerID
+	prm.cnrID.WriteToV2(&cidV2)
+
+	var body v2object.SearchRequestBody
+	body.SetVersion(1)
+	body.SetContainerID(&cidV2)
+	body.SetFilters(objectcore.SearchFiltersToV2(prm.filters))
+
+	var meta v2session.RequestMetaHeader
+	meta.SetTTL(2)
+	if prm.local {
+		meta.SetTTL(1)
+	}
+
+	var ver refs.Version
+	version.Current().WriteToV2(&ver)
+	meta.SetVersion(&ver)
+
+	if prm.sessionToken != nil {
+		var tok v2session.Token
+		prm.sessionToken.WriteToV2(&tok)
+		meta.SetSessionToken(&tok)
+	}
+
+	if prm.bearerToken != nil {
+		var tok acl.BearerToken
+		prm.bearerToken.WriteToV2(&tok)
+		meta.SetBearerToken(&tok)
+	}
+
+	if len(prm.xHeaders) != 0 {
+		hs := make([]v2session.XHeader, len(prm.xHeaders)/2)
+		for i := range hs {
+			hs[i].SetKey(prm.xHeaders[2*i])
+			hs[i].SetValue(prm.xHeaders[2*i+1])
+		}
+		meta.SetXHeaders(hs)
+	}
+
+	var req v2object.SearchRequest
+	req.SetBody(&body)
+	req.SetMetaHeader(&meta)
+
+	if err := signature.SignServiceMessage(prm.key, &req); err != nil {
+		return nil, fmt.Errorf("sign request: %w", err)
+	}
+
+	var list []oid.ID
+
+	err := prm.cli.ExecRaw(func(c *rawclient.Client) error {
+		stream, err := rpcapi.SearchObjects(c, &req)
+		if err != nil {
+			return fmt.Errorf("open stream: %w", err)
+		}
+
+		for {
+			var resp v2object.SearchResponse
+			if err := stream.Read(&resp); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return fmt.Errorf("read response: %w", err)
+			}
+
+			if err := signature.VerifyServiceMessage(&resp); err != nil {
+				return fmt.Errorf("invalid response signature: %w", err)
+			}
+
+			if err := apistatus.ErrFromStatus(apistatus.FromStatusV2(resp.GetMetaHeader().GetStatus())); err != nil {
+				return err
+			}
+
+			ids := resp.GetBody().GetIDList()
+			for i := range ids {
+				var id oid.ID
+				if err := id.ReadFromV2(ids[i]); err != nil {
+					return fmt.Errorf("invalid object ID in response: %w", err)
+				}
+				list = append(list, id)
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read object list: %w", err)
+	}
+
+	return &SearchObjectsRes{
+		ids: list,
+	}, nil
+}
+
 // HashPayloadRangesPrm groups parameters of HashPayloadRanges operation.
 type HashPayloadRangesPrm struct {
 	commonObjectPrm
@@ -50,6 +50,7 @@ var listContainerObjectsCmd = &cobra.Command{
 
 		prmSearch.SetContainerID(id)
 		prmSearch.SetFilters(*filters)
+		objectCli.ReadPagination(cmd, &prmSearch)
 
 		res, err := internalclient.SearchObjects(prmSearch)
 		commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
@@ -80,6 +81,8 @@ var listContainerObjectsCmd = &cobra.Command{
 				}
 			}
 		}
+
+		objectCli.PrintNextCursor(cmd, objectIDs)
 	},
 }
 
@@ -93,4 +96,6 @@ func initContainerListObjectsCmd() {
 	flags.BoolVar(&flagVarListObjectsPrintAttr, flagListObjectPrintAttr, false,
 		"Request and print user attributes of each object",
 	)
+
+	objectCli.InitPagination(listContainerObjectsCmd)
 }
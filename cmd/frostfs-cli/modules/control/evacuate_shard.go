@@ -1,26 +1,52 @@
 package control
 
 import (
+	"time"
+
 	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
 	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
 	"github.com/spf13/cobra"
 )
 
 var evacuateShardCmd = &cobra.Command{
 	Use:   "evacuate",
 	Short: "Evacuate objects from shard",
-	Long:  "Evacuate objects from shard to other shards",
-	Run:   evacuateShard,
+	Long: `Evacuate objects from shard to other shards.
+Objects which can't be put to other local shards (e.g. on a single-shard node)
+are replicated to other container nodes unless --local-only flag is set.`,
+	Run: evacuateShard,
+}
+
+var evacuationStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get status of the shard evacuation",
+	Long:  "Get status and per-shard counters of the last shard evacuation job",
+	Run:   evacuationStatus,
 }
 
+var stopEvacuationCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the shard evacuation",
+	Long:  "Stop the running shard evacuation job, the next evacuation continues from the place it was stopped at",
+	Run:   stopEvacuation,
+}
+
+const (
+	evacuateAsyncFlag     = "async"
+	evacuateLocalOnlyFlag = "local-only"
+)
+
 func evacuateShard(cmd *cobra.Command, _ []string) {
 	pk := key.Get(cmd)
 
 	req := &control.EvacuateShardRequest{Body: new(control.EvacuateShardRequest_Body)}
 	req.Body.Shard_ID = getShardIDList(cmd)
 	req.Body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+	req.Body.Async, _ = cmd.Flags().GetBool(evacuateAsyncFlag)
+	req.Body.LocalOnly, _ = cmd.Flags().GetBool(evacuateLocalOnlyFlag)
 
 	signRequest(cmd, pk, req)
 
@@ -34,11 +60,87 @@ func evacuateShard(cmd *cobra.Command, _ []string) {
 	})
 	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
 
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	if req.Body.Async {
+		cmd.Printf("Shard evacuation has been started, job ID: %s\n", resp.GetBody().GetJob_ID())
+		return
+	}
+
 	cmd.Printf("Objects moved: %d\n", resp.GetBody().GetCount())
+	cmd.Println("Shard has successfully been evacuated.")
+}
+
+func evacuationStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetEvacuationStatusRequest{Body: new(control.GetEvacuationStatusRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetEvacuationStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.GetEvacuationStatus(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
 
 	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
 
-	cmd.Println("Shard has successfully been evacuated.")
+	body := resp.GetBody()
+	cmd.Printf("Job ID: %s\n", body.GetJob_ID())
+	cmd.Printf("Status: %s\n", evacuationStatusToString(body.GetStatus()))
+	cmd.Printf("Started at: %s\n", time.Unix(body.GetStartedAt(), 0).Format(time.RFC3339))
+	if ts := body.GetFinishedAt(); ts != 0 {
+		cmd.Printf("Finished at: %s\n", time.Unix(ts, 0).Format(time.RFC3339))
+	}
+	if msg := body.GetError(); msg != "" {
+		cmd.Printf("Error: %s\n", msg)
+	}
+	for _, sh := range body.GetShards() {
+		cmd.Printf("Shard %s: evacuated %d (replicated to other nodes %d), failed %d, skipped %d\n",
+			base58.Encode(sh.GetShard_ID()), sh.GetEvacuated(), sh.GetReplicated(), sh.GetFailed(), sh.GetSkipped())
+	}
+}
+
+func stopEvacuation(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StopEvacuationRequest{Body: new(control.StopEvacuationRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StopEvacuationResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StopEvacuation(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard evacuation has been stopped.")
+}
+
+func evacuationStatusToString(s control.EvacuationStatus) string {
+	switch s {
+	case control.EvacuationStatus_EVACUATION_RUNNING:
+		return "running"
+	case control.EvacuationStatus_EVACUATION_COMPLETED:
+		return "completed"
+	case control.EvacuationStatus_EVACUATION_FAILED:
+		return "failed"
+	case control.EvacuationStatus_EVACUATION_STOPPED:
+		return "stopped"
+	default:
+		return "undefined"
+	}
 }
 
 func initControlEvacuateShardCmd() {
@@ -48,6 +150,14 @@ func initControlEvacuateShardCmd() {
 	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
 	flags.Bool(shardAllFlag, false, "Process all shards")
 	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Bool(evacuateAsyncFlag, false, "Start evacuation in the background and print job ID")
+	flags.Bool(evacuateLocalOnlyFlag, false, "Do not replicate objects which can't be moved to other local shards to other container nodes")
 
 	evacuateShardCmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+
+	initControlFlags(evacuationStatusCmd)
+	initControlFlags(stopEvacuationCmd)
+
+	evacuateShardCmd.AddCommand(evacuationStatusCmd)
+	evacuateShardCmd.AddCommand(stopEvacuationCmd)
 }
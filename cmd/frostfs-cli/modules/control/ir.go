@@ -0,0 +1,32 @@
+package control
+
+import (
+	"crypto/ecdsa"
+
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	ircontrolsrv "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir/server"
+	"github.com/spf13/cobra"
+)
+
+var irCmd = &cobra.Command{
+	Use:   "ir",
+	Short: "Operations with inner ring nodes",
+	Long:  "Operations with inner ring nodes",
+}
+
+func initControlIRCmd() {
+	irCmd.AddCommand(tickEpochCmd)
+	irCmd.AddCommand(removeNodeCmd)
+	irCmd.AddCommand(listNotaryRequestsCmd)
+	irCmd.AddCommand(listProcessorQueuesCmd)
+
+	initControlIRTickEpochCmd()
+	initControlIRRemoveNodeCmd()
+	initControlIRListNotaryRequestsCmd()
+	initControlIRListProcessorQueuesCmd()
+}
+
+func signIRRequest(cmd *cobra.Command, pk *ecdsa.PrivateKey, req ircontrolsrv.SignedMessage) {
+	err := ircontrolsrv.SignMessage(pk, req)
+	commonCmd.ExitOnErr(cmd, "could not sign request: %w", err)
+}
@@ -0,0 +1,56 @@
+package control
+
+import (
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	ircontrol "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+var listNotaryRequestsCmd = &cobra.Command{
+	Use:   "notary-requests",
+	Short: "List pending notary requests",
+	Long:  "List notary requests received by the IR node and pending in the sidechain notary pool",
+	Run:   listNotaryRequests,
+}
+
+func initControlIRListNotaryRequestsCmd() {
+	initControlFlags(listNotaryRequestsCmd)
+}
+
+func listNotaryRequests(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	c := getClient(cmd, pk)
+
+	req := new(ircontrol.ListNotaryRequestsRequest)
+	req.SetBody(new(ircontrol.ListNotaryRequestsRequest_Body))
+
+	signIRRequest(cmd, pk, req)
+
+	var resp *ircontrol.ListNotaryRequestsResponse
+	var err error
+	err = c.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = ircontrol.ListNotaryRequests(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	for _, r := range resp.GetBody().GetRequests() {
+		mainHash, err := util.Uint256DecodeBytesBE(r.GetMainHash())
+		commonCmd.ExitOnErr(cmd, "invalid main transaction hash: %w", err)
+
+		fallbackHash, err := util.Uint256DecodeBytesBE(r.GetFallbackHash())
+		commonCmd.ExitOnErr(cmd, "invalid fallback transaction hash: %w", err)
+
+		sender, err := util.Uint160DecodeBytesBE(r.GetSender())
+		commonCmd.ExitOnErr(cmd, "invalid sender: %w", err)
+
+		cmd.Printf("Main: %s, fallback: %s, sender: %s, valid until block: %d\n",
+			mainHash.StringLE(), fallbackHash.StringLE(), address.Uint160ToString(sender), r.GetValidUntilBlock())
+	}
+}
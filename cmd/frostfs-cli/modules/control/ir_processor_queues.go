@@ -0,0 +1,44 @@
+package control
+
+import (
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	ircontrol "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	"github.com/spf13/cobra"
+)
+
+var listProcessorQueuesCmd = &cobra.Command{
+	Use:   "processor-queues",
+	Short: "List event processor queues",
+	Long:  "List worker pool states of the IR event processors",
+	Run:   listProcessorQueues,
+}
+
+func initControlIRListProcessorQueuesCmd() {
+	initControlFlags(listProcessorQueuesCmd)
+}
+
+func listProcessorQueues(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	c := getClient(cmd, pk)
+
+	req := new(ircontrol.ListProcessorQueuesRequest)
+	req.SetBody(new(ircontrol.ListProcessorQueuesRequest_Body))
+
+	signIRRequest(cmd, pk, req)
+
+	var resp *ircontrol.ListProcessorQueuesResponse
+	var err error
+	err = c.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = ircontrol.ListProcessorQueues(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	for _, q := range resp.GetBody().GetQueues() {
+		cmd.Printf("%s: %d/%d workers busy\n", q.GetName(), q.GetRunning(), q.GetCapacity())
+	}
+}
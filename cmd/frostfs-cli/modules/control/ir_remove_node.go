@@ -0,0 +1,61 @@
+package control
+
+import (
+	"encoding/hex"
+	"errors"
+
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	ircontrol "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	"github.com/spf13/cobra"
+)
+
+const removeNodeKeyFlag = "node"
+
+var removeNodeCmd = &cobra.Command{
+	Use:   "remove-node",
+	Short: "Forces a node removal from the network map",
+	Long: `Forces a storage node removal from the network map to be signaled by the IR node.
+The node is removed when the majority of the alphabet nodes have signaled it.`,
+	Run: removeNode,
+}
+
+func initControlIRRemoveNodeCmd() {
+	initControlFlags(removeNodeCmd)
+
+	flags := removeNodeCmd.Flags()
+	flags.String(removeNodeKeyFlag, "", "Hex-encoded public key of the storage node to remove")
+
+	_ = removeNodeCmd.MarkFlagRequired(removeNodeKeyFlag)
+}
+
+func removeNode(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	c := getClient(cmd, pk)
+
+	nodeKeyStr, _ := cmd.Flags().GetString(removeNodeKeyFlag)
+	if len(nodeKeyStr) == 0 {
+		commonCmd.ExitOnErr(cmd, "", errors.New("node public key must be specified"))
+	}
+
+	nodeKey, err := hex.DecodeString(nodeKeyStr)
+	commonCmd.ExitOnErr(cmd, "can't decode node public key: %w", err)
+
+	req := new(ircontrol.RemoveNodeRequest)
+	req.SetBody(new(ircontrol.RemoveNodeRequest_Body))
+	req.GetBody().SetKey(nodeKey)
+
+	signIRRequest(cmd, pk, req)
+
+	var resp *ircontrol.RemoveNodeResponse
+	err = c.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = ircontrol.RemoveNode(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Node removal requested")
+}
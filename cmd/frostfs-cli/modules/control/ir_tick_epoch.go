@@ -0,0 +1,43 @@
+package control
+
+import (
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	ircontrol "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	"github.com/spf13/cobra"
+)
+
+var tickEpochCmd = &cobra.Command{
+	Use:   "tick-epoch",
+	Short: "Forces a new epoch",
+	Long: `Forces a new epoch to be signaled by the IR node.
+The epoch is switched when the majority of the alphabet nodes have signaled it.`,
+	Run: tickEpoch,
+}
+
+func initControlIRTickEpochCmd() {
+	initControlFlags(tickEpochCmd)
+}
+
+func tickEpoch(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	c := getClient(cmd, pk)
+
+	req := new(ircontrol.TickEpochRequest)
+	req.SetBody(new(ircontrol.TickEpochRequest_Body))
+
+	signIRRequest(cmd, pk, req)
+
+	var resp *ircontrol.TickEpochResponse
+	var err error
+	err = c.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = ircontrol.TickEpoch(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Epoch tick requested")
+}
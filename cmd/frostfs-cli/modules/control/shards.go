@@ -17,6 +17,8 @@ func initControlShardsCmd() {
 	shardsCmd.AddCommand(restoreShardCmd)
 	shardsCmd.AddCommand(evacuateShardCmd)
 	shardsCmd.AddCommand(flushCacheCmd)
+	shardsCmd.AddCommand(rebuildMetabaseCmd)
+	shardsCmd.AddCommand(compactBlobovniczasCmd)
 
 	initControlShardsListCmd()
 	initControlSetShardModeCmd()
@@ -24,4 +26,6 @@ func initControlShardsCmd() {
 	initControlRestoreShardCmd()
 	initControlEvacuateShardCmd()
 	initControlFlushCacheCmd()
+	initControlRebuildMetabaseCmd()
+	initControlCompactBlobovniczasCmd()
 }
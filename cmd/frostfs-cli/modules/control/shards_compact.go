@@ -0,0 +1,59 @@
+package control
+
+import (
+	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+const compactThresholdFlag = "threshold"
+
+var compactBlobovniczasCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Compact under-utilized blobovniczas of the shard",
+	Long: `Rewrite blobovniczas with the ratio of the stored objects size to the blobovnicza size
+less than the threshold: live objects are moved to the active blobovniczas
+and the old files are removed.`,
+	Run: compactBlobovniczas,
+}
+
+func compactBlobovniczas(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.CompactBlobovniczasRequest{Body: new(control.CompactBlobovniczasRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.Threshold, _ = cmd.Flags().GetFloat64(compactThresholdFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.CompactBlobovniczasResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.CompactBlobovniczas(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	for _, res := range resp.GetBody().GetResults() {
+		cmd.Printf("Shard %s: %d blobovniczas compacted, %d objects moved, %d bytes reclaimed\n",
+			base58.Encode(res.GetShard_ID()), res.GetCompacted(), res.GetMoved(), res.GetReclaimed())
+	}
+}
+
+func initControlCompactBlobovniczasCmd() {
+	initControlFlags(compactBlobovniczasCmd)
+
+	ff := compactBlobovniczasCmd.Flags()
+	ff.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	ff.Bool(shardAllFlag, false, "Process all shards")
+	ff.Float64(compactThresholdFlag, 0, "Ratio of the stored objects size to the blobovnicza size below which the blobovnicza is compacted (0 means the shard configuration value)")
+
+	compactBlobovniczasCmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+}
@@ -1,32 +1,55 @@
 package control
 
 import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"os"
+
 	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
 	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/spf13/cobra"
 )
 
 const (
 	dumpFilepathFlag     = "path"
+	dumpOutputFlag       = "out"
 	dumpIgnoreErrorsFlag = "no-errors"
+	dumpCompressFlag     = "compress"
+	dumpContainerFlag    = "cid"
+	dumpObjectTypeFlag   = "type"
 )
 
+var errDumpDestinationMissing = errors.New("either --path or --out flag must be specified")
+
 var dumpShardCmd = &cobra.Command{
 	Use:   "dump",
 	Short: "Dump objects from shard",
-	Long:  "Dump objects from shard to a file",
-	Run:   dumpShard,
+	Long: `Dump objects from shard to a file.
+With --path the dump is written to the file on the storage node.
+With --out the dump is streamed to the local file, the objects can be
+filtered by container and type and the dump can be compressed with zstd.`,
+	Run: dumpShard,
 }
 
 func dumpShard(cmd *cobra.Command, _ []string) {
+	if out, _ := cmd.Flags().GetString(dumpOutputFlag); out != "" {
+		dumpShardStream(cmd, out)
+		return
+	}
+
 	pk := key.Get(cmd)
 
 	body := new(control.DumpShardRequest_Body)
 	body.SetShardID(getShardID(cmd))
 
 	p, _ := cmd.Flags().GetString(dumpFilepathFlag)
+	if p == "" {
+		commonCmd.ExitOnErr(cmd, "", errDumpDestinationMissing)
+	}
 	body.SetFilepath(p)
 
 	ignore, _ := cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
@@ -52,15 +75,86 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	cmd.Println("Shard has been dumped successfully.")
 }
 
+func dumpShardStream(cmd *cobra.Command, out string) {
+	pk := key.Get(cmd)
+
+	body := new(control.DumpShardStreamRequest_Body)
+	body.Shard_ID = getShardID(cmd)
+	body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+	body.Compress, _ = cmd.Flags().GetBool(dumpCompressFlag)
+	body.ObjectType, _ = cmd.Flags().GetStringSlice(dumpObjectTypeFlag)
+
+	cnrs, _ := cmd.Flags().GetStringSlice(dumpContainerFlag)
+	for i := range cnrs {
+		var cnr cid.ID
+		commonCmd.ExitOnErr(cmd, "can't decode container ID: %w", cnr.DecodeString(cnrs[i]))
+
+		rawCID := make([]byte, sha256.Size)
+		cnr.Encode(rawCID)
+		body.Container_ID = append(body.Container_ID, rawCID)
+	}
+
+	req := new(control.DumpShardStreamRequest)
+	req.SetBody(body)
+
+	signRequest(cmd, pk, req)
+
+	f, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0640)
+	commonCmd.ExitOnErr(cmd, "can't create output file: %w", err)
+	defer f.Close()
+
+	cli := getClient(cmd, pk)
+
+	err = cli.ExecRaw(func(client *client.Client) error {
+		r, err := control.DumpShardStream(client, req)
+		if err != nil {
+			return err
+		}
+
+		for {
+			resp, err := r.Read()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+
+			verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+			if _, err := f.Write(resp.GetBody().GetChunk()); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(out)
+	}
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	commonCmd.ExitOnErr(cmd, "can't close output file: %w", f.Close())
+
+	cmd.Println("Shard has been dumped successfully.")
+}
+
 func initControlDumpShardCmd() {
 	initControlFlags(dumpShardCmd)
 
 	flags := dumpShardCmd.Flags()
 	flags.String(shardIDFlag, "", "Shard ID in base58 encoding")
-	flags.String(dumpFilepathFlag, "", "File to write objects to")
+	flags.String(dumpFilepathFlag, "", "File on the storage node to write objects to")
+	flags.String(dumpOutputFlag, "", "Local file to stream the dump to")
 	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Bool(dumpCompressFlag, false, "Compress the dump with zstd (only with --out)")
+	flags.StringSlice(dumpContainerFlag, nil, "Dump only objects from the containers (only with --out)")
+	flags.StringSlice(dumpObjectTypeFlag, nil, "Dump only objects of the types, e.g. REGULAR,TOMBSTONE,LOCK (only with --out)")
 
 	_ = dumpShardCmd.MarkFlagRequired(shardIDFlag)
-	_ = dumpShardCmd.MarkFlagRequired(dumpFilepathFlag)
 	_ = dumpShardCmd.MarkFlagRequired(controlRPC)
+
+	dumpShardCmd.MarkFlagsMutuallyExclusive(dumpFilepathFlag, dumpOutputFlag)
+	for _, f := range []string{dumpCompressFlag, dumpContainerFlag, dumpObjectTypeFlag} {
+		dumpShardCmd.MarkFlagsMutuallyExclusive(dumpFilepathFlag, f)
+	}
 }
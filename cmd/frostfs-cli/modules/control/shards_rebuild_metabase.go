@@ -0,0 +1,154 @@
+package control
+
+import (
+	"crypto/ecdsa"
+	"time"
+
+	rawclient "github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
+	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+const (
+	rebuildMetabaseAwaitFlag = "await"
+
+	rebuildMetabasePollInterval = time.Second
+)
+
+var rebuildMetabaseCmd = &cobra.Command{
+	Use:   "rebuild-metabase",
+	Short: "Rebuild shard metabase from the blobstor",
+	Long: `Rebuild shard metabase from the blobstor in the background.
+The shard is moved to the degraded-read-only mode until the rebuild is finished
+and is switched back to the read-write mode on success.`,
+	Run: rebuildMetabase,
+}
+
+var rebuildMetabaseStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get status of the metabase rebuild",
+	Long:  "Get status of the metabase rebuild",
+	Run:   rebuildMetabaseStatus,
+}
+
+func rebuildMetabase(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.RebuildMetabaseRequest{Body: new(control.RebuildMetabaseRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.RebuildMetabaseResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.RebuildMetabase(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Metabase rebuild has been started.")
+
+	if await, _ := cmd.Flags().GetBool(rebuildMetabaseAwaitFlag); !await {
+		return
+	}
+
+	for {
+		time.Sleep(rebuildMetabasePollInterval)
+
+		statuses := getMetabaseRebuildStatus(cmd, pk, req.Body.Shard_ID)
+
+		running := false
+		for _, st := range statuses {
+			if st.GetState() == control.MetabaseRebuildState_METABASE_REBUILD_RUNNING {
+				running = true
+				cmd.Printf("Shard %s: %d objects processed\n", base58.Encode(st.GetShard_ID()), st.GetObjectsProcessed())
+			}
+		}
+		if !running {
+			prettyPrintMetabaseRebuildStatuses(cmd, statuses)
+			return
+		}
+	}
+}
+
+func rebuildMetabaseStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+	prettyPrintMetabaseRebuildStatuses(cmd, getMetabaseRebuildStatus(cmd, pk, getShardIDList(cmd)))
+}
+
+func getMetabaseRebuildStatus(cmd *cobra.Command, pk *ecdsa.PrivateKey, shardIDs [][]byte) []*control.MetabaseRebuildStatus {
+	req := &control.GetMetabaseRebuildStatusRequest{Body: new(control.GetMetabaseRebuildStatusRequest_Body)}
+	req.Body.Shard_ID = shardIDs
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetMetabaseRebuildStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetMetabaseRebuildStatus(client, req)
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	return resp.GetBody().GetStatuses()
+}
+
+func prettyPrintMetabaseRebuildStatuses(cmd *cobra.Command, statuses []*control.MetabaseRebuildStatus) {
+	for _, st := range statuses {
+		cmd.Printf("Shard %s:\n", base58.Encode(st.GetShard_ID()))
+		cmd.Printf("  State: %s\n", metabaseRebuildStateToString(st.GetState()))
+		cmd.Printf("  Objects processed: %d\n", st.GetObjectsProcessed())
+		if ts := st.GetStartedAt(); ts != 0 {
+			cmd.Printf("  Started at: %s\n", time.Unix(ts, 0).Format(time.RFC3339))
+		}
+		if ts := st.GetFinishedAt(); ts != 0 {
+			cmd.Printf("  Finished at: %s\n", time.Unix(ts, 0).Format(time.RFC3339))
+		}
+		if msg := st.GetError(); msg != "" {
+			cmd.Printf("  Error: %s\n", msg)
+		}
+	}
+}
+
+func metabaseRebuildStateToString(s control.MetabaseRebuildState) string {
+	switch s {
+	case control.MetabaseRebuildState_METABASE_REBUILD_NONE:
+		return "not started"
+	case control.MetabaseRebuildState_METABASE_REBUILD_RUNNING:
+		return "running"
+	case control.MetabaseRebuildState_METABASE_REBUILD_COMPLETED:
+		return "completed"
+	case control.MetabaseRebuildState_METABASE_REBUILD_FAILED:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+func initControlRebuildMetabaseCmd() {
+	for _, cmd := range []*cobra.Command{rebuildMetabaseCmd, rebuildMetabaseStatusCmd} {
+		initControlFlags(cmd)
+
+		ff := cmd.Flags()
+		ff.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+		ff.Bool(shardAllFlag, false, "Process all shards")
+
+		cmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+	}
+
+	rebuildMetabaseCmd.Flags().Bool(rebuildMetabaseAwaitFlag, false, "Wait for the rebuild to finish and print the progress")
+
+	rebuildMetabaseCmd.AddCommand(rebuildMetabaseStatusCmd)
+}
@@ -1,6 +1,10 @@
 package control
 
 import (
+	"errors"
+	"io"
+	"os"
+
 	"github.com/TrueCloudLab/frostfs-api-go/v2/rpc/client"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
 	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
@@ -10,23 +14,39 @@ import (
 
 const (
 	restoreFilepathFlag     = "path"
+	restoreInputFlag        = "in"
 	restoreIgnoreErrorsFlag = "no-errors"
+
+	// restoreStreamChunkSize is the size of the dump chunk sent in a single request.
+	restoreStreamChunkSize = 1 << 20
 )
 
+var errRestoreSourceMissing = errors.New("either --path or --in flag must be specified")
+
 var restoreShardCmd = &cobra.Command{
 	Use:   "restore",
 	Short: "Restore objects from shard",
-	Long:  "Restore objects from shard to a file",
-	Run:   restoreShard,
+	Long: `Restore objects from shard from a file.
+With --path the dump is read from the file on the storage node.
+With --in the local dump file is streamed to the storage node.`,
+	Run: restoreShard,
 }
 
 func restoreShard(cmd *cobra.Command, _ []string) {
+	if in, _ := cmd.Flags().GetString(restoreInputFlag); in != "" {
+		restoreShardStream(cmd, in)
+		return
+	}
+
 	pk := key.Get(cmd)
 
 	body := new(control.RestoreShardRequest_Body)
 	body.SetShardID(getShardID(cmd))
 
 	p, _ := cmd.Flags().GetString(restoreFilepathFlag)
+	if p == "" {
+		commonCmd.ExitOnErr(cmd, "", errRestoreSourceMissing)
+	}
 	body.SetFilepath(p)
 
 	ignore, _ := cmd.Flags().GetBool(restoreIgnoreErrorsFlag)
@@ -52,15 +72,70 @@ func restoreShard(cmd *cobra.Command, _ []string) {
 	cmd.Println("Shard has been restored successfully.")
 }
 
+func restoreShardStream(cmd *cobra.Command, in string) {
+	pk := key.Get(cmd)
+
+	shardID := getShardID(cmd)
+	ignore, _ := cmd.Flags().GetBool(restoreIgnoreErrorsFlag)
+
+	f, err := os.Open(in)
+	commonCmd.ExitOnErr(cmd, "can't open input file: %w", err)
+	defer f.Close()
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.RestoreShardStreamResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		w, err := control.RestoreShardStream(client)
+		if err != nil {
+			return err
+		}
+
+		buf := make([]byte, restoreStreamChunkSize)
+		for {
+			n, err := io.ReadFull(f, buf)
+			if n == 0 && err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return err
+			}
+
+			req := new(control.RestoreShardStreamRequest)
+			req.SetBody(&control.RestoreShardStreamRequest_Body{
+				Shard_ID:     shardID,
+				IgnoreErrors: ignore,
+				Chunk:        buf[:n],
+			})
+
+			signRequest(cmd, pk, req)
+
+			if err := w.Write(req); err != nil {
+				return err
+			}
+		}
+
+		resp, err = w.Close()
+		return err
+	})
+	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard has been restored successfully.")
+}
+
 func initControlRestoreShardCmd() {
 	initControlFlags(restoreShardCmd)
 
 	flags := restoreShardCmd.Flags()
 	flags.String(shardIDFlag, "", "Shard ID in base58 encoding")
-	flags.String(restoreFilepathFlag, "", "File to read objects from")
+	flags.String(restoreFilepathFlag, "", "File on the storage node to read objects from")
+	flags.String(restoreInputFlag, "", "Local file to stream the dump from")
 	flags.Bool(restoreIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
 
 	_ = restoreShardCmd.MarkFlagRequired(shardIDFlag)
-	_ = restoreShardCmd.MarkFlagRequired(restoreFilepathFlag)
 	_ = restoreShardCmd.MarkFlagRequired(controlRPC)
+
+	restoreShardCmd.MarkFlagsMutuallyExclusive(restoreFilepathFlag, restoreInputFlag)
 }
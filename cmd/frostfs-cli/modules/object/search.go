@@ -2,6 +2,7 @@ package object
 
 import (
 	"fmt"
+	"math/big"
 	"os"
 	"strings"
 
@@ -9,12 +10,18 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/commonflags"
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-cli/internal/key"
 	commonCmd "github.com/TrueCloudLab/frostfs-node/cmd/internal/common"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oidSDK "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/spf13/cobra"
 )
 
+const (
+	searchLimitFlag  = "limit"
+	searchCursorFlag = "cursor"
+)
+
 var (
 	searchFilters []string
 
@@ -36,11 +43,42 @@ func initObjectSearchCmd() {
 	_ = objectSearchCmd.MarkFlagRequired(commonflags.CIDFlag)
 
 	flags.StringSliceVarP(&searchFilters, "filters", "f", nil,
-		"Repeated filter expressions or files with protobuf JSON")
+		"Repeated filter expressions or files with protobuf JSON. "+
+			"Numeric operations GT, GE, LT and LE compare values as decimal integers")
 
 	flags.Bool("root", false, "Search for user objects")
 	flags.Bool("phy", false, "Search physically stored objects")
 	flags.String(commonflags.OIDFlag, "", "Search object by identifier")
+
+	InitPagination(objectSearchCmd)
+}
+
+// InitPagination adds search pagination flags to a command.
+func InitPagination(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.Uint32(searchLimitFlag, 0, "Maximum number of objects to return, objects are sorted by ID if set")
+	flags.String(searchCursorFlag, "", "Return objects after the specified ID, use the cursor from the previous page")
+}
+
+// ReadPagination reads search pagination flags of a command.
+func ReadPagination(cmd *cobra.Command, prm *internalclient.SearchObjectsPrm) {
+	limit, _ := cmd.Flags().GetUint32(searchLimitFlag)
+	prm.SetLimit(limit)
+
+	if s, _ := cmd.Flags().GetString(searchCursorFlag); s != "" {
+		var cursor oidSDK.ID
+		commonCmd.ExitOnErr(cmd, "invalid cursor: %w", cursor.DecodeString(s))
+
+		prm.SetCursor(&cursor)
+	}
+}
+
+// PrintNextCursor prints the cursor of the next page if the page is full.
+func PrintNextCursor(cmd *cobra.Command, ids []oidSDK.ID) {
+	limit, _ := cmd.Flags().GetUint32(searchLimitFlag)
+	if limit != 0 && len(ids) == int(limit) {
+		cmd.Printf("Next page cursor: %s\n", ids[len(ids)-1])
+	}
 }
 
 func searchObject(cmd *cobra.Command, _ []string) {
@@ -60,6 +98,8 @@ func searchObject(cmd *cobra.Command, _ []string) {
 	readSessionGlobal(cmd, &prm, pk, cnr)
 	prm.SetContainerID(cnr)
 	prm.SetFilters(sf)
+	prm.SetPrivateKey(pk)
+	ReadPagination(cmd, &prm)
 
 	res, err := internalclient.SearchObjects(prm)
 	commonCmd.ExitOnErr(cmd, "rpc error: %w", err)
@@ -70,6 +110,8 @@ func searchObject(cmd *cobra.Command, _ []string) {
 	for i := range ids {
 		cmd.Println(ids[i].String())
 	}
+
+	PrintNextCursor(cmd, ids)
 }
 
 var searchUnaryOpVocabulary = map[string]object.SearchMatchType{
@@ -80,6 +122,10 @@ var searchBinaryOpVocabulary = map[string]object.SearchMatchType{
 	"EQ":            object.MatchStringEqual,
 	"NE":            object.MatchStringNotEqual,
 	"COMMON_PREFIX": object.MatchCommonPrefix,
+	"GT":            objectcore.MatchNumGT,
+	"GE":            objectcore.MatchNumGE,
+	"LT":            objectcore.MatchNumLT,
+	"LE":            objectcore.MatchNumLE,
 }
 
 func parseSearchFilters(cmd *cobra.Command) (object.SearchFilters, error) {
@@ -117,6 +163,12 @@ func parseSearchFilters(cmd *cobra.Command) (object.SearchFilters, error) {
 				return nil, fmt.Errorf("unsupported binary op: %s", words[1])
 			}
 
+			if objectcore.IsNumericMatch(m) {
+				if _, ok := new(big.Int).SetString(words[2], 10); !ok {
+					return nil, fmt.Errorf("numeric filter value must be a decimal integer: %s", words[2])
+				}
+			}
+
 			fs.AddFilter(words[0], words[2], m)
 		}
 	}
@@ -0,0 +1,50 @@
+package dump
+
+import (
+	"errors"
+	"io"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/spf13/cobra"
+)
+
+var listCMD = &cobra.Command{
+	Use:   "list",
+	Short: "Object listing",
+	Long:  `List all objects stored in a shard dump.`,
+	Run:   listFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(listCMD, &vPath)
+	common.AddMaxObjectSizeFlag(listCMD, &vMaxObjSize, shard.DefaultDumpMaxObjectSize)
+}
+
+func listFunc(cmd *cobra.Command, _ []string) {
+	f, r := openDump(cmd)
+	defer f.Close()
+	defer r.Close()
+
+	printHeader(cmd, r.Header())
+	cmd.Println("Objects:")
+
+	var data []byte
+	for {
+		var offset uint64
+		var err error
+
+		data, offset, err = r.Next(data)
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		common.ExitOnErr(cmd, common.Errf("could not read dump: %w", err))
+
+		obj := objectSDK.New()
+		common.ExitOnErr(cmd, common.Errf("could not unmarshal object: %w", obj.Unmarshal(data)))
+
+		cmd.Printf("  %s %s %d bytes at %d\n", objectCore.AddressOf(obj), obj.Type(), len(data), offset)
+	}
+}
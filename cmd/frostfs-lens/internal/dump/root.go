@@ -0,0 +1,52 @@
+package dump
+
+import (
+	"encoding/hex"
+	"os"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+var (
+	vPath       string
+	vMaxObjSize uint64
+)
+
+// Root contains `dump` command definition.
+var Root = &cobra.Command{
+	Use:   "dump",
+	Short: "Operations with a shard dump",
+}
+
+func init() {
+	Root.AddCommand(listCMD, verifyCMD)
+}
+
+func openDump(cmd *cobra.Command) (*os.File, *shard.DumpReader) {
+	f, err := os.Open(vPath)
+	common.ExitOnErr(cmd, common.Errf("could not open dump: %w", err))
+
+	r, err := shard.NewDumpReader(f)
+	if err != nil {
+		_ = f.Close()
+	}
+	common.ExitOnErr(cmd, common.Errf("could not read dump header: %w", err))
+
+	r.SetMaxObjectSize(vMaxObjSize)
+	return f, r
+}
+
+func printHeader(cmd *cobra.Command, hdr shard.DumpHeader) {
+	cmd.Println("Version:", hdr.Version)
+	if hdr.Version == shard.DumpVersion1 {
+		return
+	}
+
+	cmd.Println("Shard ID:", base58.Encode(hdr.ShardID))
+	cmd.Println("Node key:", hex.EncodeToString(hdr.NodeKey))
+	cmd.Println("Epoch:", hdr.Epoch)
+	cmd.Println("Object count:", hdr.ObjectCount)
+}
@@ -0,0 +1,93 @@
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/spf13/cobra"
+)
+
+var verifyCMD = &cobra.Command{
+	Use:   "verify",
+	Short: "Dump verification",
+	Long: `Verify integrity of a shard dump.
+All record checksums, the object count and the footer index are checked.
+Objects are also checked to be decodable.`,
+	Run: verifyFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(verifyCMD, &vPath)
+	common.AddMaxObjectSizeFlag(verifyCMD, &vMaxObjSize, shard.DefaultDumpMaxObjectSize)
+}
+
+func verifyFunc(cmd *cobra.Command, _ []string) {
+	f, r := openDump(cmd)
+	defer f.Close()
+	defer r.Close()
+
+	hdr := r.Header()
+	printHeader(cmd, hdr)
+
+	var (
+		data    []byte
+		offsets []uint64
+		addrs   []string
+		invalid int
+	)
+	for {
+		var offset uint64
+		var err error
+
+		data, offset, err = r.Next(data)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if errors.Is(err, shard.ErrDumpChecksumMismatch) {
+			cmd.Printf("Invalid record at %d: %v\n", offset, err)
+			invalid++
+			offsets = append(offsets, offset)
+			addrs = append(addrs, "")
+			continue
+		}
+		common.ExitOnErr(cmd, common.Errf("could not read dump: %w", err))
+
+		obj := objectSDK.New()
+		if err := obj.Unmarshal(data); err != nil {
+			cmd.Printf("Invalid object at %d: %v\n", offset, err)
+			invalid++
+			addrs = append(addrs, "")
+		} else {
+			addrs = append(addrs, objectCore.AddressOf(obj).EncodeToString())
+		}
+		offsets = append(offsets, offset)
+	}
+
+	if hdr.Version != shard.DumpVersion1 {
+		if hdr.ObjectCount != uint64(len(offsets)) {
+			// Objects could be skipped because of errors after the header was written.
+			cmd.Printf("Header contains %d objects, dump contains %d.\n", hdr.ObjectCount, len(offsets))
+		}
+
+		index := r.Index()
+		for i := range index {
+			if index[i].Offset != offsets[i] {
+				common.ExitOnErr(cmd, fmt.Errorf("index entry %d points to %d, record is at %d", i, index[i].Offset, offsets[i]))
+			}
+			if addr := index[i].Address.EncodeToString(); addrs[i] != "" && addrs[i] != addr {
+				common.ExitOnErr(cmd, fmt.Errorf("index entry %d contains %s, record contains %s", i, addr, addrs[i]))
+			}
+		}
+	}
+
+	if invalid != 0 {
+		common.ExitOnErr(cmd, fmt.Errorf("dump contains %d invalid objects out of %d", invalid, len(offsets)))
+	}
+
+	cmd.Printf("Dump is valid, %d objects.\n", len(offsets))
+}
@@ -8,6 +8,7 @@ const (
 	flagAddress    = "address"
 	flagEnginePath = "path"
 	flagOutFile    = "out"
+	flagMaxObjSize = "max-object-size"
 )
 
 // AddAddressFlag adds the address flag to the passed cobra command.
@@ -33,3 +34,10 @@ func AddOutputFileFlag(cmd *cobra.Command, v *string) {
 		"File to save object payload")
 	_ = cmd.MarkFlagFilename(flagOutFile)
 }
+
+// AddMaxObjectSizeFlag adds the maximum object payload size flag to the passed
+// cobra command.
+func AddMaxObjectSizeFlag(cmd *cobra.Command, v *uint64, def uint64) {
+	cmd.Flags().Uint64Var(v, flagMaxObjSize, def,
+		"Maximum object payload size, MaxObjectSize network setting")
+}
@@ -0,0 +1,69 @@
+package tree
+
+import (
+	"fmt"
+	"strings"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagNodeID = "node"
+	flagDepth  = "depth"
+)
+
+var (
+	vNodeID uint64
+	vDepth  uint32
+)
+
+var dumpCMD = &cobra.Command{
+	Use:   "dump",
+	Short: "Subtree dump",
+	Long: `Print the subtree starting from the specified node. Children are sorted by FileName,
+every node is printed with its ID, timestamp and meta.`,
+	Run: dumpFunc,
+}
+
+func init() {
+	addTreeFlags(dumpCMD)
+	dumpCMD.Flags().Uint64Var(&vNodeID, flagNodeID, pilorama.RootID, "ID of the subtree root")
+	dumpCMD.Flags().Uint32Var(&vDepth, flagDepth, 0, "Maximum depth of the subtree, 0 means unlimited")
+}
+
+func dumpFunc(cmd *cobra.Command, _ []string) {
+	cid := parseCID(cmd)
+
+	f := openForest(cmd)
+	defer f.Close()
+
+	m, parent, err := f.TreeGetMeta(cid, vTreeID, vNodeID)
+	common.ExitOnErr(cmd, common.Errf("could not get node meta: %w", err))
+
+	printNode(cmd, fmt.Sprintf("%d (parent %d, ts %d)", vNodeID, parent, m.Time), m.Items)
+	common.ExitOnErr(cmd, common.Errf("could not dump subtree: %w",
+		dumpSubtree(cmd, f, cid, vNodeID, 1)))
+}
+
+func dumpSubtree(cmd *cobra.Command, f pilorama.Forest, cid cidSDK.ID, nodeID pilorama.Node, depth uint32) error {
+	if vDepth != 0 && depth > vDepth {
+		return nil
+	}
+
+	children, err := f.TreeSortedByFilename(cid, vTreeID, nodeID, "", 0)
+	if err != nil {
+		return err
+	}
+
+	indent := strings.Repeat("  ", int(depth))
+	for i := range children {
+		printNode(cmd, fmt.Sprintf("%s%d (ts %d)", indent, children[i].ID, children[i].Meta.Time), children[i].Meta.Items)
+		if err := dumpSubtree(cmd, f, cid, children[i].ID, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
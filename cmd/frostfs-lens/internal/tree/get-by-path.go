@@ -0,0 +1,56 @@
+package tree
+
+import (
+	"fmt"
+	"strings"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAttr     = "attr"
+	flagTreePath = "tree-path"
+	flagLatest   = "latest"
+)
+
+var (
+	vAttr     string
+	vTreePath string
+	vLatest   bool
+)
+
+var getByPathCMD = &cobra.Command{
+	Use:   "get-by-path",
+	Short: "Node lookup by path",
+	Long: `Print the nodes having the specified path. The path is constructed by descending
+from the root using the values of the attribute, elements are separated by '/'.`,
+	Run: getByPathFunc,
+}
+
+func init() {
+	addTreeFlags(getByPathCMD)
+	getByPathCMD.Flags().StringVar(&vAttr, flagAttr, pilorama.AttributeFilename, "Attribute to build the path with")
+	getByPathCMD.Flags().StringVar(&vTreePath, flagTreePath, "", "Path in the tree")
+	_ = getByPathCMD.MarkFlagRequired(flagTreePath)
+	getByPathCMD.Flags().BoolVar(&vLatest, flagLatest, false, "Print only the node with the latest timestamp")
+}
+
+func getByPathFunc(cmd *cobra.Command, _ []string) {
+	cid := parseCID(cmd)
+
+	f := openForest(cmd)
+	defer f.Close()
+
+	path := strings.Split(strings.Trim(vTreePath, "/"), "/")
+	nodes, err := f.TreeGetByPath(cid, vTreeID, vAttr, path, vLatest)
+	common.ExitOnErr(cmd, common.Errf("could not get nodes by path: %w", err))
+
+	for _, n := range nodes {
+		m, parent, err := f.TreeGetMeta(cid, vTreeID, n)
+		common.ExitOnErr(cmd, common.Errf("could not get node meta: %w", err))
+
+		printNode(cmd, fmt.Sprintf("%d (parent %d, ts %d)", n, parent, m.Time), m.Items)
+	}
+}
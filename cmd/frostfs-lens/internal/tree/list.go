@@ -0,0 +1,58 @@
+package tree
+
+import (
+	"math"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var listCMD = &cobra.Command{
+	Use:   "list",
+	Short: "Tree listing",
+	Long: `List all the trees stored in a pilorama with the amount of operations in the log,
+the timestamp of the last operation, the snapshot height and the log digest.`,
+	Run: listFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(listCMD, &vPath)
+	listCMD.Flags().StringVar(&vCID, flagCID, "", "List only the trees of the container")
+}
+
+func listFunc(cmd *cobra.Command, _ []string) {
+	var filter *cidSDK.ID
+	if vCID != "" {
+		cid := parseCID(cmd)
+		filter = &cid
+	}
+
+	f := openForest(cmd)
+	defer f.Close()
+
+	err := f.IterateTrees(func(cid cidSDK.ID, treeID string) error {
+		if filter != nil && !filter.Equals(cid) {
+			return nil
+		}
+
+		d, err := f.TreeLogDigest(cid, treeID, 0, math.MaxUint64)
+		if err != nil {
+			return err
+		}
+		height, _, err := f.TreeGetSnapshot(cid, treeID, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("%s %q\n", cid, treeID)
+		cmd.Printf("  Operations: %d (%d bytes)\n", d.Count, d.Size)
+		cmd.Printf("  Last operation: %d\n", d.Last)
+		cmd.Printf("  Snapshot height: %d\n", height)
+		if d.Count != 0 {
+			cmd.Printf("  Log digest: %x\n", d.Hash)
+		}
+		return nil
+	})
+	common.ExitOnErr(cmd, common.Errf("could not iterate over trees: %w", err))
+}
@@ -0,0 +1,62 @@
+package tree
+
+import (
+	"fmt"
+	"math"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagStart = "start"
+	flagEnd   = "end"
+	flagCount = "count"
+)
+
+var (
+	vStart uint64
+	vEnd   uint64
+	vCount uint64
+)
+
+var opLogCMD = &cobra.Command{
+	Use:   "oplog",
+	Short: "Operation log listing",
+	Long:  `List the operations of the tree log with timestamps in [start, end) range.`,
+	Run:   opLogFunc,
+}
+
+func init() {
+	addTreeFlags(opLogCMD)
+	opLogCMD.Flags().Uint64Var(&vStart, flagStart, 0, "Timestamp of the first operation")
+	opLogCMD.Flags().Uint64Var(&vEnd, flagEnd, math.MaxUint64, "Timestamp next to the last operation")
+	opLogCMD.Flags().Uint64Var(&vCount, flagCount, 0, "Maximum amount of operations, 0 means unlimited")
+}
+
+func opLogFunc(cmd *cobra.Command, _ []string) {
+	cid := parseCID(cmd)
+
+	f := openForest(cmd)
+	defer f.Close()
+
+	d, err := f.TreeLogDigest(cid, vTreeID, vStart, vEnd)
+	common.ExitOnErr(cmd, common.Errf("could not get log digest: %w", err))
+
+	cmd.Printf("Operations: %d (%d bytes)\n", d.Count, d.Size)
+	if d.Count != 0 {
+		cmd.Printf("Log digest: %x\n", d.Hash)
+	}
+
+	h := vStart
+	for printed := uint64(0); vCount == 0 || printed < vCount; printed++ {
+		m, err := f.TreeGetOpLog(cid, vTreeID, h)
+		common.ExitOnErr(cmd, common.Errf("could not get operation: %w", err))
+		if m.Time == 0 || m.Time >= vEnd {
+			return
+		}
+
+		printNode(cmd, fmt.Sprintf("  %d: move %d to %d", m.Time, m.Child, m.Parent), m.Items)
+		h = m.Time + 1
+	}
+}
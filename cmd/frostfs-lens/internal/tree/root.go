@@ -0,0 +1,103 @@
+package tree
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	common "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-lens/internal"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagCID    = "cid"
+	flagTreeID = "tree"
+)
+
+var (
+	vPath   string
+	vCID    string
+	vTreeID string
+)
+
+// Root contains `tree` command definition.
+var Root = &cobra.Command{
+	Use:   "tree",
+	Short: "Operations with a pilorama",
+}
+
+func init() {
+	Root.AddCommand(
+		listCMD,
+		dumpCMD,
+		opLogCMD,
+		getByPathCMD,
+	)
+}
+
+// addTreeFlags adds the pilorama path, container ID and tree ID flags to cmd.
+func addTreeFlags(cmd *cobra.Command) {
+	common.AddComponentPathFlag(cmd, &vPath)
+
+	cmd.Flags().StringVar(&vCID, flagCID, "", "Container ID")
+	_ = cmd.MarkFlagRequired(flagCID)
+
+	cmd.Flags().StringVar(&vTreeID, flagTreeID, "", "Tree ID")
+	_ = cmd.MarkFlagRequired(flagTreeID)
+}
+
+func openForest(cmd *cobra.Command) pilorama.ForestStorage {
+	f := pilorama.NewBoltForest(pilorama.WithPath(vPath))
+	common.ExitOnErr(cmd, common.Errf("could not open pilorama: %w", f.Open(true)))
+	common.ExitOnErr(cmd, common.Errf("could not init pilorama: %w", f.Init()))
+
+	return f
+}
+
+func parseCID(cmd *cobra.Command) cidSDK.ID {
+	var cid cidSDK.ID
+	common.ExitOnErr(cmd, common.Errf("invalid container ID: %w", cid.DecodeString(vCID)))
+
+	return cid
+}
+
+// printNode prints the node description followed by its meta items.
+func printNode(cmd *cobra.Command, descr string, items []pilorama.KeyValue) {
+	if len(items) == 0 {
+		cmd.Println(descr)
+		return
+	}
+	cmd.Println(descr, formatMeta(items))
+}
+
+// formatMeta returns meta items as space-separated key=value pairs.
+func formatMeta(items []pilorama.KeyValue) string {
+	var sb strings.Builder
+	for i := range items {
+		if i != 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(items[i].Key)
+		sb.WriteByte('=')
+		sb.WriteString(formatValue(items[i].Value))
+	}
+	return sb.String()
+}
+
+// formatValue returns v as a quoted string if it is printable
+// and as a hex string otherwise.
+func formatValue(v []byte) string {
+	if !utf8.Valid(v) {
+		return "0x" + hex.EncodeToString(v)
+	}
+	for _, r := range string(v) {
+		if !unicode.IsPrint(r) {
+			return "0x" + hex.EncodeToString(v)
+		}
+	}
+	return fmt.Sprintf("%q", v)
+}
@@ -23,6 +23,8 @@ import (
 	shardconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard"
 	blobovniczaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/blobovnicza"
 	fstreeconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/fstree"
+	lsmconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/lsm"
+	segmentconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/segment"
 	loggerconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/logger"
 	nodeconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/node"
 	objectconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/object"
@@ -31,7 +33,10 @@ import (
 	netmapCore "github.com/TrueCloudLab/frostfs-node/pkg/core/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/lsm"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/segment"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
 	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
@@ -47,6 +52,8 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/network"
 	"github.com/TrueCloudLab/frostfs-node/pkg/network/cache"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator/nats"
 	objectService "github.com/TrueCloudLab/frostfs-node/pkg/services/object"
 	getsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/get"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object_manager/tombstone"
@@ -102,6 +109,11 @@ type applicationConfiguration struct {
 
 type shardCfg struct {
 	compress                  bool
+	compressionCodec          compression.Codec
+	compressionLevel          int
+	compressionRules          []compression.Rule
+	compressionAdaptive       bool
+	compressionMinRatio       float64
 	smallSizeObjectLimit      uint64
 	uncompressableContentType []string
 	refillMetabase            bool
@@ -119,10 +131,14 @@ type shardCfg struct {
 	gcCfg struct {
 		removerBatchSize     int
 		removerSleepInterval time.Duration
+
+		compactionInterval  time.Duration
+		compactionThreshold float64
 	}
 
 	writecacheCfg struct {
 		enabled          bool
+		typ              string
 		path             string
 		maxBatchSize     int
 		maxBatchDelay    time.Duration
@@ -134,12 +150,13 @@ type shardCfg struct {
 	}
 
 	piloramaCfg struct {
-		enabled       bool
-		path          string
-		perm          fs.FileMode
-		noSync        bool
-		maxBatchSize  int
-		maxBatchDelay time.Duration
+		enabled        bool
+		path           string
+		perm           fs.FileMode
+		noSync         bool
+		maxBatchSize   int
+		maxBatchDelay  time.Duration
+		pathAttributes map[string][]string
 	}
 }
 
@@ -157,16 +174,27 @@ func (c *shardCfg) id() string {
 
 type subStorageCfg struct {
 	// common for all storages
-	typ    string
-	path   string
-	perm   fs.FileMode
-	depth  uint64
-	noSync bool
+	typ              string
+	path             string
+	perm             fs.FileMode
+	depth            uint64
+	noSync           bool
+	compressionCodec compression.Codec
+	compressionLevel int
 
 	// blobovnicza-specific
 	size            uint64
 	width           uint64
 	openedCacheSize int
+
+	// lsm-specific
+	blockCacheSize  int
+	writeBufferSize int
+
+	// segment-specific
+	segmentSize         int64
+	compactionThreshold float64
+	compactionInterval  time.Duration
 }
 
 // readConfig fills applicationConfiguration with raw configuration values
@@ -205,6 +233,11 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		sh.mode = sc.Mode()
 		sh.compress = sc.Compress()
 		sh.uncompressableContentType = sc.UncompressableContentTypes()
+		sh.compressionCodec = sc.CompressionCodec()
+		sh.compressionLevel = sc.CompressionLevel()
+		sh.compressionRules = sc.CompressionRules()
+		sh.compressionAdaptive = sc.CompressionAdaptive()
+		sh.compressionMinRatio = sc.CompressionMinRatio()
 		sh.smallSizeObjectLimit = sc.SmallSizeLimit()
 
 		// write-cache
@@ -214,6 +247,7 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 			wc := &sh.writecacheCfg
 
 			wc.enabled = true
+			wc.typ = writeCacheCfg.Type()
 			wc.path = writeCacheCfg.Path()
 			wc.maxBatchSize = writeCacheCfg.BoltDB().MaxBatchSize()
 			wc.maxBatchDelay = writeCacheCfg.BoltDB().MaxBatchDelay()
@@ -241,6 +275,7 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 			pr.noSync = piloramaCfg.NoSync()
 			pr.maxBatchSize = piloramaCfg.MaxBatchSize()
 			pr.maxBatchDelay = piloramaCfg.MaxBatchDelay()
+			pr.pathAttributes = piloramaCfg.PathAttributes()
 		}
 
 		ss := make([]subStorageCfg, 0, len(storagesCfg))
@@ -250,6 +285,8 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 			sCfg.typ = storagesCfg[i].Type()
 			sCfg.path = storagesCfg[i].Path()
 			sCfg.perm = storagesCfg[i].Perm()
+			sCfg.compressionCodec = storagesCfg[i].CompressionCodec()
+			sCfg.compressionLevel = storagesCfg[i].CompressionLevel()
 
 			switch storagesCfg[i].Type() {
 			case blobovniczatree.Type:
@@ -263,6 +300,17 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 				sub := fstreeconfig.From((*config.Config)(storagesCfg[i]))
 				sCfg.depth = sub.Depth()
 				sCfg.noSync = sub.NoSync()
+			case lsm.Type:
+				sub := lsmconfig.From((*config.Config)(storagesCfg[i]))
+				sCfg.noSync = sub.NoSync()
+				sCfg.blockCacheSize = sub.BlockCacheSize()
+				sCfg.writeBufferSize = sub.WriteBufferSize()
+			case segment.Type:
+				sub := segmentconfig.From((*config.Config)(storagesCfg[i]))
+				sCfg.noSync = sub.NoSync()
+				sCfg.segmentSize = sub.SegmentSize()
+				sCfg.compactionThreshold = sub.CompactionThreshold()
+				sCfg.compactionInterval = sub.CompactionInterval()
 			default:
 				return fmt.Errorf("invalid storage type: %s", storagesCfg[i].Type())
 			}
@@ -285,6 +333,8 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 
 		sh.gcCfg.removerBatchSize = gcCfg.RemoverBatchSize()
 		sh.gcCfg.removerSleepInterval = gcCfg.RemoverSleepInterval()
+		sh.gcCfg.compactionInterval = gcCfg.BlobovniczaCompactionInterval()
+		sh.gcCfg.compactionThreshold = gcCfg.BlobovniczaCompactionThreshold()
 
 		a.EngineCfg.shards = append(a.EngineCfg.shards, sh)
 
@@ -485,6 +535,12 @@ type cfgNotifications struct {
 	enabled      bool
 	nw           notificationWriter
 	defaultTopic string
+
+	// nats is nil if NATS notification sink is not used.
+	nats *nats.Writer
+
+	// events is nil if object event stream is disabled.
+	events *notificator.EventNotificator
 }
 
 type cfgLocalStorage struct {
@@ -660,6 +716,7 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 		var writeCacheOpts []writecache.Option
 		if wcRead := shCfg.writecacheCfg; wcRead.enabled {
 			writeCacheOpts = append(writeCacheOpts,
+				writecache.WithType(wcRead.typ),
 				writecache.WithPath(wcRead.path),
 				writecache.WithMaxBatchSize(wcRead.maxBatchSize),
 				writecache.WithMaxBatchDelay(wcRead.maxBatchDelay),
@@ -681,6 +738,9 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 				pilorama.WithMaxBatchSize(prRead.maxBatchSize),
 				pilorama.WithMaxBatchDelay(prRead.maxBatchDelay),
 			)
+			for treeID, attrs := range prRead.pathAttributes {
+				piloramaOpts = append(piloramaOpts, pilorama.WithPathAttributes(treeID, attrs))
+			}
 		}
 
 		var ss []blobstor.SubStorage
@@ -700,6 +760,8 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 					Policy: func(_ *objectSDK.Object, data []byte) bool {
 						return uint64(len(data)) < shCfg.smallSizeObjectLimit
 					},
+					Codec:            sRead.compressionCodec,
+					CompressionLevel: sRead.compressionLevel,
 				})
 			case fstree.Type:
 				ss = append(ss, blobstor.SubStorage{
@@ -711,6 +773,38 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 					Policy: func(_ *objectSDK.Object, data []byte) bool {
 						return true
 					},
+					Codec:            sRead.compressionCodec,
+					CompressionLevel: sRead.compressionLevel,
+				})
+			case lsm.Type:
+				ss = append(ss, blobstor.SubStorage{
+					Storage: lsm.New(
+						lsm.WithPath(sRead.path),
+						lsm.WithPerm(sRead.perm),
+						lsm.WithNoSync(sRead.noSync),
+						lsm.WithBlockCacheSize(sRead.blockCacheSize),
+						lsm.WithWriteBufferSize(sRead.writeBufferSize)),
+					Policy: func(_ *objectSDK.Object, data []byte) bool {
+						return uint64(len(data)) < shCfg.smallSizeObjectLimit
+					},
+					Codec:            sRead.compressionCodec,
+					CompressionLevel: sRead.compressionLevel,
+				})
+			case segment.Type:
+				ss = append(ss, blobstor.SubStorage{
+					Storage: segment.New(
+						segment.WithPath(sRead.path),
+						segment.WithPerm(sRead.perm),
+						segment.WithNoSync(sRead.noSync),
+						segment.WithSegmentSize(sRead.segmentSize),
+						segment.WithCompactionThreshold(sRead.compactionThreshold),
+						segment.WithCompactionInterval(sRead.compactionInterval),
+						segment.WithLogger(c.log)),
+					Policy: func(_ *objectSDK.Object, data []byte) bool {
+						return uint64(len(data)) < shCfg.smallSizeObjectLimit
+					},
+					Codec:            sRead.compressionCodec,
+					CompressionLevel: sRead.compressionLevel,
 				})
 			default:
 				// should never happen, that has already
@@ -727,6 +821,9 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 			shard.WithBlobStorOptions(
 				blobstor.WithCompressObjects(shCfg.compress),
 				blobstor.WithUncompressableContentTypes(shCfg.uncompressableContentType),
+				blobstor.WithCompressionCodec(shCfg.compressionCodec, shCfg.compressionLevel),
+				blobstor.WithCompressionRules(shCfg.compressionRules),
+				blobstor.WithAdaptiveCompression(shCfg.compressionAdaptive, shCfg.compressionMinRatio),
 				blobstor.WithStorages(ss),
 
 				blobstor.WithLogger(c.log),
@@ -748,6 +845,8 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 			shard.WithWriteCacheOptions(writeCacheOpts...),
 			shard.WithRemoverBatchSize(shCfg.gcCfg.removerBatchSize),
 			shard.WithGCRemoverSleepInterval(shCfg.gcCfg.removerSleepInterval),
+			shard.WithBlobovniczaCompactionInterval(shCfg.gcCfg.compactionInterval),
+			shard.WithBlobovniczaCompactionThreshold(shCfg.gcCfg.compactionThreshold),
 			shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
 				pool, err := ants.NewPool(sz)
 				fatalOnErr(err)
@@ -12,6 +12,7 @@ import (
 	fstreeconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/fstree"
 	piloramaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/pilorama"
 	configtest "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/test"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/stretchr/testify/require"
 )
@@ -66,6 +67,9 @@ func TestEngineSection(t *testing.T) {
 				require.False(t, pl.NoSync())
 				require.Equal(t, pl.MaxBatchDelay(), 10*time.Millisecond)
 				require.Equal(t, pl.MaxBatchSize(), 200)
+				require.Equal(t, map[string][]string{
+					"version": {"Key", "Version"},
+				}, pl.PathAttributes())
 
 				require.Equal(t, false, wc.Enabled())
 				require.Equal(t, true, wc.NoSync())
@@ -83,6 +87,15 @@ func TestEngineSection(t *testing.T) {
 
 				require.Equal(t, true, sc.Compress())
 				require.Equal(t, []string{"audio/*", "video/*"}, sc.UncompressableContentTypes())
+				require.Equal(t, compression.CodecZstd, sc.CompressionCodec())
+				require.Equal(t, 3, sc.CompressionLevel())
+				require.Equal(t, true, sc.CompressionAdaptive())
+				require.Equal(t, 1.2, sc.CompressionMinRatio())
+				require.Equal(t, []compression.Rule{{
+					ContentTypes: []string{"text/*"},
+					Codec:        compression.CodecZstd,
+					Level:        19,
+				}}, sc.CompressionRules())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
 				require.Equal(t, 2, len(ss))
@@ -93,9 +106,13 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 1, blz.ShallowDepth())
 				require.EqualValues(t, 4, blz.ShallowWidth())
 				require.EqualValues(t, 50, blz.OpenedCacheSize())
+				require.Equal(t, compression.CodecZstd, ss[0].CompressionCodec())
+				require.Equal(t, 9, ss[0].CompressionLevel())
 
 				require.Equal(t, "tmp/0/blob", ss[1].Path())
 				require.EqualValues(t, 0644, ss[1].Perm())
+				require.Equal(t, compression.CodecLZ4, ss[1].CompressionCodec())
+				require.Equal(t, 0, ss[1].CompressionLevel())
 
 				fst := fstreeconfig.From((*config.Config)(ss[1]))
 				require.EqualValues(t, 5, fst.Depth())
@@ -112,6 +129,7 @@ func TestEngineSection(t *testing.T) {
 				require.True(t, pl.NoSync())
 				require.Equal(t, 5*time.Millisecond, pl.MaxBatchDelay())
 				require.Equal(t, 100, pl.MaxBatchSize())
+				require.Nil(t, pl.PathAttributes())
 
 				require.Equal(t, true, wc.Enabled())
 				require.Equal(t, false, wc.NoSync())
@@ -129,6 +147,10 @@ func TestEngineSection(t *testing.T) {
 
 				require.Equal(t, false, sc.Compress())
 				require.Equal(t, []string(nil), sc.UncompressableContentTypes())
+				require.Equal(t, compression.Codec(""), sc.CompressionCodec())
+				require.Equal(t, false, sc.CompressionAdaptive())
+				require.Equal(t, compression.DefaultMinRatio, sc.CompressionMinRatio())
+				require.Equal(t, []compression.Rule(nil), sc.CompressionRules())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
 				require.Equal(t, 2, len(ss))
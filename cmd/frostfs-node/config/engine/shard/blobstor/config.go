@@ -7,6 +7,8 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor/storage"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/lsm"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/segment"
 )
 
 // Config is a wrapper over the config section
@@ -28,7 +30,7 @@ func (x *Config) Storages() []*storage.Config {
 		switch typ {
 		case "":
 			return ss
-		case fstree.Type, blobovniczatree.Type:
+		case fstree.Type, blobovniczatree.Type, lsm.Type, segment.Type:
 			sub := storage.From((*config.Config)(x).Sub(strconv.Itoa(i)))
 			ss = append(ss, sub)
 		default:
@@ -0,0 +1,67 @@
+package lsmconfig
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/lsm"
+)
+
+// Config is a wrapper over the config section
+// which provides access to LSM storage configurations.
+type Config config.Config
+
+const (
+	// BlockCacheSizeDefault is a default size of the block cache.
+	BlockCacheSizeDefault = 8 << 20
+
+	// WriteBufferSizeDefault is a default size of the in-memory table.
+	WriteBufferSizeDefault = 4 << 20
+)
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Type returns the storage type.
+func (x *Config) Type() string {
+	return lsm.Type
+}
+
+// NoSync returns the value of "no_sync" config parameter.
+//
+// Returns false if the value is not a boolean or is missing.
+func (x *Config) NoSync() bool {
+	return config.BoolSafe((*config.Config)(x), "no_sync")
+}
+
+// BlockCacheSize returns the value of "block_cache_size" config parameter.
+//
+// Returns BlockCacheSizeDefault if the value is not a positive number.
+func (x *Config) BlockCacheSize() int {
+	s := config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"block_cache_size",
+	)
+
+	if s > 0 {
+		return int(s)
+	}
+
+	return BlockCacheSizeDefault
+}
+
+// WriteBufferSize returns the value of "write_buffer_size" config parameter.
+//
+// Returns WriteBufferSizeDefault if the value is not a positive number.
+func (x *Config) WriteBufferSize() int {
+	s := config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"write_buffer_size",
+	)
+
+	if s > 0 {
+		return int(s)
+	}
+
+	return WriteBufferSizeDefault
+}
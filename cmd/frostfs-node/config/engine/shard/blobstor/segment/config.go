@@ -0,0 +1,89 @@
+package segmentconfig
+
+import (
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/segment"
+)
+
+// Config is a wrapper over the config section
+// which provides access to segment storage configurations.
+type Config config.Config
+
+const (
+	// SegmentSizeDefault is a default maximum size of a single segment.
+	SegmentSizeDefault = 64 << 20
+
+	// CompactionThresholdDefault is a default ratio of garbage in a segment
+	// which makes it eligible for compaction.
+	CompactionThresholdDefault = 0.5
+
+	// CompactionIntervalDefault is a default interval between compaction runs.
+	CompactionIntervalDefault = time.Minute
+)
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Type returns the storage type.
+func (x *Config) Type() string {
+	return segment.Type
+}
+
+// NoSync returns the value of "no_sync" config parameter.
+//
+// Returns false if the value is not a boolean or is missing.
+func (x *Config) NoSync() bool {
+	return config.BoolSafe((*config.Config)(x), "no_sync")
+}
+
+// SegmentSize returns the value of "segment_size" config parameter.
+//
+// Returns SegmentSizeDefault if the value is not a positive number.
+func (x *Config) SegmentSize() int64 {
+	s := config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"segment_size",
+	)
+
+	if s > 0 {
+		return int64(s)
+	}
+
+	return SegmentSizeDefault
+}
+
+// CompactionThreshold returns the value of "compaction_threshold" config parameter.
+//
+// Returns CompactionThresholdDefault if the value is not in (0, 1] range.
+func (x *Config) CompactionThreshold() float64 {
+	v := config.FloatSafe(
+		(*config.Config)(x),
+		"compaction_threshold",
+	)
+
+	if v > 0 && v <= 1 {
+		return v
+	}
+
+	return CompactionThresholdDefault
+}
+
+// CompactionInterval returns the value of "compaction_interval" config parameter.
+//
+// Returns CompactionIntervalDefault if the value is not a positive duration.
+func (x *Config) CompactionInterval() time.Duration {
+	d := config.DurationSafe(
+		(*config.Config)(x),
+		"compaction_interval",
+	)
+
+	if d > 0 {
+		return d
+	}
+
+	return CompactionIntervalDefault
+}
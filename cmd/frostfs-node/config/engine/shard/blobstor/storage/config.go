@@ -4,6 +4,7 @@ import (
 	"io/fs"
 
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 )
 
 type Config config.Config
@@ -53,3 +54,22 @@ func (x *Config) Perm() fs.FileMode {
 
 	return fs.FileMode(p)
 }
+
+// CompressionCodec returns the value of "compression_codec" config parameter.
+//
+// Returns empty codec if the value is missing, so that
+// the codec of the shard is used.
+func (x *Config) CompressionCodec() compression.Codec {
+	return compression.Codec(config.StringSafe(
+		(*config.Config)(x),
+		"compression_codec"))
+}
+
+// CompressionLevel returns the value of "compression_level" config parameter.
+//
+// Returns 0 if the value is missing or is invalid.
+func (x *Config) CompressionLevel() int {
+	return int(config.IntSafe(
+		(*config.Config)(x),
+		"compression_level"))
+}
@@ -2,6 +2,7 @@ package shardconfig
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
 	blobstorconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/blobstor"
@@ -9,6 +10,7 @@ import (
 	metabaseconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/metabase"
 	piloramaconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/pilorama"
 	writecacheconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/writecache"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 )
 
@@ -43,6 +45,67 @@ func (x *Config) UncompressableContentTypes() []string {
 		"compression_exclude_content_types")
 }
 
+// CompressionCodec returns the value of "compression_codec" config parameter.
+//
+// Returns empty codec if the value is missing or is invalid.
+func (x *Config) CompressionCodec() compression.Codec {
+	return compression.Codec(config.StringSafe(
+		(*config.Config)(x),
+		"compression_codec"))
+}
+
+// CompressionLevel returns the value of "compression_level" config parameter.
+//
+// Returns 0 if the value is missing or is invalid.
+func (x *Config) CompressionLevel() int {
+	return int(config.IntSafe(
+		(*config.Config)(x),
+		"compression_level"))
+}
+
+// CompressionRules returns the value of "compression_rules" config parameter.
+//
+// Rules are read from the consecutive numbered subsections starting from 0.
+func (x *Config) CompressionRules() []compression.Rule {
+	var rules []compression.Rule
+	for i := 0; ; i++ {
+		sub := (*config.Config)(x).Sub("compression_rules").Sub(strconv.Itoa(i))
+
+		codec := config.StringSafe(sub, "codec")
+		if codec == "" {
+			return rules
+		}
+
+		rules = append(rules, compression.Rule{
+			ContentTypes: config.StringSliceSafe(sub, "content_types"),
+			Codec:        compression.Codec(codec),
+			Level:        int(config.IntSafe(sub, "level")),
+		})
+	}
+}
+
+// CompressionAdaptive returns the value of "compression_adaptive" config parameter.
+//
+// Returns false if the value is not a valid bool.
+func (x *Config) CompressionAdaptive() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"compression_adaptive")
+}
+
+// CompressionMinRatio returns the value of "compression_min_ratio" config parameter.
+//
+// Returns compression.DefaultMinRatio if the value is not a positive number.
+func (x *Config) CompressionMinRatio() float64 {
+	r := config.FloatSafe(
+		(*config.Config)(x),
+		"compression_min_ratio")
+	if r > 0 {
+		return r
+	}
+	return compression.DefaultMinRatio
+}
+
 // SmallSizeLimit returns the value of "small_object_size" config parameter.
 //
 // Returns SmallSizeLimitDefault if the value is not a positive number.
@@ -16,6 +16,10 @@ const (
 
 	// RemoverSleepIntervalDefault is a default sleep interval of Shard GC's remover.
 	RemoverSleepIntervalDefault = time.Minute
+
+	// BlobovniczaCompactionThresholdDefault is a default ratio of the stored objects size
+	// to the blobovnicza size below which the blobovnicza is compacted.
+	BlobovniczaCompactionThresholdDefault = 0.5
 )
 
 // From wraps config section into Config.
@@ -56,3 +60,37 @@ func (x *Config) RemoverSleepInterval() time.Duration {
 
 	return RemoverSleepIntervalDefault
 }
+
+// BlobovniczaCompactionInterval returns the value of "blobovnicza_compaction_interval"
+// config parameter.
+//
+// Returns 0 (background compaction is disabled) if the value is not a positive number.
+func (x *Config) BlobovniczaCompactionInterval() time.Duration {
+	s := config.DurationSafe(
+		(*config.Config)(x),
+		"blobovnicza_compaction_interval",
+	)
+
+	if s > 0 {
+		return s
+	}
+
+	return 0
+}
+
+// BlobovniczaCompactionThreshold returns the value of "blobovnicza_compaction_threshold"
+// config parameter.
+//
+// Returns BlobovniczaCompactionThresholdDefault if the value is not in (0, 1] range.
+func (x *Config) BlobovniczaCompactionThreshold() float64 {
+	v := config.FloatSafe(
+		(*config.Config)(x),
+		"blobovnicza_compaction_threshold",
+	)
+
+	if v > 0 && v <= 1 {
+		return v
+	}
+
+	return BlobovniczaCompactionThresholdDefault
+}
@@ -2,6 +2,7 @@ package piloramaconfig
 
 import (
 	"io/fs"
+	"strconv"
 	"time"
 
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
@@ -68,3 +69,24 @@ func (x *Config) MaxBatchSize() int {
 	}
 	return s
 }
+
+// PathAttributes returns the value of "path_attributes" config parameter
+// as a map from the tree ID to the additional attributes indexed for path lookups.
+//
+// Attributes are read from the consecutive numbered subsections starting from 0.
+func (x *Config) PathAttributes() map[string][]string {
+	var m map[string][]string
+	for i := 0; ; i++ {
+		sub := (*config.Config)(x).Sub("path_attributes").Sub(strconv.Itoa(i))
+
+		treeID := config.StringSafe(sub, "tree")
+		if treeID == "" {
+			return m
+		}
+
+		if m == nil {
+			m = make(map[string][]string)
+		}
+		m[treeID] = append(m[treeID], config.StringSliceSafe(sub, "attributes")...)
+	}
+}
@@ -3,6 +3,7 @@ package writecacheconfig
 import (
 	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
 	boltdbconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/engine/shard/boltdb"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
 )
 
 // Config is a wrapper over the config section
@@ -21,6 +22,9 @@ const (
 
 	// SizeLimitDefault is a default write-cache size limit.
 	SizeLimitDefault = 1 << 30
+
+	// TypeDefault is a default write-cache type.
+	TypeDefault = writecache.TypeBBolt
 )
 
 // From wraps config section into Config.
@@ -35,6 +39,22 @@ func (x *Config) Enabled() bool {
 	return config.Bool((*config.Config)(x), "enabled")
 }
 
+// Type returns the value of "type" config parameter.
+//
+// Returns TypeDefault if the value is not a non-empty string.
+func (x *Config) Type() string {
+	t := config.StringSafe(
+		(*config.Config)(x),
+		"type",
+	)
+
+	if t != "" {
+		return t
+	}
+
+	return TypeDefault
+}
+
 // Path returns the value of "path" config parameter.
 //
 // Panics if the value is not a non-empty string.
@@ -30,11 +30,43 @@ type NotificationConfig struct {
 	cfg *config.Config
 }
 
+// EventsConfig is a wrapper over "events" config section of "notification"
+// subsection which provides access to object event stream configuration of node.
+type EventsConfig struct {
+	cfg *config.Config
+}
+
+// WebhookConfig is a wrapper over "webhook" config section of "notification"
+// subsection which provides access to webhook notification sink configuration of node.
+type WebhookConfig struct {
+	cfg *config.Config
+}
+
+// FileConfig is a wrapper over "file" config section of "notification"
+// subsection which provides access to file notification sink configuration of node.
+type FileConfig struct {
+	cfg *config.Config
+}
+
+// EventSubscription describes object events of the container
+// sent to the event stream.
+type EventSubscription struct {
+	// Container is the string representation of the container ID.
+	Container string
+	// Events contains the types of events to send. All events are sent if empty.
+	Events []string
+	// Topic is the topic of the events.
+	Topic string
+}
+
 const (
 	subsection                   = "node"
 	persistentSessionsSubsection = "persistent_sessions"
 	persistentStateSubsection    = "persistent_state"
 	notificationSubsection       = "notification"
+	eventsSubsection             = "events"
+	webhookSubsection            = "webhook"
+	fileSubsection               = "file"
 
 	attributePrefix = "attribute"
 
@@ -272,3 +304,137 @@ func (n NotificationConfig) KeyPath() string {
 func (n NotificationConfig) CAPath() string {
 	return config.StringSafe(n.cfg, "ca")
 }
+
+// Events returns structure that provides access to "events"
+// section of "notification" subsection.
+func (n NotificationConfig) Events() EventsConfig {
+	return EventsConfig{
+		n.cfg.Sub(eventsSubsection),
+	}
+}
+
+// Enabled returns the value of "enabled" config parameter from "events"
+// section of "notification" subsection.
+//
+// Returns false if the value is not presented.
+func (e EventsConfig) Enabled() bool {
+	return config.BoolSafe(e.cfg, "enabled")
+}
+
+// Topic returns the value of "topic" config parameter from "events"
+// section of "notification" subsection.
+//
+// Returns empty string if the value is not presented.
+func (e EventsConfig) Topic() string {
+	return config.StringSafe(e.cfg, "topic")
+}
+
+// QueueSize returns the value of "queue_size" config parameter from "events"
+// section of "notification" subsection.
+//
+// Returns 0 if the value is not presented.
+func (e EventsConfig) QueueSize() int {
+	return int(config.IntSafe(e.cfg, "queue_size"))
+}
+
+// Subscriptions returns the value of "subscriptions" config parameter from "events"
+// section of "notification" subsection.
+//
+// Returns nil if the value is not presented.
+func (e EventsConfig) Subscriptions() []EventSubscription {
+	var res []EventSubscription
+	for i := 0; ; i++ {
+		sub := e.cfg.Sub("subscriptions").Sub(strconv.Itoa(i))
+
+		cnr := config.StringSafe(sub, "container")
+		if cnr == "" {
+			return res
+		}
+
+		res = append(res, EventSubscription{
+			Container: cnr,
+			Events:    config.StringSliceSafe(sub, "events"),
+			Topic:     config.StringSafe(sub, "topic"),
+		})
+	}
+}
+
+// Webhook returns structure that provides access to "webhook"
+// section of "notification" subsection.
+func (n NotificationConfig) Webhook() WebhookConfig {
+	return WebhookConfig{
+		n.cfg.Sub(webhookSubsection),
+	}
+}
+
+// URL returns the value of "url" config parameter from "webhook"
+// section of "notification" subsection.
+//
+// Returns empty string if the value is not presented.
+func (w WebhookConfig) URL() string {
+	return config.StringSafe(w.cfg, "url")
+}
+
+// Secret returns the value of "secret" config parameter from "webhook"
+// section of "notification" subsection.
+//
+// Returns empty string if the value is not presented.
+func (w WebhookConfig) Secret() string {
+	return config.StringSafe(w.cfg, "secret")
+}
+
+// Timeout returns the value of "timeout" config parameter from "webhook"
+// section of "notification" subsection.
+//
+// Returns 0 if the value is not presented.
+func (w WebhookConfig) Timeout() time.Duration {
+	return config.DurationSafe(w.cfg, "timeout")
+}
+
+// QueuePath returns the value of "queue_path" config parameter from "webhook"
+// section of "notification" subsection.
+//
+// Returns empty string if the value is not presented.
+func (w WebhookConfig) QueuePath() string {
+	return config.StringSafe(w.cfg, "queue_path")
+}
+
+// QueueSize returns the value of "queue_size" config parameter from "webhook"
+// section of "notification" subsection.
+//
+// Returns 0 if the value is not presented.
+func (w WebhookConfig) QueueSize() int {
+	return int(config.IntSafe(w.cfg, "queue_size"))
+}
+
+// MinBackoff returns the value of "min_backoff" config parameter from "webhook"
+// section of "notification" subsection.
+//
+// Returns 0 if the value is not presented.
+func (w WebhookConfig) MinBackoff() time.Duration {
+	return config.DurationSafe(w.cfg, "min_backoff")
+}
+
+// MaxBackoff returns the value of "max_backoff" config parameter from "webhook"
+// section of "notification" subsection.
+//
+// Returns 0 if the value is not presented.
+func (w WebhookConfig) MaxBackoff() time.Duration {
+	return config.DurationSafe(w.cfg, "max_backoff")
+}
+
+// File returns structure that provides access to "file"
+// section of "notification" subsection.
+func (n NotificationConfig) File() FileConfig {
+	return FileConfig{
+		n.cfg.Sub(fileSubsection),
+	}
+}
+
+// Path returns the value of "path" config parameter from "file"
+// section of "notification" subsection.
+//
+// Returns empty string if the value is not presented.
+func (f FileConfig) Path() string {
+	return config.StringSafe(f.cfg, "path")
+}
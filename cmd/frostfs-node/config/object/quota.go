@@ -0,0 +1,70 @@
+package objectconfig
+
+import (
+	"strconv"
+
+	"github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config"
+)
+
+// QuotaConfig is a wrapper over "quota" config section which provides access
+// to storage quota configuration of object service.
+type QuotaConfig struct {
+	cfg *config.Config
+}
+
+// OwnerQuota is a storage quota of the container owner.
+type OwnerQuota struct {
+	// Owner is a string-encoded owner ID.
+	Owner string
+	// Soft is a payload size which the owner can exceed with warnings only.
+	Soft uint64
+	// Hard is a payload size after which new objects are not accepted.
+	Hard uint64
+	// SoftObjects is a number of objects which the owner can exceed
+	// with warnings only.
+	SoftObjects uint64
+	// HardObjects is a number of objects after which new objects
+	// are not accepted.
+	HardObjects uint64
+}
+
+const quotaSubsection = "quota"
+
+// Quota returns structure that provides access to "quota" subsection of
+// "object" section.
+func Quota(c *config.Config) QuotaConfig {
+	return QuotaConfig{
+		c.Sub(subsection).Sub(quotaSubsection),
+	}
+}
+
+// Enabled returns the value of "enabled" config parameter.
+//
+// Returns false if the value is not presented.
+func (q QuotaConfig) Enabled() bool {
+	return config.BoolSafe(q.cfg, "enabled")
+}
+
+// Owners returns the value of "owners" config parameter.
+//
+// Returns nil if the value is not presented.
+func (q QuotaConfig) Owners() []OwnerQuota {
+	var res []OwnerQuota
+	for i := 0; ; i++ {
+		sub := q.cfg.Sub("owners").Sub(strconv.Itoa(i))
+
+		owner := config.StringSafe(sub, "owner")
+		if owner == "" {
+			return res
+		}
+
+		res = append(res, OwnerQuota{
+			Owner: owner,
+			Soft:  config.SizeInBytesSafe(sub, "soft_size"),
+			Hard:  config.SizeInBytesSafe(sub, "hard_size"),
+
+			SoftObjects: config.UintSafe(sub, "soft_objects"),
+			HardObjects: config.UintSafe(sub, "hard_objects"),
+		})
+	}
+}
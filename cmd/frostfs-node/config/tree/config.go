@@ -71,3 +71,11 @@ func (c TreeConfig) ReplicationWorkerCount() int {
 func (c TreeConfig) SyncInterval() time.Duration {
 	return config.DurationSafe(c.cfg, "sync_interval")
 }
+
+// CompactionInterval returns the value of "compaction_interval"
+// config parameter from the "tree" section.
+//
+// Returns 0 if config value is not specified.
+func (c TreeConfig) CompactionInterval() time.Duration {
+	return config.DurationSafe(c.cfg, "compaction_interval")
+}
@@ -48,6 +48,7 @@ func initControlService(c *cfg) {
 		controlSvc.WithTreeService(treeSynchronizer{
 			c.treeService,
 		}),
+		controlSvc.WithMaxObjectSizeSource(c),
 	)
 
 	lis, err := net.Listen("tcp", endpoint)
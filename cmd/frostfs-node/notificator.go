@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	nodeconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/node"
@@ -9,7 +10,9 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event/netmap"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator/file"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator/nats"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator/webhook"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -92,19 +95,39 @@ func (n *notificationSource) processAddress(
 	return nil
 }
 
+// notificationSink is a destination of object notifications and events.
+type notificationSink interface {
+	Notify(topic string, address oid.Address) error
+	notificator.EventWriter
+}
+
 type notificationWriter struct {
-	l *logger.Logger
-	w *nats.Writer
+	l     *logger.Logger
+	sinks []notificationSink
 }
 
 func (n notificationWriter) Notify(topic string, address oid.Address) {
-	if err := n.w.Notify(topic, address); err != nil {
-		n.l.Warn("could not write object notification",
-			zap.Stringer("address", address),
-			zap.String("topic", topic),
-			zap.Error(err),
-		)
+	for _, s := range n.sinks {
+		if err := s.Notify(topic, address); err != nil {
+			n.l.Warn("could not write object notification",
+				zap.Stringer("address", address),
+				zap.String("topic", topic),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// NotifyEvent writes the object event to all the sinks
+// and returns the first error encountered.
+func (n notificationWriter) NotifyEvent(topic string, ev notificator.ObjectEvent) error {
+	var firstErr error
+	for _, s := range n.sinks {
+		if err := s.NotifyEvent(topic, ev); err != nil && firstErr == nil {
+			firstErr = err
+		}
 	}
+	return firstErr
 }
 
 func initNotifications(c *cfg) {
@@ -116,26 +139,16 @@ func initNotifications(c *cfg) {
 			topic = pubKey
 		}
 
-		natsSvc := nats.New(
-			nats.WithConnectionName("FrostFS Storage Node: "+pubKey), // connection name is used in the server side logs
-			nats.WithTimeout(nodeconfig.Notification(c.appCfg).Timeout()),
-			nats.WithClientCert(
-				nodeconfig.Notification(c.appCfg).CertPath(),
-				nodeconfig.Notification(c.appCfg).KeyPath(),
-			),
-			nats.WithRootCA(nodeconfig.Notification(c.appCfg).CAPath()),
-			nats.WithLogger(c.log),
-		)
-
 		c.cfgNotifications = cfgNotifications{
 			enabled: true,
 			nw: notificationWriter{
 				l: c.log,
-				w: natsSvc,
 			},
 			defaultTopic: topic,
 		}
 
+		initNotificationSinks(c, pubKey)
+
 		n := notificator.New(new(notificator.Prm).
 			SetLogger(c.log).
 			SetNotificationSource(
@@ -152,16 +165,106 @@ func initNotifications(c *cfg) {
 
 			n.ProcessEpoch(ev.EpochNumber())
 		})
+
+		if eventsCfg := nodeconfig.Notification(c.appCfg).Events(); eventsCfg.Enabled() {
+			initObjectEvents(c, eventsCfg, c.cfgNotifications.nw, topic)
+		}
 	}
 }
 
+// initNotificationSinks creates the configured notification sinks. NATS is used
+// if its endpoint is set or if no other sink is configured.
+func initNotificationSinks(c *cfg, pubKey string) {
+	notificationCfg := nodeconfig.Notification(c.appCfg)
+
+	if webhookCfg := notificationCfg.Webhook(); webhookCfg.URL() != "" {
+		queuePath := webhookCfg.QueuePath()
+		if queuePath == "" {
+			fatalOnErr(errors.New("webhook notification queue path is not set"))
+		}
+
+		webhookSvc, err := webhook.New(webhookCfg.URL(), queuePath,
+			webhook.WithLogger(c.log),
+			webhook.WithSecret([]byte(webhookCfg.Secret())),
+			webhook.WithTimeout(webhookCfg.Timeout()),
+			webhook.WithQueueSize(webhookCfg.QueueSize()),
+			webhook.WithBackoff(webhookCfg.MinBackoff(), webhookCfg.MaxBackoff()),
+		)
+		fatalOnErrDetails("could not initialize webhook notification sink", err)
+
+		c.cfgNotifications.nw.sinks = append(c.cfgNotifications.nw.sinks, webhookSvc)
+		c.workers = append(c.workers, newWorkerFromFunc(webhookSvc.Run))
+	}
+
+	if path := notificationCfg.File().Path(); path != "" {
+		fileSvc, err := file.New(path)
+		fatalOnErrDetails("could not initialize file notification sink", err)
+
+		c.cfgNotifications.nw.sinks = append(c.cfgNotifications.nw.sinks, fileSvc)
+		c.onShutdown(func() {
+			if err := fileSvc.Close(); err != nil {
+				c.log.Warn("could not close notification file", zap.Error(err))
+			}
+		})
+	}
+
+	if notificationCfg.Endpoint() != "" || len(c.cfgNotifications.nw.sinks) == 0 {
+		natsSvc := nats.New(
+			nats.WithConnectionName("FrostFS Storage Node: "+pubKey), // connection name is used in the server side logs
+			nats.WithTimeout(notificationCfg.Timeout()),
+			nats.WithClientCert(
+				notificationCfg.CertPath(),
+				notificationCfg.KeyPath(),
+			),
+			nats.WithRootCA(notificationCfg.CAPath()),
+			nats.WithLogger(c.log),
+		)
+
+		c.cfgNotifications.nats = natsSvc
+		c.cfgNotifications.nw.sinks = append(c.cfgNotifications.nw.sinks, natsSvc)
+	}
+}
+
+func initObjectEvents(c *cfg, eventsCfg nodeconfig.EventsConfig, w notificator.EventWriter, notificationTopic string) {
+	topic := eventsCfg.Topic()
+	if topic == "" {
+		topic = notificationTopic + "_events"
+	}
+
+	subCfgs := eventsCfg.Subscriptions()
+	subs := make([]notificator.Subscription, len(subCfgs))
+	for i := range subCfgs {
+		err := subs[i].Container.DecodeString(subCfgs[i].Container)
+		fatalOnErrDetails("invalid container in object event subscription", err)
+
+		subs[i].Topic = subCfgs[i].Topic
+		for _, s := range subCfgs[i].Events {
+			typ, err := notificator.EventTypeFromString(s)
+			fatalOnErrDetails("invalid object event subscription", err)
+
+			subs[i].Types = append(subs[i].Types, typ)
+		}
+	}
+
+	events := notificator.NewEventNotificator(new(notificator.EventPrm).
+		SetLogger(c.log).
+		SetWriter(w).
+		SetDefaultTopic(topic).
+		SetSubscriptions(subs).
+		SetQueueSize(eventsCfg.QueueSize()),
+	)
+
+	c.cfgNotifications.events = events
+	c.workers = append(c.workers, newWorkerFromFunc(events.Run))
+}
+
 func connectNats(c *cfg) {
-	if !c.cfgNotifications.enabled {
+	if c.cfgNotifications.nats == nil {
 		return
 	}
 
 	endpoint := nodeconfig.Notification(c.appCfg).Endpoint()
-	err := c.cfgNotifications.nw.w.Connect(c.ctx, endpoint)
+	err := c.cfgNotifications.nats.Connect(c.ctx, endpoint)
 	if err != nil {
 		panic(fmt.Sprintf("could not connect to a nats endpoint %s: %v", endpoint, err))
 	}
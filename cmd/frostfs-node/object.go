@@ -20,6 +20,7 @@ import (
 	cntClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/container"
 	nmClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/netmap"
 	objectTransportGRPC "github.com/TrueCloudLab/frostfs-node/pkg/network/transport/object/grpc"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator"
 	objectService "github.com/TrueCloudLab/frostfs-node/pkg/services/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/acl"
 	v2 "github.com/TrueCloudLab/frostfs-node/pkg/services/object/acl/v2"
@@ -262,11 +263,12 @@ func initObjectService(c *cfg) {
 			base:         os,
 			nw:           c.cfgNotifications.nw,
 			ns:           c.cfgNetmap.state,
+			events:       c.cfgNotifications.events,
 			defaultTopic: c.cfgNotifications.defaultTopic,
 		}
 	}
 
-	sPut := putsvc.NewService(
+	putOpts := []putsvc.Option{
 		putsvc.WithKeyStorage(keyStorage),
 		putsvc.WithClientConstructor(putConstructor),
 		putsvc.WithMaxSizeSource(newCachedMaxObjectSizeSource(c)),
@@ -277,7 +279,9 @@ func initObjectService(c *cfg) {
 		putsvc.WithNetworkState(c.cfgNetmap.state),
 		putsvc.WithWorkerPools(c.cfgObject.pool.putRemote, c.cfgObject.pool.putLocal),
 		putsvc.WithLogger(c.log),
-	)
+	}
+
+	sPut := putsvc.NewService(append(putOpts, initQuotas(c)...)...)
 
 	sPutV2 := putsvcV2.NewService(
 		putsvcV2.WithInternalService(sPut),
@@ -551,15 +555,48 @@ type engineWithNotifications struct {
 	nw   notificationWriter
 	ns   netmap.State
 
+	// events is nil if object event stream is disabled.
+	events *notificator.EventNotificator
+
 	defaultTopic string
 }
 
 func (e engineWithNotifications) Delete(tombstone oid.Address, toDelete []oid.ID) error {
-	return e.base.Delete(tombstone, toDelete)
+	if err := e.base.Delete(tombstone, toDelete); err != nil {
+		return err
+	}
+
+	e.notifyTargets(notificator.EventDelete, tombstone, toDelete)
+	return nil
 }
 
 func (e engineWithNotifications) Lock(locker oid.Address, toLock []oid.ID) error {
-	return e.base.Lock(locker, toLock)
+	if err := e.base.Lock(locker, toLock); err != nil {
+		return err
+	}
+
+	e.notifyTargets(notificator.EventLock, locker, toLock)
+	return nil
+}
+
+// notifyTargets emits the event for every object affected by the tombstone or the lock object.
+func (e engineWithNotifications) notifyTargets(typ notificator.EventType, cause oid.Address, targets []oid.ID) {
+	if e.events == nil {
+		return
+	}
+
+	epoch := e.ns.CurrentEpoch()
+	for i := range targets {
+		ev := notificator.ObjectEvent{
+			Type:  typ,
+			Epoch: epoch,
+			Cause: cause.Object(),
+		}
+		ev.Address.SetContainer(cause.Container())
+		ev.Address.SetObject(targets[i])
+
+		e.events.Notify(ev)
+	}
 }
 
 func (e engineWithNotifications) Put(o *objectSDK.Object) error {
@@ -567,6 +604,21 @@ func (e engineWithNotifications) Put(o *objectSDK.Object) error {
 		return err
 	}
 
+	if e.events != nil {
+		ev := notificator.ObjectEvent{
+			Type:        notificator.EventPut,
+			Address:     objectCore.AddressOf(o),
+			Epoch:       e.ns.CurrentEpoch(),
+			ObjectType:  o.Type(),
+			PayloadSize: o.PayloadSize(),
+		}
+		if owner := o.OwnerID(); owner != nil {
+			ev.Owner = *owner
+		}
+
+		e.events.Notify(ev)
+	}
+
 	ni, err := o.NotificationInfo()
 	if err == nil {
 		if epoch := ni.Epoch(); epoch == 0 || epoch == e.ns.CurrentEpoch() {
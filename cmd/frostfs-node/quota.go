@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	objectconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/object"
+	containercore "github.com/TrueCloudLab/frostfs-node/pkg/core/container"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	cntClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/container"
+	"github.com/TrueCloudLab/frostfs-node/pkg/morph/event"
+	netmapEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/netmap"
+	putsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/put"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"go.uber.org/zap"
+)
+
+// estimationEpochDelta is the number of epochs after which
+// the container size estimations are surely collected in
+// the Container contract.
+const estimationEpochDelta = 2
+
+// containerUsage is a putsvc.UsageSource which provides container
+// sizes announced by the storage nodes to the Container contract.
+//
+// Container size is the average of the announced values,
+// the same one is used by the inner ring for the basic income.
+type containerUsage struct {
+	log *logger.Logger
+
+	cnrClient *cntClient.Client
+
+	cnrSrc containercore.Source
+
+	mtx sync.RWMutex
+
+	containers map[cid.ID]uint64
+
+	owners map[string]uint64
+}
+
+// localObjectCounts is a putsvc.ObjectCountSource which provides
+// the number of objects stored in the local storage.
+//
+// Container numbers are read from the storage on each request, owner
+// numbers are summed over the local containers on each new epoch.
+type localObjectCounts struct {
+	log *logger.Logger
+
+	engine *engine.StorageEngine
+
+	cnrSrc containercore.Source
+
+	mtx sync.RWMutex
+
+	owners map[string]uint64
+}
+
+type ownerQuotas map[string]putsvc.Quota
+
+func (q ownerQuotas) OwnerQuota(id user.ID) putsvc.Quota {
+	return q[id.EncodeToString()]
+}
+
+func (u *containerUsage) ContainerUsage(id cid.ID) (uint64, error) {
+	u.mtx.RLock()
+	defer u.mtx.RUnlock()
+
+	return u.containers[id], nil
+}
+
+func (u *containerUsage) OwnerUsage(id user.ID) (uint64, error) {
+	u.mtx.RLock()
+	defer u.mtx.RUnlock()
+
+	return u.owners[id.EncodeToString()], nil
+}
+
+// update reads the container size estimations of the epoch
+// and replaces the current values on success.
+func (u *containerUsage) update(epoch uint64) {
+	ids, err := u.cnrClient.ListLoadEstimationsByEpoch(epoch)
+	if err != nil {
+		u.log.Warn("could not list container size estimations",
+			zap.Uint64("epoch", epoch),
+			zap.Error(err),
+		)
+		return
+	}
+
+	containers := make(map[cid.ID]uint64, len(ids))
+	owners := make(map[string]uint64)
+
+	for i := range ids {
+		e, err := u.cnrClient.GetUsedSpaceEstimations(ids[i])
+		if err != nil {
+			u.log.Warn("could not get container size estimation",
+				zap.String("estimation_id", hex.EncodeToString(ids[i])),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if _, ok := containers[e.ContainerID]; ok || len(e.Values) == 0 {
+			continue
+		}
+
+		var size uint64
+		for j := range e.Values {
+			size += e.Values[j].Size
+		}
+		size /= uint64(len(e.Values))
+
+		containers[e.ContainerID] = size
+
+		cnr, err := u.cnrSrc.Get(e.ContainerID)
+		if err != nil {
+			// removed containers are not counted for the owner
+			u.log.Debug("could not get container for the size estimation",
+				zap.Stringer("cid", e.ContainerID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		owners[cnr.Value.Owner().EncodeToString()] += size
+	}
+
+	u.mtx.Lock()
+	u.containers, u.owners = containers, owners
+	u.mtx.Unlock()
+
+	u.log.Debug("container usage updated",
+		zap.Uint64("epoch", epoch),
+		zap.Int("containers", len(containers)),
+	)
+}
+
+func (c *localObjectCounts) ContainerObjects(id cid.ID) (uint64, error) {
+	var prm engine.ContainerSizePrm
+	prm.SetContainerID(id)
+
+	res, err := c.engine.ContainerSize(prm)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ObjectCount(), nil
+}
+
+func (c *localObjectCounts) OwnerObjects(id user.ID) (uint64, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return c.owners[id.EncodeToString()], nil
+}
+
+// update sums the number of objects in the local containers
+// of each owner and replaces the current values on success.
+func (c *localObjectCounts) update() {
+	ids, err := engine.ListContainers(c.engine)
+	if err != nil {
+		c.log.Warn("could not list local containers", zap.Error(err))
+		return
+	}
+
+	owners := make(map[string]uint64)
+
+	for i := range ids {
+		count, err := c.ContainerObjects(ids[i])
+		if err != nil {
+			c.log.Warn("could not get the number of objects in the container",
+				zap.Stringer("cid", ids[i]),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if count == 0 {
+			continue
+		}
+
+		cnr, err := c.cnrSrc.Get(ids[i])
+		if err != nil {
+			// removed containers are not counted for the owner
+			c.log.Debug("could not get container for the object counting",
+				zap.Stringer("cid", ids[i]),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		owners[cnr.Value.Owner().EncodeToString()] += count
+	}
+
+	c.mtx.Lock()
+	c.owners = owners
+	c.mtx.Unlock()
+}
+
+// initQuotas returns put service options to check the storage quotas
+// if they are enabled in the config.
+func initQuotas(c *cfg) []putsvc.Option {
+	quotaCfg := objectconfig.Quota(c.appCfg)
+	if !quotaCfg.Enabled() {
+		return nil
+	}
+
+	u := &containerUsage{
+		log:       c.log,
+		cnrClient: c.shared.cnrClient,
+		cnrSrc:    c.cfgObject.cnrSource,
+	}
+
+	counts := &localObjectCounts{
+		log:    c.log,
+		engine: c.cfgObject.cfgLocalStorage.localStorage,
+		cnrSrc: c.cfgObject.cnrSource,
+	}
+
+	updateForEpoch := func(epoch uint64) {
+		if epoch >= estimationEpochDelta {
+			u.update(epoch - estimationEpochDelta)
+		}
+
+		counts.update()
+	}
+
+	c.workers = append(c.workers, newWorkerFromFunc(func(context.Context) {
+		updateForEpoch(c.cfgNetmap.state.CurrentEpoch())
+	}))
+
+	addNewEpochAsyncNotificationHandler(c, func(ev event.Event) {
+		updateForEpoch(ev.(netmapEvent.NewEpoch).EpochNumber())
+	})
+
+	opts := []putsvc.Option{
+		putsvc.WithUsageSource(u),
+		putsvc.WithObjectCountSource(counts),
+	}
+
+	owners := quotaCfg.Owners()
+	if len(owners) == 0 {
+		return opts
+	}
+
+	q := make(ownerQuotas, len(owners))
+	for i := range owners {
+		var id user.ID
+
+		err := id.DecodeString(owners[i].Owner)
+		fatalOnErrDetails(fmt.Sprintf("invalid owner of the storage quota #%d", i), err)
+
+		q[id.EncodeToString()] = putsvc.Quota{
+			Soft:        owners[i].Soft,
+			Hard:        owners[i].Hard,
+			SoftObjects: owners[i].SoftObjects,
+			HardObjects: owners[i].HardObjects,
+		}
+	}
+
+	return append(opts, putsvc.WithOwnerQuotaSource(q))
+}
@@ -41,7 +41,7 @@ func initTreeService(c *cfg) {
 		return
 	}
 
-	c.treeService = tree.New(
+	opts := []tree.Option{
 		tree.WithContainerSource(cnrSource{
 			src: c.cfgObject.cnrSource,
 			cli: c.shared.cnrClient,
@@ -54,7 +54,14 @@ func initTreeService(c *cfg) {
 		tree.WithContainerCacheSize(treeConfig.CacheSize()),
 		tree.WithReplicationTimeout(treeConfig.ReplicationTimeout()),
 		tree.WithReplicationChannelCapacity(treeConfig.ReplicationChannelCapacity()),
-		tree.WithReplicationWorkerCount(treeConfig.ReplicationWorkerCount()))
+		tree.WithReplicationWorkerCount(treeConfig.ReplicationWorkerCount()),
+		tree.WithCompactionInterval(treeConfig.CompactionInterval()),
+	}
+	if c.metricsCollector != nil {
+		opts = append(opts, tree.WithMetrics(c.metricsCollector))
+	}
+
+	c.treeService = tree.New(opts...)
 
 	for _, srv := range c.cfgGRPC.servers {
 		tree.RegisterTreeServiceServer(srv, c.treeService)
@@ -11,6 +11,9 @@ import (
 	treeconfig "github.com/TrueCloudLab/frostfs-node/cmd/frostfs-node/config/tree"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/lsm"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/segment"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 )
 
@@ -31,6 +34,13 @@ func validateConfig(c *config.Config) error {
 	paths := make(map[string]pathDescription)
 	return engineconfig.IterateShards(c, false, func(sc *shardconfig.Config) error {
 		if sc.WriteCache().Enabled() {
+			switch sc.WriteCache().Type() {
+			case writecache.TypeBBolt, writecache.TypeLog:
+			default:
+				return fmt.Errorf("unexpected write-cache type: %s (shard %d)",
+					sc.WriteCache().Type(), shardNum)
+			}
+
 			err := addPath(paths, "writecache", shardNum, sc.WriteCache().Path())
 			if err != nil {
 				return err
@@ -49,6 +59,15 @@ func validateConfig(c *config.Config) error {
 			}
 		}
 
+		if err := sc.CompressionCodec().Validate(); err != nil {
+			return fmt.Errorf("%w (shard %d)", err, shardNum)
+		}
+		for _, rule := range sc.CompressionRules() {
+			if err := rule.Codec.Validate(); err != nil {
+				return fmt.Errorf("%w (shard %d)", err, shardNum)
+			}
+		}
+
 		blobstor := sc.BlobStor().Storages()
 		if len(blobstor) != 2 {
 			// TODO (@fyrcik): remove after #1522
@@ -56,7 +75,7 @@ func validateConfig(c *config.Config) error {
 		}
 		for i := range blobstor {
 			switch blobstor[i].Type() {
-			case fstree.Type, blobovniczatree.Type:
+			case fstree.Type, blobovniczatree.Type, lsm.Type, segment.Type:
 			default:
 				// FIXME #1764 (@fyrchik): this line is currently unreachable,
 				//   because we panic in `sc.BlobStor().Storages()`.
@@ -68,6 +87,9 @@ func validateConfig(c *config.Config) error {
 					"expected at least rw- for the owner (shard %d)",
 					blobstor[i].Perm(), shardNum)
 			}
+			if err := blobstor[i].CompressionCodec().Validate(); err != nil {
+				return fmt.Errorf("%w (shard %d)", err, shardNum)
+			}
 			if blobstor[i].Path() == "" {
 				return fmt.Errorf("blobstor component path is empty (shard %d)", shardNum)
 			}
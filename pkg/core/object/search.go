@@ -0,0 +1,57 @@
+package object
+
+import (
+	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+)
+
+// X-headers of the search request to get the results page by page.
+// The objects are returned in the ascending order of their identifiers.
+const (
+	// XHeaderSearchCursor is an X-header with the base58-encoded
+	// identifier of the last object from the previous page.
+	XHeaderSearchCursor = session.ReservedXHeaderPrefix + "SEARCH_CURSOR"
+	// XHeaderSearchLimit is an X-header with the decimal
+	// maximum number of the returned objects.
+	XHeaderSearchLimit = session.ReservedXHeaderPrefix + "SEARCH_LIMIT"
+)
+
+// Numeric search match types. Filter and attribute values are compared
+// as decimal integers of arbitrary length.
+//
+// The values follow the FrostFS API specification. They are not known to the SDK,
+// so SearchFiltersFromV2 and SearchFiltersToV2 must be used for the conversion.
+const (
+	MatchNumGT object.SearchMatchType = object.MatchCommonPrefix + 1 + iota
+	MatchNumGE
+	MatchNumLT
+	MatchNumLE
+)
+
+// IsNumericMatch checks whether m is a numeric search match type.
+func IsNumericMatch(m object.SearchMatchType) bool {
+	return MatchNumGT <= m && m <= MatchNumLE
+}
+
+// SearchFiltersFromV2 converts search filters from the message structure.
+// Unlike object.NewSearchFiltersFromV2, numeric match types are preserved.
+func SearchFiltersFromV2(fs []v2object.SearchFilter) object.SearchFilters {
+	res := make(object.SearchFilters, 0, len(fs))
+	for i := range fs {
+		res.AddFilter(fs[i].GetKey(), fs[i].GetValue(), object.SearchMatchType(fs[i].GetMatchType()))
+	}
+	return res
+}
+
+// SearchFiltersToV2 converts search filters to the message structure.
+// Unlike object.SearchFilters.ToV2, numeric match types are preserved.
+func SearchFiltersToV2(fs object.SearchFilters) []v2object.SearchFilter {
+	res := make([]v2object.SearchFilter, len(fs))
+	for i := range fs {
+		res[i].SetKey(fs[i].Header())
+		res[i].SetValue(fs[i].Value())
+		res[i].SetMatchType(v2object.MatchType(fs[i].Operation()))
+	}
+	return res
+}
@@ -0,0 +1,30 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchFiltersV2(t *testing.T) {
+	var fs object.SearchFilters
+	fs.AddFilter("a", "1", object.MatchStringEqual)
+	fs.AddFilter("b", "10", MatchNumGT)
+	fs.AddFilter("c", "20", MatchNumLE)
+
+	v2 := SearchFiltersToV2(fs)
+	require.Len(t, v2, len(fs))
+
+	res := SearchFiltersFromV2(v2)
+	require.Len(t, res, len(fs))
+	for i := range fs {
+		require.Equal(t, fs[i].Header(), res[i].Header())
+		require.Equal(t, fs[i].Value(), res[i].Value())
+		require.Equal(t, fs[i].Operation(), res[i].Operation())
+	}
+
+	require.False(t, IsNumericMatch(object.MatchCommonPrefix))
+	require.True(t, IsNumericMatch(MatchNumGE))
+	require.True(t, IsNumericMatch(MatchNumLT))
+}
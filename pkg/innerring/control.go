@@ -0,0 +1,175 @@
+package innerring
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	nmClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/netmap"
+	control "github.com/TrueCloudLab/frostfs-node/pkg/services/control/ir"
+	"go.uber.org/zap"
+)
+
+var errNotAlphabet = errors.New("node is not in the alphabet")
+
+// poolStater is an event processor with the worker pool.
+type poolStater interface {
+	PoolState() (running, capacity int)
+}
+
+type processorPool struct {
+	name string
+	p    poolStater
+}
+
+func (s *Server) addProcessorPool(name string, p poolStater) {
+	s.processorPools = append(s.processorPools, processorPool{name: name, p: p})
+}
+
+// TickEpoch invokes the new epoch method of the Netmap contract
+// with the epoch following the current one.
+//
+// The epoch is switched when the majority of the alphabet nodes
+// have done the same.
+func (s *Server) TickEpoch() error {
+	if !s.IsAlphabet() {
+		return errNotAlphabet
+	}
+
+	nextEpoch := s.EpochCounter() + 1
+	s.log.Info("forced new epoch tick", zap.Uint64("value", nextEpoch))
+
+	target := make([]byte, 8)
+	binary.LittleEndian.PutUint64(target, nextEpoch)
+
+	nonce, vub, err := s.controlNonceAndVUB(target)
+	if err != nil {
+		return err
+	}
+
+	var prm nmClient.NewEpochPrm
+	prm.SetEpoch(nextEpoch)
+	prm.SetNonceAndVUB(nonce, vub)
+
+	if err := s.netmapClient.NewEpoch(prm); err != nil {
+		return fmt.Errorf("can't invoke netmap.NewEpoch: %w", err)
+	}
+	return nil
+}
+
+// RemoveNode switches the storage node with the given public key to offline
+// state in the Netmap contract.
+//
+// The node is removed when the majority of the alphabet nodes
+// have done the same.
+func (s *Server) RemoveNode(key []byte) error {
+	if !s.IsAlphabet() {
+		return errNotAlphabet
+	}
+
+	nm, err := s.netmapClient.NetMap()
+	if err != nil {
+		return fmt.Errorf("can't get netmap snapshot: %w", err)
+	}
+
+	var found bool
+	nodes := nm.Nodes()
+	for i := range nodes {
+		if found = bytes.Equal(nodes[i].PublicKey(), key); found {
+			break
+		}
+	}
+
+	if !found {
+		return errors.New("node is not in the network map")
+	}
+
+	s.log.Info("forced node removal", zap.String("key", hex.EncodeToString(key)))
+
+	target := make([]byte, 8, 8+len(key))
+	binary.LittleEndian.PutUint64(target, s.EpochCounter())
+
+	nonce, vub, err := s.controlNonceAndVUB(append(target, key...))
+	if err != nil {
+		return err
+	}
+
+	var prm nmClient.UpdatePeerPrm
+	prm.SetKey(key)
+	prm.SetNonceAndVUB(nonce, vub)
+
+	if err := s.netmapClient.UpdatePeerState(prm); err != nil {
+		return fmt.Errorf("can't invoke netmap.UpdateState: %w", err)
+	}
+	return nil
+}
+
+// controlNonceAndVUB returns nonce and `validUntilBlock` values of the notary
+// request made by the control service. Alphabet nodes are called independently,
+// but they must create the same request for it to be accepted, so the values
+// are derived from the data they agree on: the target of the request and
+// the block of the last epoch. The request is valid until the end of the
+// period following the one it is made in, periods are epoch duration long
+// and start from the block of the last epoch.
+func (s *Server) controlNonceAndVUB(target []byte) (uint32, uint32, error) {
+	epochBlock, err := s.netmapClient.LastEpochBlock()
+	if err != nil {
+		return 0, 0, fmt.Errorf("can't read last epoch block: %w", err)
+	}
+
+	height, err := s.morphClient.BlockCount()
+	if err != nil {
+		return 0, 0, fmt.Errorf("can't get side chain height: %w", err)
+	}
+
+	period := uint32(s.epochDuration.Load())
+	if period == 0 {
+		return 0, 0, errors.New("epoch duration is not set")
+	}
+
+	var passed uint32
+	if height > epochBlock {
+		passed = (height - epochBlock) / period
+	}
+
+	h := sha256.Sum256(target)
+
+	return binary.LittleEndian.Uint32(h[:]), epochBlock + (passed+2)*period, nil
+}
+
+// NotaryRequests returns notary requests pending in the sidechain notary pool.
+func (s *Server) NotaryRequests() ([]*control.NotaryRequestInfo, error) {
+	requests, err := s.morphListener.NotaryRequests()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*control.NotaryRequestInfo, len(requests))
+	for i := range requests {
+		res[i] = new(control.NotaryRequestInfo)
+		res[i].SetMainHash(requests[i].MainHash.BytesBE())
+		res[i].SetFallbackHash(requests[i].FallbackHash.BytesBE())
+		res[i].SetSender(requests[i].Sender.BytesBE())
+		res[i].SetValidUntilBlock(requests[i].ValidUntilBlock)
+	}
+
+	return res, nil
+}
+
+// ProcessorQueues returns worker pool states of the event processors.
+func (s *Server) ProcessorQueues() []*control.ProcessorQueue {
+	res := make([]*control.ProcessorQueue, len(s.processorPools))
+	for i := range s.processorPools {
+		running, capacity := s.processorPools[i].p.PoolState()
+
+		res[i] = new(control.ProcessorQueue)
+		res[i].SetName(s.processorPools[i].name)
+		res[i].SetRunning(uint32(running))
+		res[i].SetCapacity(uint32(capacity))
+	}
+
+	return res
+}
@@ -100,6 +100,7 @@ type (
 
 		// runtime processors
 		netmapProcessor *netmap.Processor
+		processorPools  []processorPool
 
 		workers []func(context.Context)
 
@@ -602,6 +603,8 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessorPool("audit", auditProcessor)
+
 	// create settlement processor dependencies
 	settlementDeps := settlementDeps{
 		log:           server.log,
@@ -646,11 +649,18 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		settlement.WithLogger(server.log),
 	)
 
+	server.addProcessorPool("settlement", settlementProcessor)
+
 	locodeValidator, err := server.newLocodeValidator(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	rulesValidator, err := server.newRulesValidator(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("ir: can't create node validation rules: %w", err)
+	}
+
 	subnetValidator, err := subnetvalidator.New(
 		subnetvalidator.Prm{
 			SubnetClient: subnetClient,
@@ -689,6 +699,8 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		if err != nil {
 			return nil, err
 		}
+
+		server.addProcessorPool("governance", governanceProcessor)
 	}
 
 	netSettings := (*networkSettings)(server.netmapClient)
@@ -722,6 +734,7 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 			addrvalidator.New(),
 			locodeValidator,
 			subnetValidator,
+			rulesValidator,
 		),
 		NotaryDisabled: server.sideNotaryConfig.disabled,
 		SubnetContract: &server.contracts.subnet,
@@ -737,6 +750,8 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessorPool("netmap", server.netmapProcessor)
+
 	// container processor
 	containerProcessor, err := container.New(&container.Params{
 		Log:             log,
@@ -757,6 +772,8 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessorPool("container", containerProcessor)
+
 	// create balance processor
 	balanceProcessor, err := balance.New(&balance.Params{
 		Log:           log,
@@ -775,6 +792,8 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessorPool("balance", balanceProcessor)
+
 	if !server.withoutMainNet {
 		// create mainnnet frostfs processor
 		frostfsProcessor, err := frostfs.New(&frostfs.Params{
@@ -801,6 +820,8 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		if err != nil {
 			return nil, err
 		}
+
+		server.addProcessorPool("frostfs", frostfsProcessor)
 	}
 
 	// create alphabet processor
@@ -822,6 +843,8 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessorPool("alphabet", alphabetProcessor)
+
 	// create reputation processor
 	reputationProcessor, err := reputation.New(&reputation.Params{
 		Log:               log,
@@ -845,6 +868,8 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 		return nil, err
 	}
 
+	server.addProcessorPool("reputation", reputationProcessor)
+
 	// initialize epoch timers
 	server.epochTimer = newEpochTimer(&epochTimerArgs{
 		l:                  server.log,
@@ -896,6 +921,10 @@ func New(ctx context.Context, log *logger.Logger, cfg *viper.Viper, errChan chan
 
 		p.SetPrivateKey(*server.key)
 		p.SetHealthChecker(server)
+		p.SetEpochTicker(server)
+		p.SetNodeRemover(server)
+		p.SetNotaryRequestLister(server)
+		p.SetProcessorQueueLister(server)
 
 		controlSvc := controlsrv.New(p,
 			controlsrv.WithAllowedKeys(authKeys),
@@ -0,0 +1,125 @@
+package innerring
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/netmap"
+	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/netmap/nodevalidation/rules"
+	"github.com/spf13/viper"
+)
+
+const nodeValidationSection = "node_validation"
+
+func (s *Server) newRulesValidator(cfg *viper.Viper) (netmap.NodeValidator, error) {
+	var (
+		prm rules.Prm
+		err error
+	)
+
+	prm.Attributes, err = parseAttributeRules(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	prm.AllowedKeys, err = parseHexKeys(cfg, nodeValidationSection+".keys.allowed")
+	if err != nil {
+		return nil, err
+	}
+
+	prm.DeniedKeys, err = parseHexKeys(cfg, nodeValidationSection+".keys.denied")
+	if err != nil {
+		return nil, err
+	}
+
+	prm.AllowedNetworks, err = parseNetworks(cfg, nodeValidationSection+".networks.allowed")
+	if err != nil {
+		return nil, err
+	}
+
+	prm.DeniedNetworks, err = parseNetworks(cfg, nodeValidationSection+".networks.denied")
+	if err != nil {
+		return nil, err
+	}
+
+	prm.OnReject = func(r rules.Reason) {
+		if s.metrics != nil {
+			s.metrics.IncRejectedNodes(string(r))
+		}
+	}
+
+	return rules.New(prm), nil
+}
+
+func parseAttributeRules(cfg *viper.Viper) ([]rules.AttributeRule, error) {
+	var res []rules.AttributeRule
+
+	section := nodeValidationSection + ".attributes"
+	for i := 0; ; i++ {
+		prefix := fmt.Sprintf("%s.%d.", section, i)
+
+		key := cfg.GetString(prefix + "key")
+		if key == "" {
+			return res, nil
+		}
+
+		r := rules.AttributeRule{
+			Key:      key,
+			Required: cfg.GetBool(prefix + "required"),
+		}
+
+		if pattern := cfg.GetString(prefix + "pattern"); pattern != "" {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern of %s attribute rule: %w", key, err)
+			}
+			r.Pattern = re
+		}
+
+		if cfg.IsSet(prefix + "min") {
+			v := cfg.GetFloat64(prefix + "min")
+			r.Min = &v
+		}
+
+		if cfg.IsSet(prefix + "max") {
+			v := cfg.GetFloat64(prefix + "max")
+			r.Max = &v
+		}
+
+		res = append(res, r)
+	}
+}
+
+func parseHexKeys(cfg *viper.Viper, name string) ([][]byte, error) {
+	strs := cfg.GetStringSlice(name)
+	res := make([][]byte, 0, len(strs))
+
+	for i := range strs {
+		key, err := hex.DecodeString(strs[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s key %s: %w", name, strs[i], err)
+		}
+
+		res = append(res, key)
+	}
+
+	return res, nil
+}
+
+func parseNetworks(cfg *viper.Viper, name string) ([]*net.IPNet, error) {
+	strs := cfg.GetStringSlice(name)
+	res := make([]*net.IPNet, 0, len(strs))
+
+	for i := range strs {
+		_, ipNet, err := net.ParseCIDR(strs[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s network %s: %w", name, strs[i], err)
+		}
+
+		res = append(res, ipNet)
+	}
+
+	return res, nil
+}
@@ -107,3 +107,8 @@ func (ap *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (ap *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns the number of busy workers and the capacity of the worker pool.
+func (ap *Processor) PoolState() (running, capacity int) {
+	return ap.pool.Running(), ap.pool.Cap()
+}
@@ -146,3 +146,8 @@ func (r *epochAuditReporter) WriteReport(rep *audit.Report) error {
 
 	return r.rep.WriteReport(rep)
 }
+
+// PoolState returns the number of busy workers and the capacity of the worker pool.
+func (ap *Processor) PoolState() (running, capacity int) {
+	return ap.pool.Running(), ap.pool.Cap()
+}
@@ -119,3 +119,8 @@ func (bp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (bp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns the number of busy workers and the capacity of the worker pool.
+func (bp *Processor) PoolState() (running, capacity int) {
+	return bp.pool.Running(), bp.pool.Cap()
+}
@@ -242,3 +242,8 @@ func (cp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (cp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns the number of busy workers and the capacity of the worker pool.
+func (cp *Processor) PoolState() (running, capacity int) {
+	return cp.pool.Running(), cp.pool.Cap()
+}
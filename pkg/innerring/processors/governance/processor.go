@@ -166,3 +166,8 @@ func (gp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (gp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns the number of busy workers and the capacity of the worker pool.
+func (gp *Processor) PoolState() (running, capacity int) {
+	return gp.pool.Running(), gp.pool.Cap()
+}
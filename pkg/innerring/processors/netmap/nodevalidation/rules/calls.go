@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/network"
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+)
+
+// Reason is the reason of the node rejection.
+type Reason string
+
+const (
+	// ReasonMissingAttribute means the required attribute is not set.
+	ReasonMissingAttribute Reason = "missing_attribute"
+	// ReasonAttributePattern means the attribute value doesn't match the pattern.
+	ReasonAttributePattern Reason = "attribute_pattern"
+	// ReasonAttributeRange means the attribute value is not a number within the bounds.
+	ReasonAttributeRange Reason = "attribute_range"
+	// ReasonKeyNotAllowed means the node key is not in the allow list.
+	ReasonKeyNotAllowed Reason = "key_not_allowed"
+	// ReasonKeyDenied means the node key is in the deny list.
+	ReasonKeyDenied Reason = "key_denied"
+	// ReasonNetworkNotAllowed means the node address is not in the allowed networks.
+	ReasonNetworkNotAllowed Reason = "network_not_allowed"
+	// ReasonNetworkDenied means the node address is in the denied networks.
+	ReasonNetworkDenied Reason = "network_denied"
+)
+
+// RejectionError is returned by the Validator if the node
+// does not satisfy the rules.
+type RejectionError struct {
+	Reason Reason
+	msg    string
+}
+
+func (e *RejectionError) Error() string {
+	return fmt.Sprintf("node rejected by rule (%s): %s", e.Reason, e.msg)
+}
+
+// VerifyAndUpdate checks the node against the configured rules in the
+// following order: public key lists, attributes, network addresses.
+//
+// Returns RejectionError with the first violated rule.
+func (v *Validator) VerifyAndUpdate(n *netmap.NodeInfo) error {
+	if err := v.verify(n); err != nil {
+		if v.onReject != nil {
+			v.onReject(err.Reason)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (v *Validator) verify(n *netmap.NodeInfo) *RejectionError {
+	key := string(n.PublicKey())
+	if v.allowedKeys != nil {
+		if _, ok := v.allowedKeys[key]; !ok {
+			return reject(ReasonKeyNotAllowed, "public key is not in the allow list")
+		}
+	}
+	if _, ok := v.deniedKeys[key]; ok {
+		return reject(ReasonKeyDenied, "public key is in the deny list")
+	}
+
+	for i := range v.attributes {
+		if err := v.attributes[i].verify(n); err != nil {
+			return err
+		}
+	}
+
+	if len(v.allowedNetworks) == 0 && len(v.deniedNetworks) == 0 {
+		return nil
+	}
+
+	var err *RejectionError
+	n.IterateNetworkEndpoints(func(s string) bool {
+		err = v.verifyEndpoint(s)
+		return err != nil
+	})
+	return err
+}
+
+func (r AttributeRule) verify(n *netmap.NodeInfo) *RejectionError {
+	val := n.Attribute(r.Key)
+	if val == "" {
+		if r.Required {
+			return reject(ReasonMissingAttribute, fmt.Sprintf("attribute %s is not set", r.Key))
+		}
+		return nil
+	}
+
+	if r.Pattern != nil && !r.Pattern.MatchString(val) {
+		return reject(ReasonAttributePattern,
+			fmt.Sprintf("attribute %s value %q does not match %s", r.Key, val, r.Pattern))
+	}
+
+	if r.Min == nil && r.Max == nil {
+		return nil
+	}
+
+	num, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return reject(ReasonAttributeRange, fmt.Sprintf("attribute %s value %q is not a number", r.Key, val))
+	}
+	if r.Min != nil && num < *r.Min {
+		return reject(ReasonAttributeRange, fmt.Sprintf("attribute %s value %s is less than %v", r.Key, val, *r.Min))
+	}
+	if r.Max != nil && num > *r.Max {
+		return reject(ReasonAttributeRange, fmt.Sprintf("attribute %s value %s is greater than %v", r.Key, val, *r.Max))
+	}
+
+	return nil
+}
+
+func (v *Validator) verifyEndpoint(s string) *RejectionError {
+	var addr network.Address
+	if err := addr.FromString(s); err != nil {
+		return reject(ReasonNetworkNotAllowed, fmt.Sprintf("invalid address %s: %v", s, err))
+	}
+
+	ip := addr.IP()
+	if ip == nil {
+		// DNS names can't be checked against the ranges
+		if len(v.allowedNetworks) != 0 {
+			return reject(ReasonNetworkNotAllowed, fmt.Sprintf("address %s is not an IP address", s))
+		}
+		return nil
+	}
+
+	for i := range v.deniedNetworks {
+		if v.deniedNetworks[i].Contains(ip) {
+			return reject(ReasonNetworkDenied, fmt.Sprintf("address %s is in the denied network %s", s, v.deniedNetworks[i]))
+		}
+	}
+
+	if len(v.allowedNetworks) == 0 {
+		return nil
+	}
+
+	for i := range v.allowedNetworks {
+		if v.allowedNetworks[i].Contains(ip) {
+			return nil
+		}
+	}
+
+	return reject(ReasonNetworkNotAllowed, fmt.Sprintf("address %s is not in the allowed networks", s))
+}
+
+func reject(reason Reason, msg string) *RejectionError {
+	return &RejectionError{Reason: reason, msg: msg}
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"net"
+	"regexp"
+)
+
+// AttributeRule describes requirements to the node attribute.
+type AttributeRule struct {
+	// Key is the attribute key.
+	Key string
+
+	// Required makes the node without the attribute invalid.
+	Required bool
+
+	// Pattern is the regular expression which the attribute value must match.
+	// Not checked if nil.
+	Pattern *regexp.Regexp
+
+	// Min is the minimum numeric value of the attribute. Not checked if nil.
+	Min *float64
+
+	// Max is the maximum numeric value of the attribute. Not checked if nil.
+	Max *float64
+}
+
+// Prm groups the parameters of the Validator's constructor.
+// All fields are optional.
+type Prm struct {
+	// Attributes are the rules of the node attributes.
+	Attributes []AttributeRule
+
+	// AllowedKeys are the public keys of the nodes allowed to enter
+	// the network map. All keys are allowed if empty.
+	AllowedKeys [][]byte
+
+	// DeniedKeys are the public keys of the nodes denied to enter
+	// the network map.
+	DeniedKeys [][]byte
+
+	// AllowedNetworks are the IP ranges which all node addresses must belong to.
+	// Any address is allowed if empty.
+	AllowedNetworks []*net.IPNet
+
+	// DeniedNetworks are the IP ranges which node addresses must not belong to.
+	DeniedNetworks []*net.IPNet
+
+	// OnReject is called with the reason of every node rejection.
+	OnReject func(Reason)
+}
+
+// Validator is an utility that verifies node information
+// against the configured admission rules.
+//
+// For correct operation, the Validator must be created
+// using the constructor (New). After successful creation,
+// the Validator is immediately ready to work through API.
+type Validator struct {
+	attributes []AttributeRule
+
+	allowedKeys map[string]struct{}
+	deniedKeys  map[string]struct{}
+
+	allowedNetworks []*net.IPNet
+	deniedNetworks  []*net.IPNet
+
+	onReject func(Reason)
+}
+
+// New creates a new instance of the Validator.
+//
+// The created Validator does not require additional
+// initialization and is completely ready for work.
+func New(prm Prm) *Validator {
+	v := &Validator{
+		attributes:      prm.Attributes,
+		allowedNetworks: prm.AllowedNetworks,
+		deniedNetworks:  prm.DeniedNetworks,
+		onReject:        prm.OnReject,
+	}
+
+	if len(prm.AllowedKeys) != 0 {
+		v.allowedKeys = keySet(prm.AllowedKeys)
+	}
+	if len(prm.DeniedKeys) != 0 {
+		v.deniedKeys = keySet(prm.DeniedKeys)
+	}
+
+	return v
+}
+
+func keySet(keys [][]byte) map[string]struct{} {
+	m := make(map[string]struct{}, len(keys))
+	for i := range keys {
+		m[string(keys[i])] = struct{}{}
+	}
+	return m
+}
@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"errors"
+	"net"
+	"regexp"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator_VerifyAndUpdate(t *testing.T) {
+	minCapacity, maxCapacity := 10.0, 1000.0
+
+	_, allowed, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	_, denied, err := net.ParseCIDR("10.1.0.0/16")
+	require.NoError(t, err)
+
+	var rejected []Reason
+	v := New(Prm{
+		Attributes: []AttributeRule{
+			{Key: "Operator", Required: true, Pattern: regexp.MustCompile("^[a-z]+$")},
+			{Key: "Capacity", Min: &minCapacity, Max: &maxCapacity},
+		},
+		DeniedKeys:      [][]byte{{0xde, 0xad}},
+		AllowedNetworks: []*net.IPNet{allowed},
+		DeniedNetworks:  []*net.IPNet{denied},
+		OnReject: func(r Reason) {
+			rejected = append(rejected, r)
+		},
+	})
+
+	newNode := func(key []byte, addr string, attrs ...string) *netmap.NodeInfo {
+		var n netmap.NodeInfo
+		n.SetPublicKey(key)
+		n.SetNetworkEndpoints(addr)
+		for i := 0; i < len(attrs); i += 2 {
+			n.SetAttribute(attrs[i], attrs[i+1])
+		}
+		return &n
+	}
+
+	testCases := []struct {
+		name   string
+		node   *netmap.NodeInfo
+		reason Reason
+	}{
+		{
+			name: "valid",
+			node: newNode([]byte{1}, "/ip4/10.2.0.1/tcp/8080", "Operator", "acme", "Capacity", "100"),
+		},
+		{
+			name:   "denied key",
+			node:   newNode([]byte{0xde, 0xad}, "/ip4/10.2.0.1/tcp/8080", "Operator", "acme"),
+			reason: ReasonKeyDenied,
+		},
+		{
+			name:   "missing attribute",
+			node:   newNode([]byte{1}, "/ip4/10.2.0.1/tcp/8080", "Capacity", "100"),
+			reason: ReasonMissingAttribute,
+		},
+		{
+			name:   "pattern",
+			node:   newNode([]byte{1}, "/ip4/10.2.0.1/tcp/8080", "Operator", "ACME"),
+			reason: ReasonAttributePattern,
+		},
+		{
+			name:   "not a number",
+			node:   newNode([]byte{1}, "/ip4/10.2.0.1/tcp/8080", "Operator", "acme", "Capacity", "big"),
+			reason: ReasonAttributeRange,
+		},
+		{
+			name:   "out of range",
+			node:   newNode([]byte{1}, "/ip4/10.2.0.1/tcp/8080", "Operator", "acme", "Capacity", "1001"),
+			reason: ReasonAttributeRange,
+		},
+		{
+			name:   "denied network",
+			node:   newNode([]byte{1}, "/ip4/10.1.0.1/tcp/8080", "Operator", "acme"),
+			reason: ReasonNetworkDenied,
+		},
+		{
+			name:   "not allowed network",
+			node:   newNode([]byte{1}, "/ip4/192.168.0.1/tcp/8080", "Operator", "acme"),
+			reason: ReasonNetworkNotAllowed,
+		},
+		{
+			name:   "dns name",
+			node:   newNode([]byte{1}, "/dns4/example.com/tcp/8080", "Operator", "acme"),
+			reason: ReasonNetworkNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rejected = rejected[:0]
+
+			err := v.VerifyAndUpdate(tc.node)
+			if tc.reason == "" {
+				require.NoError(t, err)
+				require.Empty(t, rejected)
+				return
+			}
+
+			var rErr *RejectionError
+			require.True(t, errors.As(err, &rErr), err)
+			require.Equal(t, tc.reason, rErr.Reason)
+			require.Equal(t, []Reason{tc.reason}, rejected)
+		})
+	}
+
+	t.Run("allowed keys", func(t *testing.T) {
+		v := New(Prm{AllowedKeys: [][]byte{{1}}})
+
+		require.NoError(t, v.VerifyAndUpdate(newNode([]byte{1}, "/ip4/127.0.0.1/tcp/8080")))
+
+		var rErr *RejectionError
+		require.ErrorAs(t, v.VerifyAndUpdate(newNode([]byte{2}, "/ip4/127.0.0.1/tcp/8080")), &rErr)
+		require.Equal(t, ReasonKeyNotAllowed, rErr.Reason)
+	})
+}
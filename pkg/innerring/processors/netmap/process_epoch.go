@@ -5,6 +5,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/governance"
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/settlement"
 	cntClient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/container"
+	netmapclient "github.com/TrueCloudLab/frostfs-node/pkg/morph/client/netmap"
 	netmapEvent "github.com/TrueCloudLab/frostfs-node/pkg/morph/event/netmap"
 	"go.uber.org/zap"
 )
@@ -78,7 +79,10 @@ func (np *Processor) processNewEpochTick() {
 	nextEpoch := np.epochState.EpochCounter() + 1
 	np.log.Debug("next epoch", zap.Uint64("value", nextEpoch))
 
-	err := np.netmapClient.NewEpoch(nextEpoch)
+	var prm netmapclient.NewEpochPrm
+	prm.SetEpoch(nextEpoch)
+
+	err := np.netmapClient.NewEpoch(prm)
 	if err != nil {
 		np.log.Error("can't invoke netmap.NewEpoch", zap.Error(err))
 	}
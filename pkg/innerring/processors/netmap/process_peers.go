@@ -45,6 +45,7 @@ func (np *Processor) processAddPeer(ev netmapEvent.AddPeer) {
 	err := np.nodeValidator.VerifyAndUpdate(&nodeInfo)
 	if err != nil {
 		np.log.Warn("could not verify and update information about network map candidate",
+			zap.String("key", hex.EncodeToString(nodeInfo.PublicKey())),
 			zap.String("error", err.Error()),
 		)
 
@@ -301,3 +301,8 @@ func (np *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (np *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns the number of busy workers and the capacity of the worker pool.
+func (np *Processor) PoolState() (running, capacity int) {
+	return np.pool.Running(), np.pool.Cap()
+}
@@ -153,3 +153,8 @@ func (rp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (rp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns the number of busy workers and the capacity of the worker pool.
+func (rp *Processor) PoolState() (running, capacity int) {
+	return rp.pool.Running(), rp.pool.Cap()
+}
@@ -5,7 +5,6 @@ import (
 	"sync"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/innerring/processors/settlement/basic"
-	nodeutil "github.com/TrueCloudLab/frostfs-node/pkg/util"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
@@ -23,7 +22,7 @@ type (
 
 		state AlphabetState
 
-		pool nodeutil.WorkerPool
+		pool *ants.Pool
 
 		auditProc AuditProcessor
 
@@ -76,3 +75,8 @@ func New(prm Prm, opts ...Option) *Processor {
 		incomeContexts: make(map[uint64]*basic.IncomeSettlementContext),
 	}
 }
+
+// PoolState returns the number of busy workers and the capacity of the worker pool.
+func (p *Processor) PoolState() (running, capacity int) {
+	return p.pool.Running(), p.pool.Cap()
+}
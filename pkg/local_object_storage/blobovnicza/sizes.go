@@ -4,6 +4,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strconv"
+
+	"go.etcd.io/bbolt"
 )
 
 const firstBucketBound = uint64(32 * 1 << 10) // 32KB
@@ -49,3 +51,20 @@ func (b *Blobovnicza) decSize(sz uint64) {
 func (b *Blobovnicza) full() bool {
 	return b.filled.Load() >= b.fullSizeLimit
 }
+
+// Utilization returns the total size of the stored objects and the size of
+// the database. The difference between them is occupied by the free pages
+// left after the removed objects and by the database metadata.
+func (b *Blobovnicza) Utilization() (live uint64, total uint64, err error) {
+	err = b.boltDB.View(func(tx *bbolt.Tx) error {
+		total = uint64(tx.Size())
+
+		return b.iterateBuckets(tx, func(_, _ uint64, buck *bbolt.Bucket) (bool, error) {
+			return false, buck.ForEach(func(_, v []byte) error {
+				live += uint64(len(v))
+				return nil
+			})
+		})
+	})
+	return
+}
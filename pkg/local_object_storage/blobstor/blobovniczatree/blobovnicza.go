@@ -76,6 +76,13 @@ type Blobovniczas struct {
 	// list of active (opened, non-filled) Blobovniczas
 	activeMtx sync.RWMutex
 	active    map[string]blobovniczaWithIndex
+
+	// compactMtx serializes compactions.
+	compactMtx sync.Mutex
+
+	// compacted contains paths of the blobovniczas removed by the compaction,
+	// they must not be opened (and thus created) anew. Protected by lruMtx.
+	compacted map[string]struct{}
 }
 
 type blobovniczaWithIndex struct {
@@ -126,6 +133,7 @@ func NewBlobovniczaTree(opts ...Option) (blz *Blobovniczas) {
 
 	blz.opened = cache
 	blz.active = make(map[string]blobovniczaWithIndex, cp)
+	blz.compacted = make(map[string]struct{})
 
 	return blz
 }
@@ -0,0 +1,204 @@
+package blobovniczatree
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// CompactPrm groups the parameters of Compact operation.
+type CompactPrm struct {
+	// Threshold is the ratio of the stored objects size to the size of
+	// a filled blobovnicza below which the blobovnicza is compacted.
+	Threshold float64
+	// Filter is called for every object of the compacted blobovnicza with
+	// the blobovnicza ID. If it returns false, the object is not moved and
+	// is removed together with the blobovnicza. All objects are moved if nil.
+	Filter func(addr oid.Address, storageID []byte) (bool, error)
+	// Moved is called for every moved object with its new storage ID.
+	Moved func(addr oid.Address, storageID []byte) error
+}
+
+// CompactRes groups the resulting values of Compact operation.
+type CompactRes struct {
+	// Compacted is the number of removed blobovniczas.
+	Compacted uint64
+	// Moved is the number of objects moved to other blobovniczas.
+	Moved uint64
+	// Reclaimed is the amount of the freed disk space.
+	Reclaimed uint64
+}
+
+// Compact rewrites under-utilized blobovniczas: objects from every filled
+// blobovnicza having the ratio of the stored objects size to its size less than
+// the threshold are put to the active blobovniczas and the old file is removed.
+//
+// Only the blobovniczas preceding the active one of the same level are processed,
+// so that the compacted blobovnicza can't become active during the compaction.
+// The index of the removed blobovnicza is reused after the tree is reopened.
+func (b *Blobovniczas) Compact(prm CompactPrm) (CompactRes, error) {
+	var res CompactRes
+
+	if b.readOnly {
+		return res, common.ErrReadOnly
+	}
+
+	b.compactMtx.Lock()
+	defer b.compactMtx.Unlock()
+
+	err := b.iterateLeaves(func(p string) (bool, error) {
+		lvlPath := filepath.Dir(p)
+		if lvlPath == "." {
+			// Blobovniczas of the single-level tree are stored in the root.
+			lvlPath = ""
+		}
+
+		b.activeMtx.RLock()
+		active, ok := b.active[lvlPath]
+		b.activeMtx.RUnlock()
+
+		if !ok || u64FromHexString(filepath.Base(p)) >= active.ind {
+			return false, nil
+		}
+
+		// Don't create the file of the already compacted blobovnicza.
+		if _, err := os.Stat(filepath.Join(b.rootPath, p)); errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+
+		blz, err := b.openBlobovnicza(p)
+		if err != nil {
+			return true, err
+		}
+
+		live, total, err := blz.Utilization()
+		if err != nil {
+			return true, fmt.Errorf("could not get utilization of blobovnicza %s: %w", p, err)
+		}
+		if float64(live) >= prm.Threshold*float64(total) {
+			return false, nil
+		}
+
+		moved, movedSize, err := b.compactBlobovnicza(p, blz, prm)
+		res.Moved += moved
+		if err != nil {
+			return true, fmt.Errorf("could not compact blobovnicza %s: %w", p, err)
+		}
+
+		res.Compacted++
+		if total > movedSize {
+			res.Reclaimed += total - movedSize
+		}
+
+		b.log.Debug("blobovnicza compacted",
+			zap.String("path", p),
+			zap.Uint64("moved", moved),
+			zap.Uint64("size", total))
+		return false, nil
+	})
+
+	return res, err
+}
+
+// compactBlobovnicza moves objects from the blobovnicza to the active ones
+// and removes it. Returns the number and the total size of the moved objects.
+//
+// Objects are not put inside the iteration transaction: the blobovnicza can be
+// evicted from the cache during the put, and closing it would wait for the transaction.
+func (b *Blobovniczas) compactBlobovnicza(p string, blz *blobovnicza.Blobovnicza, prm CompactPrm) (uint64, uint64, error) {
+	var addrs []oid.Address
+	err := blobovnicza.IterateAddresses(blz, func(addr oid.Address) error {
+		addrs = append(addrs, addr)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var moved, movedSize uint64
+	for i := range addrs {
+		if prm.Filter != nil {
+			keep, err := prm.Filter(addrs[i], []byte(p))
+			if err != nil {
+				return moved, movedSize, err
+			}
+			if !keep {
+				continue
+			}
+		}
+
+		data, err := b.getCompacted(p, addrs[i])
+		if err != nil {
+			if blobovnicza.IsErrNotFound(err) {
+				// Removed concurrently.
+				continue
+			}
+			return moved, movedSize, err
+		}
+
+		// Object data is already compressed.
+		res, err := b.Put(common.PutPrm{
+			Address:      addrs[i],
+			RawData:      data,
+			DontCompress: true,
+		})
+		if err != nil {
+			return moved, movedSize, fmt.Errorf("could not move object %s: %w", addrs[i], err)
+		}
+
+		if prm.Moved != nil {
+			if err := prm.Moved(addrs[i], res.StorageID); err != nil {
+				return moved, movedSize, err
+			}
+		}
+
+		moved++
+		movedSize += uint64(len(data))
+	}
+
+	// The storage IDs of the moved objects are updated, but concurrent readers
+	// can still use the old ones. The blobovnicza is marked as compacted, so it
+	// is not opened (and created) again, and the readers fall back to the search
+	// in the whole tree. It is closed on eviction, closing waits for the reads
+	// in progress, so the file is removed after they finish.
+	b.lruMtx.Lock()
+	b.compacted[p] = struct{}{}
+	b.opened.Remove(p)
+	b.lruMtx.Unlock()
+
+	if err := os.Remove(filepath.Join(b.rootPath, p)); err != nil {
+		b.reportError("could not remove compacted blobovnicza", err)
+		return moved, movedSize, err
+	}
+	return moved, movedSize, nil
+}
+
+// getCompacted reads the raw object data from the compacted blobovnicza
+// reopening it if it was evicted from the cache.
+func (b *Blobovniczas) getCompacted(p string, addr oid.Address) ([]byte, error) {
+	var prm blobovnicza.GetPrm
+	prm.SetAddress(addr)
+
+	for {
+		blz, err := b.openBlobovnicza(p)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := blz.Get(prm)
+		if errors.Is(err, bbolt.ErrDatabaseNotOpen) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return res.Object(), nil
+	}
+}
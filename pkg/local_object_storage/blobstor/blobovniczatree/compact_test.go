@@ -0,0 +1,118 @@
+package blobovniczatree
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/internal/blobstortest"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestCompact(t *testing.T) {
+	for _, depth := range []uint64{1, 2} {
+		dir := t.TempDir()
+		b := NewBlobovniczaTree(
+			WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+			WithObjectSizeLimit(16*1024),
+			WithBlobovniczaShallowWidth(2),
+			WithBlobovniczaShallowDepth(depth),
+			WithRootPath(dir),
+			WithBlobovniczaSize(64*1024))
+		require.NoError(t, b.Open(false))
+		require.NoError(t, b.Init())
+
+		type objDesc struct {
+			addr      oid.Address
+			data      []byte
+			storageID []byte
+		}
+
+		// Fill 3/4 of the tree, so that some blobovniczas are full.
+		objs := make([]objDesc, 6<<(depth+1))
+		for i := range objs {
+			obj := blobstortest.NewObject(8 * 1024)
+			objs[i].addr = object.AddressOf(obj)
+
+			var err error
+			objs[i].data, err = obj.Marshal()
+			require.NoError(t, err)
+
+			res, err := b.Put(common.PutPrm{Address: objs[i].addr, RawData: objs[i].data, DontCompress: true})
+			require.NoError(t, err)
+			objs[i].storageID = res.StorageID
+		}
+
+		// Keep every 4th object only.
+		for i := range objs {
+			if i%4 != 0 {
+				_, err := b.Delete(common.DeletePrm{Address: objs[i].addr, StorageID: objs[i].storageID})
+				require.NoError(t, err)
+			}
+		}
+
+		var dropped *oid.Address
+		moved := make(map[oid.Address][]byte)
+		res, err := b.Compact(CompactPrm{
+			Threshold: 0.5,
+			Filter: func(addr oid.Address, storageID []byte) (bool, error) {
+				// Drop the first object to check filtering.
+				if dropped == nil {
+					dropped = &addr
+					return false, nil
+				}
+				return true, nil
+			},
+			Moved: func(addr oid.Address, storageID []byte) error {
+				moved[addr] = storageID
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		require.NotZero(t, res.Compacted)
+		require.NotZero(t, res.Reclaimed)
+		require.Equal(t, uint64(len(moved)), res.Moved)
+		require.NotNil(t, dropped)
+
+		for i := 0; i < len(objs); i += 4 {
+			if id, ok := moved[objs[i].addr]; ok {
+				oldPath := filepath.Join(dir, string(objs[i].storageID))
+				_, err := os.Stat(oldPath)
+				require.ErrorIs(t, err, os.ErrNotExist)
+
+				// Stale storage ID falls back to the search in the whole tree.
+				gRes, err := b.Get(common.GetPrm{Address: objs[i].addr, StorageID: objs[i].storageID, Raw: true})
+				require.NoError(t, err)
+				require.Equal(t, objs[i].data, gRes.RawData)
+
+				eRes, err := b.Exists(common.ExistsPrm{Address: objs[i].addr, StorageID: objs[i].storageID})
+				require.NoError(t, err)
+				require.True(t, eRes.Exists)
+
+				// The removed blobovnicza is not created anew.
+				_, err = os.Stat(oldPath)
+				require.ErrorIs(t, err, os.ErrNotExist)
+
+				objs[i].storageID = id
+			}
+		}
+
+		for i := 0; i < len(objs); i += 4 {
+
+			gRes, err := b.Get(common.GetPrm{Address: objs[i].addr, StorageID: objs[i].storageID, Raw: true})
+			if objs[i].addr == *dropped {
+				require.Error(t, err)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, objs[i].data, gRes.RawData)
+		}
+
+		require.NoError(t, b.Close())
+	}
+}
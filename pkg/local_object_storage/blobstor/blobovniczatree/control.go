@@ -1,7 +1,10 @@
 package blobovniczatree
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
 	"path/filepath"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobovnicza"
@@ -68,6 +71,8 @@ func (b *Blobovniczas) Close() error {
 	}
 
 	b.active = make(map[string]blobovniczaWithIndex)
+	// Files of the compacted blobovniczas are created anew on Init.
+	b.compacted = make(map[string]struct{})
 
 	b.lruMtx.Unlock()
 
@@ -107,6 +112,10 @@ func (b *Blobovniczas) openBlobovnicza(p string) (*blobovnicza.Blobovnicza, erro
 		return v, nil
 	}
 
+	if b.isCompacted(p) {
+		return nil, errCompacted
+	}
+
 	blz, err := b.openBlobovniczaNoCache(p)
 	if err != nil {
 		return nil, err
@@ -117,6 +126,31 @@ func (b *Blobovniczas) openBlobovnicza(p string) (*blobovnicza.Blobovnicza, erro
 	return blz, nil
 }
 
+// isCompacted checks whether the blobovnicza with path p was removed by
+// the compaction. The missing file of the filled blobovnicza is not created
+// anew: the storage ID pointing to it is stale. Must be called with activeMtx
+// and lruMtx held.
+func (b *Blobovniczas) isCompacted(p string) bool {
+	if _, ok := b.compacted[p]; ok {
+		return true
+	}
+
+	lvlPath := filepath.Dir(p)
+	if lvlPath == "." {
+		// Blobovniczas of the single-level tree are stored in the root.
+		lvlPath = ""
+	}
+
+	active, ok := b.active[lvlPath]
+	filled := ok && u64FromHexString(filepath.Base(p)) < active.ind
+	if !filled && !b.readOnly {
+		return false
+	}
+
+	_, err := os.Stat(filepath.Join(b.rootPath, p))
+	return errors.Is(err, fs.ErrNotExist)
+}
+
 func (b *Blobovniczas) openBlobovniczaNoCache(p string) (*blobovnicza.Blobovnicza, error) {
 	b.openMtx.Lock()
 	defer b.openMtx.Unlock()
@@ -12,7 +12,8 @@ import (
 
 // Delete deletes object from blobovnicza tree.
 //
-// If blobocvnicza ID is specified, only this blobovnicza is processed.
+// If blobocvnicza ID is specified, only this blobovnicza is processed
+// unless it has been removed by the compaction.
 // Otherwise, all Blobovniczas are processed descending weight.
 func (b *Blobovniczas) Delete(prm common.DeletePrm) (res common.DeleteRes, err error) {
 	if b.readOnly {
@@ -25,11 +26,13 @@ func (b *Blobovniczas) Delete(prm common.DeletePrm) (res common.DeleteRes, err e
 	if prm.StorageID != nil {
 		id := blobovnicza.NewIDFromBytes(prm.StorageID)
 		blz, err := b.openBlobovnicza(id.String())
-		if err != nil {
+		if err == nil {
+			res, err = b.deleteObject(blz, bPrm, prm)
+		}
+		if !isStaleStorageID(err) {
 			return res, err
 		}
-
-		return b.deleteObject(blz, bPrm, prm)
+		// The object has been moved by the compaction, search the whole tree.
 	}
 
 	activeCache := make(map[string]struct{})
@@ -2,11 +2,25 @@ package blobovniczatree
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	"go.etcd.io/bbolt"
 )
 
+// errCompacted is returned on access to the blobovnicza removed by the compaction.
+// It is a kind of ObjectNotFound, so the tree-wide search skips the blobovnicza.
+var errCompacted = fmt.Errorf("%w: blobovnicza was removed by the compaction",
+	logicerr.Wrap(apistatus.ObjectNotFound{}))
+
+// isStaleStorageID checks whether err may be caused by the storage ID of the
+// object moved by the compaction: the blobovnicza has been removed or closed
+// concurrently. The object must be searched in the whole tree then.
+func isStaleStorageID(err error) bool {
+	return errors.Is(err, errCompacted) || errors.Is(err, bbolt.ErrDatabaseNotOpen)
+}
+
 func isErrOutOfRange(err error) bool {
 	return errors.As(err, new(apistatus.ObjectOutOfRange))
 }
@@ -13,12 +13,14 @@ func (b *Blobovniczas) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
 	if prm.StorageID != nil {
 		id := blobovnicza.NewIDFromBytes(prm.StorageID)
 		blz, err := b.openBlobovnicza(id.String())
-		if err != nil {
-			return common.ExistsRes{}, err
+		var exists bool
+		if err == nil {
+			exists, err = blz.Exists(prm.Address)
 		}
-
-		exists, err := blz.Exists(prm.Address)
-		return common.ExistsRes{Exists: exists}, err
+		if !isStaleStorageID(err) {
+			return common.ExistsRes{Exists: exists}, err
+		}
+		// The object has been moved by the compaction, search the whole tree.
 	}
 
 	activeCache := make(map[string]struct{})
@@ -14,7 +14,8 @@ import (
 
 // Get reads object from blobovnicza tree.
 //
-// If blobocvnicza ID is specified, only this blobovnicza is processed.
+// If blobocvnicza ID is specified, only this blobovnicza is processed
+// unless it has been removed by the compaction.
 // Otherwise, all Blobovniczas are processed descending weight.
 func (b *Blobovniczas) Get(prm common.GetPrm) (res common.GetRes, err error) {
 	var bPrm blobovnicza.GetPrm
@@ -23,11 +24,13 @@ func (b *Blobovniczas) Get(prm common.GetPrm) (res common.GetRes, err error) {
 	if prm.StorageID != nil {
 		id := blobovnicza.NewIDFromBytes(prm.StorageID)
 		blz, err := b.openBlobovnicza(id.String())
-		if err != nil {
+		if err == nil {
+			res, err = b.getObject(blz, bPrm)
+		}
+		if !isStaleStorageID(err) {
 			return res, err
 		}
-
-		return b.getObject(blz, bPrm)
+		// The object has been moved by the compaction, search the whole tree.
 	}
 
 	activeCache := make(map[string]struct{})
@@ -14,17 +14,20 @@ import (
 
 // GetRange reads range of object payload data from blobovnicza tree.
 //
-// If blobocvnicza ID is specified, only this blobovnicza is processed.
+// If blobocvnicza ID is specified, only this blobovnicza is processed
+// unless it has been removed by the compaction.
 // Otherwise, all Blobovniczas are processed descending weight.
 func (b *Blobovniczas) GetRange(prm common.GetRangePrm) (res common.GetRangeRes, err error) {
 	if prm.StorageID != nil {
 		id := blobovnicza.NewIDFromBytes(prm.StorageID)
 		blz, err := b.openBlobovnicza(id.String())
-		if err != nil {
-			return common.GetRangeRes{}, err
+		if err == nil {
+			res, err = b.getObjectRange(blz, prm)
 		}
-
-		return b.getObjectRange(blz, prm)
+		if !isStaleStorageID(err) {
+			return res, err
+		}
+		// The object has been moved by the compaction, search the whole tree.
 	}
 
 	activeCache := make(map[string]struct{})
@@ -1,6 +1,7 @@
 package blobovniczatree
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 
@@ -49,7 +50,7 @@ func (b *Blobovniczas) iterateBlobovniczas(ignoreErrors bool, f func(string, *bl
 	return b.iterateLeaves(func(p string) (bool, error) {
 		blz, err := b.openBlobovnicza(p)
 		if err != nil {
-			if ignoreErrors {
+			if ignoreErrors || errors.Is(err, errCompacted) {
 				return false, nil
 			}
 			return false, fmt.Errorf("could not open blobovnicza %s: %w", p, err)
@@ -15,6 +15,12 @@ import (
 type SubStorage struct {
 	Storage common.Storage
 	Policy  func(*objectSDK.Object, []byte) bool
+
+	// Codec overrides the default compression codec for the sub-storage.
+	// Empty value means the codec of the BlobStor is used.
+	Codec compression.Codec
+	// CompressionLevel is a level for the Codec.
+	CompressionLevel int
 }
 
 // BlobStor represents FrostFS local BLOB storage.
@@ -43,6 +49,12 @@ type cfg struct {
 	compression compression.Config
 	log         *logger.Logger
 	storage     []SubStorage
+
+	// subCompression contains compression configs
+	// of sub-storages overriding the default codec.
+	subCompression []*compression.Config
+
+	segmentMetrics common.CompactionMetrics
 }
 
 func initConfig(c *cfg) {
@@ -59,7 +71,26 @@ func New(opts ...Option) *BlobStor {
 	}
 
 	for i := range bs.storage {
-		bs.storage[i].Storage.SetCompressor(&bs.compression)
+		cc := &bs.compression
+		if bs.storage[i].Codec != "" {
+			sub := compression.Config{
+				Enabled:                    bs.compression.Enabled,
+				UncompressableContentTypes: bs.compression.UncompressableContentTypes,
+				Codec:                      bs.storage[i].Codec,
+				Level:                      bs.storage[i].CompressionLevel,
+				Rules:                      bs.compression.Rules,
+				Adaptive:                   bs.compression.Adaptive,
+				MinRatio:                   bs.compression.MinRatio,
+				Metrics:                    bs.compression.Metrics,
+			}
+			cc = &sub
+			bs.subCompression = append(bs.subCompression, cc)
+		}
+		bs.storage[i].Storage.SetCompressor(cc)
+
+		if s, ok := bs.storage[i].Storage.(common.MetricsSetter); ok && bs.segmentMetrics != nil {
+			s.SetMetrics(bs.segmentMetrics)
+		}
 	}
 
 	return bs
@@ -87,7 +118,8 @@ func WithLogger(l *logger.Logger) Option {
 // WithCompressObjects returns option to toggle
 // compression of the stored objects.
 //
-// If true, Zstandard algorithm is used for data compression.
+// If true, the codec set by WithCompressionCodec is used for data compression
+// (Zstandard by default).
 //
 // If compressor (decompressor) creation failed,
 // the uncompressed option will be used, and the error
@@ -106,6 +138,51 @@ func WithUncompressableContentTypes(values []string) Option {
 	}
 }
 
+// WithCompressionCodec returns option to specify the default
+// compression codec and its level.
+//
+// Level 0 means the default level of the codec.
+func WithCompressionCodec(codec compression.Codec, level int) Option {
+	return func(c *cfg) {
+		c.compression.Codec = codec
+		c.compression.Level = level
+	}
+}
+
+// WithCompressionRules returns option to specify codecs for specific
+// content types as seen by object.AttributeContentType attribute.
+// Rules take precedence over the default codec and the codec of a sub-storage.
+func WithCompressionRules(rules []compression.Rule) Option {
+	return func(c *cfg) {
+		c.compression.Rules = rules
+	}
+}
+
+// WithAdaptiveCompression returns option to store objects uncompressed
+// if the compression ratio of the first chunk of the payload is less than minRatio.
+//
+// If minRatio is not positive, compression.DefaultMinRatio is used.
+func WithAdaptiveCompression(enabled bool, minRatio float64) Option {
+	return func(c *cfg) {
+		c.compression.Adaptive = enabled
+		c.compression.MinRatio = minRatio
+	}
+}
+
+// WithCompressionMetrics returns option to specify compression statistics receiver.
+func WithCompressionMetrics(m compression.Metrics) Option {
+	return func(c *cfg) {
+		c.compression.Metrics = m
+	}
+}
+
+// WithSegmentMetrics returns option to specify segment compaction statistics receiver.
+func WithSegmentMetrics(m common.CompactionMetrics) Option {
+	return func(c *cfg) {
+		c.segmentMetrics = m
+	}
+}
+
 // SetReportErrorFunc allows to provide a function to be called on disk errors.
 // This function MUST be called before Open.
 func (b *BlobStor) SetReportErrorFunc(f func(string, error)) {
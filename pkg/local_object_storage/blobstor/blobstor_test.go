@@ -8,6 +8,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	"github.com/stretchr/testify/require"
@@ -99,6 +100,47 @@ func TestCompression(t *testing.T) {
 	require.NoError(t, blobStor.Close())
 }
 
+func TestCompressionCodecs(t *testing.T) {
+	dir := t.TempDir()
+
+	const smallSizeLimit = 512
+
+	newBlobStor := func(t *testing.T, compress bool) *BlobStor {
+		ss := defaultStorages(dir, smallSizeLimit)
+		ss[0].Codec = compression.CodecSnappy
+		ss[1].Codec = compression.CodecLZ4
+		ss[1].CompressionLevel = 9
+
+		bs := New(
+			WithCompressObjects(compress),
+			WithStorages(ss))
+		require.NoError(t, bs.Open(false))
+		require.NoError(t, bs.Init())
+		return bs
+	}
+
+	objs := []*objectSDK.Object{
+		testObject(smallSizeLimit / 2),
+		testObject(smallSizeLimit * 2),
+	}
+
+	blobStor := newBlobStor(t, true)
+	for i := range objs {
+		_, err := blobStor.Put(common.PutPrm{Object: objs[i]})
+		require.NoError(t, err)
+	}
+	require.NoError(t, blobStor.Close())
+
+	// Objects must be readable even if compression is disabled.
+	blobStor = newBlobStor(t, false)
+	for i := range objs {
+		res, err := blobStor.Get(common.GetPrm{Address: object.AddressOf(objs[i])})
+		require.NoError(t, err)
+		require.Equal(t, objs[i], res.Object)
+	}
+	require.NoError(t, blobStor.Close())
+}
+
 func TestBlobstor_needsCompression(t *testing.T) {
 	const smallSizeLimit = 512
 	newBlobStor := func(t *testing.T, compress bool, ct ...string) *BlobStor {
@@ -23,3 +23,17 @@ type Storage interface {
 	Delete(DeletePrm) (DeleteRes, error)
 	Iterate(IteratePrm) (IterateRes, error)
 }
+
+// CompactionMetrics is an interface for compaction statistics of the storage.
+type CompactionMetrics interface {
+	// IncSegmentCompactions must increment the number of compacted segments.
+	IncSegmentCompactions()
+	// AddSegmentReclaimedBytes must add the amount of disk space
+	// reclaimed by the segment compaction.
+	AddSegmentReclaimedBytes(n uint64)
+}
+
+// MetricsSetter is implemented by the storages reporting compaction statistics.
+type MetricsSetter interface {
+	SetMetrics(m CompactionMetrics)
+}
@@ -0,0 +1,31 @@
+package blobstor
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+)
+
+// CompactBlobovniczas compacts under-utilized blobovniczas of all
+// the blobovnicza tree sub-storages.
+//
+// See blobovniczatree.Blobovniczas.Compact for details.
+func (b *BlobStor) CompactBlobovniczas(prm blobovniczatree.CompactPrm) (blobovniczatree.CompactRes, error) {
+	b.modeMtx.RLock()
+	defer b.modeMtx.RUnlock()
+
+	var res blobovniczatree.CompactRes
+	for i := range b.storage {
+		st, ok := b.storage[i].Storage.(*blobovniczatree.Blobovniczas)
+		if !ok {
+			continue
+		}
+
+		r, err := st.Compact(prm)
+		res.Compacted += r.Compacted
+		res.Moved += r.Moved
+		res.Reclaimed += r.Reclaimed
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
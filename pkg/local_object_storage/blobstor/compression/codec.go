@@ -0,0 +1,213 @@
+package compression
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Codec is a name of the compression algorithm.
+type Codec string
+
+const (
+	// CodecNone stores data as is.
+	CodecNone Codec = "none"
+	// CodecZstd compresses data with Zstandard, it is the default codec.
+	CodecZstd Codec = "zstd"
+	// CodecLZ4 compresses data with LZ4 frame format.
+	CodecLZ4 Codec = "lz4"
+	// CodecSnappy compresses data with Snappy framing format.
+	CodecSnappy Codec = "snappy"
+)
+
+// encoder compresses data with a particular codec and level.
+type encoder interface {
+	encode(data []byte) []byte
+	close() error
+}
+
+// decoder decompresses data produced by the encoder of the same codec.
+type decoder interface {
+	decode(data []byte) ([]byte, error)
+	close()
+}
+
+// codecInfo describes a codec registered in the package.
+//
+// Every codec must write a frame header starting with magic, so that
+// the data compressed with different codecs can be stored side by side
+// and decompressed without any additional information.
+type codecInfo struct {
+	magic      []byte
+	newEncoder func(level int) (encoder, error)
+	newDecoder func() (decoder, error)
+}
+
+var (
+	// zstdFrameMagic contains first 4 bytes of any compressed object
+	// https://github.com/klauspost/compress/blob/master/zstd/framedec.go#L58 .
+	zstdFrameMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+	// lz4FrameMagic contains first 4 bytes of any LZ4 frame
+	// https://github.com/lz4/lz4/blob/dev/doc/lz4_Frame_format.md#general-structure-of-lz4-frame-format .
+	lz4FrameMagic = []byte{0x04, 0x22, 0x4d, 0x18}
+
+	// snappyStreamMagic is a stream identifier chunk starting any Snappy stream
+	// https://github.com/google/snappy/blob/main/framing_format.txt .
+	snappyStreamMagic = []byte{0xff, 0x06, 0x00, 0x00, 's', 'N', 'a', 'P', 'p', 'Y'}
+)
+
+var codecs = map[Codec]codecInfo{
+	CodecZstd: {
+		magic:      zstdFrameMagic,
+		newEncoder: newZstdEncoder,
+		newDecoder: newZstdDecoder,
+	},
+	CodecLZ4: {
+		magic:      lz4FrameMagic,
+		newEncoder: newLZ4Encoder,
+		newDecoder: func() (decoder, error) { return lz4Decoder{}, nil },
+	},
+	CodecSnappy: {
+		magic:      snappyStreamMagic,
+		newEncoder: func(int) (encoder, error) { return snappyEncoder{}, nil },
+		newDecoder: func() (decoder, error) { return snappyDecoder{}, nil },
+	},
+}
+
+// Validate checks whether the codec is known.
+func (c Codec) Validate() error {
+	if c == "" || c == CodecNone {
+		return nil
+	}
+	if _, ok := codecs[c]; !ok {
+		return fmt.Errorf("unknown compression codec: %s", c)
+	}
+	return nil
+}
+
+type zstdEncoder struct {
+	*zstd.Encoder
+}
+
+func newZstdEncoder(level int) (encoder, error) {
+	var opts []zstd.EOption
+	if level != 0 {
+		opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	}
+
+	enc, err := zstd.NewWriter(nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return zstdEncoder{enc}, nil
+}
+
+func (e zstdEncoder) encode(data []byte) []byte {
+	maxSize := e.MaxEncodedSize(len(data))
+	return e.EncodeAll(data, make([]byte, 0, maxSize))
+}
+
+func (e zstdEncoder) close() error {
+	return e.Close()
+}
+
+type zstdDecoder struct {
+	*zstd.Decoder
+}
+
+func newZstdDecoder() (decoder, error) {
+	dec, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	return zstdDecoder{dec}, nil
+}
+
+func (d zstdDecoder) decode(data []byte) ([]byte, error) {
+	return d.DecodeAll(data, nil)
+}
+
+func (d zstdDecoder) close() {
+	d.Close()
+}
+
+// lz4Levels maps numeric level from the configuration to the LZ4 level.
+// Zero level corresponds to the fast (non-HC) mode.
+var lz4Levels = []lz4.CompressionLevel{
+	lz4.Fast, lz4.Level1, lz4.Level2, lz4.Level3, lz4.Level4,
+	lz4.Level5, lz4.Level6, lz4.Level7, lz4.Level8, lz4.Level9,
+}
+
+type lz4Encoder struct {
+	level lz4.CompressionLevel
+}
+
+func newLZ4Encoder(level int) (encoder, error) {
+	if level < 0 || level >= len(lz4Levels) {
+		return nil, fmt.Errorf("invalid lz4 compression level: %d", level)
+	}
+	return lz4Encoder{level: lz4Levels[level]}, nil
+}
+
+func (e lz4Encoder) encode(data []byte) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, lz4.CompressBlockBound(len(data))))
+
+	w := lz4.NewWriter(buf)
+	if err := w.Apply(lz4.CompressionLevelOption(e.level)); err != nil {
+		// Level is validated in the constructor.
+		panic(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		// Writes to bytes.Buffer never fail.
+		panic(err)
+	}
+	if err := w.Close(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func (lz4Encoder) close() error {
+	return nil
+}
+
+type lz4Decoder struct{}
+
+func (lz4Decoder) decode(data []byte) ([]byte, error) {
+	return io.ReadAll(lz4.NewReader(bytes.NewReader(data)))
+}
+
+func (lz4Decoder) close() {}
+
+type snappyEncoder struct{}
+
+func (snappyEncoder) encode(data []byte) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, len(snappyStreamMagic)+snappy.MaxEncodedLen(len(data))))
+
+	w := snappy.NewBufferedWriter(buf)
+	if _, err := w.Write(data); err != nil {
+		// Writes to bytes.Buffer never fail.
+		panic(err)
+	}
+	if err := w.Close(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func (snappyEncoder) close() error {
+	return nil
+}
+
+type snappyDecoder struct{}
+
+func (snappyDecoder) decode(data []byte) ([]byte, error) {
+	return io.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+}
+
+func (snappyDecoder) close() {}
@@ -2,10 +2,10 @@ package compression
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
-	"github.com/klauspost/compress/zstd"
 )
 
 // Config represents common compression-related configuration.
@@ -13,33 +13,118 @@ type Config struct {
 	Enabled                    bool
 	UncompressableContentTypes []string
 
-	encoder *zstd.Encoder
-	decoder *zstd.Decoder
+	// Codec is a codec used for the objects not matching any of the Rules.
+	// Empty value means CodecZstd.
+	Codec Codec
+	// Level is a codec-specific compression level, 0 means the default one.
+	Level int
+	// Rules allow to override codec for specific content types.
+	// The first matching rule is used.
+	Rules []Rule
+
+	// Adaptive enables trial compression of the first chunk of the payload.
+	// If the compression ratio of the chunk is less than MinRatio,
+	// the object is stored uncompressed.
+	Adaptive bool
+	// MinRatio is a minimum ratio of the original size to the compressed one
+	// for the object to be stored compressed. DefaultMinRatio is used if not positive.
+	MinRatio float64
+	// Metrics is used to report compression statistics, can be nil.
+	Metrics Metrics
+
+	encoders map[codecLevel]encoder
+	decoders map[Codec]decoder
+}
+
+// Metrics is an interface for compression statistics.
+type Metrics interface {
+	// ObserveCompressionRatio must register the ratio of the original
+	// size of the compressed object to the stored one.
+	ObserveCompressionRatio(ratio float64)
+	// IncCompressionSkipped must increment the number of objects
+	// stored uncompressed because of the poor compression ratio.
+	IncCompressionSkipped()
+}
+
+const (
+	// DefaultMinRatio is a default minimum compression ratio for adaptive compression.
+	DefaultMinRatio = 1.1
+
+	// probeSize is a size of the payload chunk which is compressed
+	// to estimate compression ratio of the whole object.
+	probeSize = 16 * 1024
+)
+
+// Rule describes the codec to be used for objects
+// with the specific content type.
+type Rule struct {
+	// ContentTypes is a list of content types with the same
+	// matching semantics as Config.UncompressableContentTypes.
+	ContentTypes []string
+	Codec        Codec
+	Level        int
 }
 
-// zstdFrameMagic contains first 4 bytes of any compressed object
-// https://github.com/klauspost/compress/blob/master/zstd/framedec.go#L58 .
-var zstdFrameMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+type codecLevel struct {
+	codec Codec
+	level int
+}
 
 // Init initializes compression routines.
 func (c *Config) Init() error {
-	var err error
-
-	if c.Enabled {
-		c.encoder, err = zstd.NewWriter(nil)
+	c.decoders = make(map[Codec]decoder, len(codecs))
+	for name, info := range codecs {
+		dec, err := info.newDecoder()
 		if err != nil {
-			return err
+			return fmt.Errorf("can't create %s decoder: %w", name, err)
 		}
+		c.decoders[name] = dec
 	}
 
-	c.decoder, err = zstd.NewReader(nil)
-	if err != nil {
+	if !c.Enabled {
+		return nil
+	}
+
+	c.encoders = make(map[codecLevel]encoder)
+	if err := c.initEncoder(c.defaultCodec()); err != nil {
 		return err
 	}
+	for i := range c.Rules {
+		if err := c.initEncoder(codecLevel{codec: c.Rules[i].Codec, level: c.Rules[i].Level}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Config) initEncoder(cl codecLevel) error {
+	if cl.codec == CodecNone {
+		return nil
+	}
+	if _, ok := c.encoders[cl]; ok {
+		return nil
+	}
 
+	info, ok := codecs[cl.codec]
+	if !ok {
+		return fmt.Errorf("unknown compression codec: %s", cl.codec)
+	}
+
+	enc, err := info.newEncoder(cl.level)
+	if err != nil {
+		return fmt.Errorf("can't create %s encoder: %w", cl.codec, err)
+	}
+	c.encoders[cl] = enc
 	return nil
 }
 
+func (c *Config) defaultCodec() codecLevel {
+	if c.Codec == "" {
+		return codecLevel{codec: CodecZstd, level: c.Level}
+	}
+	return codecLevel{codec: c.Codec, level: c.Level}
+}
+
 // NeedsCompression returns true if the object should be compressed.
 // For an object to be compressed 2 conditions must hold:
 // 1. Compression is enabled in settings.
@@ -51,19 +136,8 @@ func (c *Config) NeedsCompression(obj *objectSDK.Object) bool {
 
 	for _, attr := range obj.Attributes() {
 		if attr.Key() == objectSDK.AttributeContentType {
-			for _, value := range c.UncompressableContentTypes {
-				match := false
-				switch {
-				case len(value) > 0 && value[len(value)-1] == '*':
-					match = strings.HasPrefix(attr.Value(), value[:len(value)-1])
-				case len(value) > 0 && value[0] == '*':
-					match = strings.HasSuffix(attr.Value(), value[1:])
-				default:
-					match = attr.Value() == value
-				}
-				if match {
-					return false
-				}
+			if matchContentType(c.UncompressableContentTypes, attr.Value()) {
+				return false
 			}
 		}
 	}
@@ -71,33 +145,143 @@ func (c *Config) NeedsCompression(obj *objectSDK.Object) bool {
 	return c.Enabled
 }
 
+// matchContentType checks whether the content type matches any of the patterns.
+// Each pattern can contain a star `*` as a first (last) character,
+// which matches any prefix (suffix).
+func matchContentType(patterns []string, contentType string) bool {
+	for _, value := range patterns {
+		match := false
+		switch {
+		case len(value) > 0 && value[len(value)-1] == '*':
+			match = strings.HasPrefix(contentType, value[:len(value)-1])
+		case len(value) > 0 && value[0] == '*':
+			match = strings.HasSuffix(contentType, value[1:])
+		default:
+			match = contentType == value
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
 // Decompress decompresses data if it starts with the magic
-// and returns data untouched otherwise.
+// of any known codec and returns data untouched otherwise.
 func (c *Config) Decompress(data []byte) ([]byte, error) {
-	if len(data) < 4 || !bytes.Equal(data[:4], zstdFrameMagic) {
-		return data, nil
+	for name, info := range codecs {
+		if bytes.HasPrefix(data, info.magic) {
+			return c.decoders[name].decode(data)
+		}
 	}
-	return c.decoder.DecodeAll(data, nil)
+	return data, nil
 }
 
-// Compress compresses data if compression is enabled
+// Compress compresses data with the default codec if compression is enabled
 // and returns data untouched otherwise.
 func (c *Config) Compress(data []byte) []byte {
+	return c.CompressObject(nil, data)
+}
+
+// CompressObject compresses data with the codec selected for the object
+// if compression is enabled and returns data untouched otherwise.
+// Object is used to match compression rules and can be nil.
+//
+// If adaptive compression is enabled, data is returned untouched
+// when the compression ratio is poor.
+func (c *Config) CompressObject(obj *objectSDK.Object, data []byte) []byte {
 	if c == nil || !c.Enabled {
 		return data
 	}
-	maxSize := c.encoder.MaxEncodedSize(len(data))
-	return c.encoder.EncodeAll(data, make([]byte, 0, maxSize))
+
+	cl := c.codecFor(obj)
+	if cl.codec == CodecNone || len(data) == 0 {
+		return data
+	}
+
+	enc := c.encoders[cl]
+	if !c.Adaptive {
+		res := enc.encode(data)
+		c.observeRatio(data, res)
+		return res
+	}
+
+	sample := data
+	if obj != nil && len(obj.Payload()) != 0 {
+		sample = obj.Payload()
+	}
+	// Small objects are compressed entirely, so there is no need in a probe.
+	if len(sample) > probeSize {
+		if c.ratio(sample[:probeSize], enc.encode(sample[:probeSize])) < c.minRatio() {
+			c.skip()
+			return data
+		}
+	}
+
+	res := enc.encode(data)
+	if c.ratio(data, res) < c.minRatio() {
+		c.skip()
+		return data
+	}
+	c.observeRatio(data, res)
+	return res
 }
 
-// Close closes encoder and decoder, returns any error occurred.
+func (c *Config) ratio(original, compressed []byte) float64 {
+	return float64(len(original)) / float64(len(compressed))
+}
+
+func (c *Config) minRatio() float64 {
+	if c.MinRatio <= 0 {
+		return DefaultMinRatio
+	}
+	return c.MinRatio
+}
+
+func (c *Config) observeRatio(original, compressed []byte) {
+	if c.Metrics != nil {
+		c.Metrics.ObserveCompressionRatio(c.ratio(original, compressed))
+	}
+}
+
+func (c *Config) skip() {
+	if c.Metrics != nil {
+		c.Metrics.IncCompressionSkipped()
+	}
+}
+
+// codecFor returns codec to compress object with.
+func (c *Config) codecFor(obj *objectSDK.Object) codecLevel {
+	if obj == nil || (len(c.Rules) == 0 && len(c.UncompressableContentTypes) == 0) {
+		return c.defaultCodec()
+	}
+
+	for _, attr := range obj.Attributes() {
+		if attr.Key() != objectSDK.AttributeContentType {
+			continue
+		}
+		for i := range c.Rules {
+			if matchContentType(c.Rules[i].ContentTypes, attr.Value()) {
+				return codecLevel{codec: c.Rules[i].Codec, level: c.Rules[i].Level}
+			}
+		}
+		if matchContentType(c.UncompressableContentTypes, attr.Value()) {
+			return codecLevel{codec: CodecNone}
+		}
+	}
+	return c.defaultCodec()
+}
+
+// Close closes encoders and decoders, returns any error occurred.
 func (c *Config) Close() error {
 	var err error
-	if c.encoder != nil {
-		err = c.encoder.Close()
+	for _, enc := range c.encoders {
+		if cErr := enc.close(); cErr != nil && err == nil {
+			err = cErr
+		}
 	}
-	if c.decoder != nil {
-		c.decoder.Close()
+	for _, dec := range c.decoders {
+		dec.close()
 	}
 	return err
 }
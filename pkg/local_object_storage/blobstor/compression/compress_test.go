@@ -0,0 +1,168 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs(t *testing.T) {
+	data := bytes.Repeat([]byte("frostfs"), 1024)
+
+	compressed := make(map[Codec][]byte)
+	for _, codec := range []Codec{CodecZstd, CodecLZ4, CodecSnappy, CodecNone} {
+		c := Config{Enabled: true, Codec: codec}
+		require.NoError(t, c.Init())
+
+		res := c.Compress(data)
+		if codec == CodecNone {
+			require.Equal(t, data, res)
+		} else {
+			require.Less(t, len(res), len(data), codec)
+		}
+		compressed[codec] = res
+		require.NoError(t, c.Close())
+	}
+
+	t.Run("mixed data", func(t *testing.T) {
+		// Data compressed with any codec must be decompressed
+		// regardless of the codec in the configuration.
+		c := Config{}
+		require.NoError(t, c.Init())
+		defer c.Close()
+
+		for codec, res := range compressed {
+			actual, err := c.Decompress(res)
+			require.NoError(t, err, codec)
+			require.Equal(t, data, actual, codec)
+		}
+	})
+	t.Run("level", func(t *testing.T) {
+		c := Config{Enabled: true, Codec: CodecLZ4, Level: 10}
+		require.Error(t, c.Init())
+
+		c = Config{Enabled: true, Codec: CodecZstd, Level: 19}
+		require.NoError(t, c.Init())
+		defer c.Close()
+
+		actual, err := c.Decompress(c.Compress(data))
+		require.NoError(t, err)
+		require.Equal(t, data, actual)
+	})
+	t.Run("unknown codec", func(t *testing.T) {
+		require.Error(t, Codec("brotli").Validate())
+
+		c := Config{Enabled: true, Codec: "brotli"}
+		require.Error(t, c.Init())
+	})
+}
+
+func TestRules(t *testing.T) {
+	c := Config{
+		Enabled:                    true,
+		Codec:                      CodecLZ4,
+		UncompressableContentTypes: []string{"video/*"},
+		Rules: []Rule{
+			{ContentTypes: []string{"text/*"}, Codec: CodecSnappy},
+			{ContentTypes: []string{"*/json"}, Codec: CodecZstd},
+		},
+	}
+	require.NoError(t, c.Init())
+	defer c.Close()
+
+	data := bytes.Repeat([]byte("frostfs"), 1024)
+	newObject := func(contentType string) *objectSDK.Object {
+		obj := objectSDK.New()
+		if contentType != "" {
+			var a objectSDK.Attribute
+			a.SetKey(objectSDK.AttributeContentType)
+			a.SetValue(contentType)
+			obj.SetAttributes(a)
+		}
+		return obj
+	}
+
+	testCases := []struct {
+		contentType string
+		magic       []byte
+	}{
+		{"", lz4FrameMagic},
+		{"image/png", lz4FrameMagic},
+		{"text/plain", snappyStreamMagic},
+		{"application/json", zstdFrameMagic},
+		{"video/mp4", data[:4]},
+	}
+	for _, tc := range testCases {
+		res := c.CompressObject(newObject(tc.contentType), data)
+		require.True(t, bytes.HasPrefix(res, tc.magic), tc.contentType)
+
+		actual, err := c.Decompress(res)
+		require.NoError(t, err)
+		require.Equal(t, data, actual)
+	}
+
+	require.True(t, bytes.HasPrefix(c.CompressObject(nil, data), lz4FrameMagic))
+}
+
+type testMetrics struct {
+	ratios  []float64
+	skipped int
+}
+
+func (m *testMetrics) ObserveCompressionRatio(ratio float64) {
+	m.ratios = append(m.ratios, ratio)
+}
+
+func (m *testMetrics) IncCompressionSkipped() {
+	m.skipped++
+}
+
+func TestAdaptive(t *testing.T) {
+	m := new(testMetrics)
+	c := Config{Enabled: true, Adaptive: true, Metrics: m}
+	require.NoError(t, c.Init())
+	defer c.Close()
+
+	newObject := func(payload []byte) (*objectSDK.Object, []byte) {
+		obj := objectSDK.New()
+		obj.SetPayload(payload)
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+		return obj, data
+	}
+
+	random := make([]byte, probeSize*4)
+	_, _ = rand.Read(random)
+
+	t.Run("incompressible payload", func(t *testing.T) {
+		obj, data := newObject(random)
+		require.Equal(t, data, c.CompressObject(obj, data))
+		require.Equal(t, 1, m.skipped)
+
+		// Small objects are checked after the compression.
+		obj, data = newObject(random[:probeSize/2])
+		require.Equal(t, data, c.CompressObject(obj, data))
+		require.Equal(t, 2, m.skipped)
+		require.Empty(t, m.ratios)
+	})
+	t.Run("compressible payload", func(t *testing.T) {
+		obj, data := newObject(bytes.Repeat([]byte("frostfs"), probeSize))
+		res := c.CompressObject(obj, data)
+		require.True(t, bytes.HasPrefix(res, zstdFrameMagic))
+		require.Equal(t, 2, m.skipped)
+		require.Len(t, m.ratios, 1)
+		require.Greater(t, m.ratios[0], DefaultMinRatio)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		c := Config{Enabled: true, Metrics: m}
+		require.NoError(t, c.Init())
+		defer c.Close()
+
+		obj, data := newObject(random)
+		require.True(t, bytes.HasPrefix(c.CompressObject(obj, data), zstdFrameMagic))
+		require.Equal(t, 2, m.skipped)
+	})
+}
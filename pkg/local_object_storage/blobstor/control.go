@@ -34,6 +34,11 @@ func (b *BlobStor) Init() error {
 	if err := b.compression.Init(); err != nil {
 		return err
 	}
+	for i := range b.subCompression {
+		if err := b.subCompression[i].Init(); err != nil {
+			return err
+		}
+	}
 
 	for i := range b.storage {
 		err := b.storage[i].Storage.Init()
@@ -64,5 +69,11 @@ func (b *BlobStor) Close() error {
 	if firstErr == nil {
 		firstErr = err
 	}
+	for i := range b.subCompression {
+		err := b.subCompression[i].Close()
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
 	return firstErr
 }
@@ -228,7 +228,7 @@ func (t *FSTree) Put(prm common.PutPrm) (common.PutRes, error) {
 		return common.PutRes{}, err
 	}
 	if !prm.DontCompress {
-		prm.RawData = t.Compress(prm.RawData)
+		prm.RawData = t.CompressObject(prm.Object, prm.RawData)
 	}
 
 	// Here is a situation:
@@ -0,0 +1,52 @@
+package lsm
+
+import (
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// Open implements common.Storage.
+func (s *LSM) Open(readOnly bool) error {
+	s.readOnly = readOnly
+
+	if !readOnly {
+		if err := util.MkdirAllX(s.path, s.perm); err != nil {
+			return fmt.Errorf("can't create dir %s for the LSM storage: %w", s.path, err)
+		}
+	}
+
+	db, err := leveldb.OpenFile(s.path, &opt.Options{
+		BlockCacheCapacity: s.blockCacheSize,
+		WriteBuffer:        s.writeBufferSize,
+		// Objects are compressed by the blobstor.
+		Compression: opt.NoCompression,
+		NoSync:      s.noSync,
+		ReadOnly:    readOnly,
+	})
+	if err != nil {
+		s.reportError("could not open LSM storage", err)
+		return fmt.Errorf("can't open the LSM storage %s: %w", s.path, err)
+	}
+
+	s.db = db
+	return nil
+}
+
+// Init implements common.Storage.
+func (s *LSM) Init() error {
+	return nil
+}
+
+// Close implements common.Storage.
+func (s *LSM) Close() error {
+	if s.db == nil {
+		return nil
+	}
+
+	err := s.db.Close()
+	s.db = nil
+	return err
+}
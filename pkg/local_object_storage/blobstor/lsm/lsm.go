@@ -0,0 +1,232 @@
+package lsm
+
+import (
+	"errors"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// LSM represents an object storage backed by an embedded LSM-tree key-value database.
+// Objects are stored by the container ID + object ID key, so that sequential
+// writes of small objects do not suffer from the B+-tree write amplification.
+//
+// The storage is intended to be used for small objects instead of the blobovnicza tree,
+// i.e. as the first sub-storage of the blobstor, because the non-empty storage ID is returned on Put.
+type LSM struct {
+	cfg
+
+	*compression.Config
+	db       *leveldb.DB
+	readOnly bool
+}
+
+// Type is LSM storage type used in logs and configuration.
+const Type = "lsm"
+
+// storageID is returned as the storage ID of every stored object.
+var storageID = []byte(Type)
+
+const addressKeySize = 2 * 32
+
+var _ common.Storage = (*LSM)(nil)
+
+// New creates new LSM storage instance.
+func New(opts ...Option) *LSM {
+	s := new(LSM)
+	initConfig(&s.cfg)
+
+	for i := range opts {
+		opts[i](&s.cfg)
+	}
+
+	return s
+}
+
+func addressKey(addr oid.Address) []byte {
+	key := make([]byte, addressKeySize)
+	addr.Container().Encode(key)
+	addr.Object().Encode(key[32:])
+	return key
+}
+
+func addressFromKey(key []byte) (oid.Address, error) {
+	var addr oid.Address
+	if len(key) != addressKeySize {
+		return addr, errors.New("invalid address key")
+	}
+
+	var cnr cid.ID
+	if err := cnr.Decode(key[:32]); err != nil {
+		return addr, err
+	}
+
+	var obj oid.ID
+	if err := obj.Decode(key[32:]); err != nil {
+		return addr, err
+	}
+
+	addr.SetContainer(cnr)
+	addr.SetObject(obj)
+	return addr, nil
+}
+
+// Put implements common.Storage.
+func (s *LSM) Put(prm common.PutPrm) (common.PutRes, error) {
+	if s.readOnly {
+		return common.PutRes{}, common.ErrReadOnly
+	}
+
+	if !prm.DontCompress {
+		prm.RawData = s.CompressObject(prm.Object, prm.RawData)
+	}
+
+	if err := s.db.Put(addressKey(prm.Address), prm.RawData, nil); err != nil {
+		s.reportError("could not put object to LSM storage", err)
+		return common.PutRes{}, err
+	}
+	return common.PutRes{StorageID: storageID}, nil
+}
+
+// Delete implements common.Storage.
+func (s *LSM) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
+	if s.readOnly {
+		return common.DeleteRes{}, common.ErrReadOnly
+	}
+
+	key := addressKey(prm.Address)
+	ok, err := s.db.Has(key, nil)
+	if err != nil {
+		return common.DeleteRes{}, err
+	}
+	if !ok {
+		return common.DeleteRes{}, logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	if err := s.db.Delete(key, nil); err != nil {
+		s.reportError("could not delete object from LSM storage", err)
+		return common.DeleteRes{}, err
+	}
+	return common.DeleteRes{}, nil
+}
+
+// Exists implements common.Storage.
+func (s *LSM) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
+	ok, err := s.db.Has(addressKey(prm.Address), nil)
+	return common.ExistsRes{Exists: ok}, err
+}
+
+// Get implements common.Storage.
+func (s *LSM) Get(prm common.GetPrm) (common.GetRes, error) {
+	data, err := s.db.Get(addressKey(prm.Address), nil)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return common.GetRes{}, logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+		return common.GetRes{}, err
+	}
+
+	data, err = s.Decompress(data)
+	if err != nil {
+		return common.GetRes{}, err
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return common.GetRes{}, err
+	}
+
+	return common.GetRes{Object: obj, RawData: data}, nil
+}
+
+// GetRange implements common.Storage.
+func (s *LSM) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	res, err := s.Get(common.GetPrm{Address: prm.Address})
+	if err != nil {
+		return common.GetRangeRes{}, err
+	}
+
+	payload := res.Object.Payload()
+	from := prm.Range.GetOffset()
+	to := from + prm.Range.GetLength()
+
+	if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+		return common.GetRangeRes{}, logicerr.Wrap(apistatus.ObjectOutOfRange{})
+	}
+
+	return common.GetRangeRes{
+		Data: payload[from:to],
+	}, nil
+}
+
+// Iterate implements common.Storage.
+func (s *LSM) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
+	it := s.db.NewIterator(nil, nil)
+	defer it.Release()
+
+	for it.Next() {
+		addr, err := addressFromKey(it.Key())
+		if err != nil {
+			// Not an object key.
+			continue
+		}
+
+		// Value is only valid until the next iteration.
+		data := append([]byte(nil), it.Value()...)
+
+		if prm.LazyHandler != nil {
+			err = prm.LazyHandler(addr, func() ([]byte, error) {
+				return data, nil
+			})
+		} else {
+			data, err = s.Decompress(data)
+			if err != nil {
+				if prm.IgnoreErrors {
+					if prm.ErrorHandler != nil {
+						return common.IterateRes{}, prm.ErrorHandler(addr, err)
+					}
+					continue
+				}
+				return common.IterateRes{}, err
+			}
+
+			err = prm.Handler(common.IterationElement{
+				Address:    addr,
+				ObjectData: data,
+				StorageID:  storageID,
+			})
+		}
+
+		if err != nil {
+			return common.IterateRes{}, err
+		}
+	}
+
+	return common.IterateRes{}, it.Error()
+}
+
+// Type implements common.Storage.
+func (*LSM) Type() string {
+	return Type
+}
+
+// Path implements common.Storage.
+func (s *LSM) Path() string {
+	return s.path
+}
+
+// SetCompressor implements common.Storage.
+func (s *LSM) SetCompressor(cc *compression.Config) {
+	s.Config = cc
+}
+
+// SetReportErrorFunc implements common.Storage.
+func (s *LSM) SetReportErrorFunc(f func(string, error)) {
+	s.reportError = f
+}
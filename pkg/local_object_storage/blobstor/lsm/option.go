@@ -0,0 +1,72 @@
+package lsm
+
+import (
+	"io/fs"
+)
+
+type cfg struct {
+	path            string
+	perm            fs.FileMode
+	noSync          bool
+	blockCacheSize  int
+	writeBufferSize int
+	// reportError is the function called when encountering disk errors.
+	reportError func(string, error)
+}
+
+type Option func(*cfg)
+
+const (
+	defaultPerm            = 0700
+	defaultBlockCacheSize  = 8 << 20
+	defaultWriteBufferSize = 4 << 20
+)
+
+func initConfig(c *cfg) {
+	*c = cfg{
+		perm:            defaultPerm,
+		blockCacheSize:  defaultBlockCacheSize,
+		writeBufferSize: defaultWriteBufferSize,
+		reportError:     func(string, error) {},
+	}
+}
+
+// WithPath returns option to set the path to the database directory.
+func WithPath(p string) Option {
+	return func(c *cfg) {
+		c.path = p
+	}
+}
+
+// WithPerm returns option to set permission bits of the database directory.
+func WithPerm(perm fs.FileMode) Option {
+	return func(c *cfg) {
+		c.perm = perm
+	}
+}
+
+// WithNoSync returns option to disable syncing of the write-ahead log on every write.
+func WithNoSync(noSync bool) Option {
+	return func(c *cfg) {
+		c.noSync = noSync
+	}
+}
+
+// WithBlockCacheSize returns option to set the size of the block cache in bytes.
+func WithBlockCacheSize(sz int) Option {
+	return func(c *cfg) {
+		if sz > 0 {
+			c.blockCacheSize = sz
+		}
+	}
+}
+
+// WithWriteBufferSize returns option to set the size of the in-memory table in bytes.
+// Larger buffer decreases the amount of compactions at the cost of memory usage.
+func WithWriteBufferSize(sz int) Option {
+	return func(c *cfg) {
+		if sz > 0 {
+			c.writeBufferSize = sz
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package segment
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"go.uber.org/zap"
+)
+
+// Compact compacts all the sealed segments having the ratio of garbage
+// not less than the configured threshold: the records which are still needed
+// are appended to the active segment and the old segment file is removed.
+func (s *Segments) Compact() error {
+	if s.readOnly {
+		return common.ErrReadOnly
+	}
+
+	s.compactMtx.Lock()
+	defer s.compactMtx.Unlock()
+
+	s.mtx.RLock()
+	var ids []uint64
+	for id, seg := range s.segments {
+		if seg != s.active && seg.garbage() >= s.compactionThreshold {
+			ids = append(ids, id)
+		}
+	}
+	s.mtx.RUnlock()
+
+	// Older segments go first, so that the delete records
+	// for the objects in them become garbage.
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		if err := s.compactSegment(id); err != nil {
+			return fmt.Errorf("could not compact segment %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func (s *Segments) compactSegment(id uint64) error {
+	s.mtx.RLock()
+	seg := s.segments[id]
+	s.mtx.RUnlock()
+	if seg == nil {
+		return nil
+	}
+
+	// Sealed segment is never modified, so it is safe to read it without the lock.
+	var moved int64
+	for off := int64(0); off < seg.size; {
+		rec, err := readRecord(seg.f, off, seg.size)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				s.log.Warn("segment is corrupted, skip the rest of it",
+					zap.String("path", seg.f.Name()),
+					zap.Int64("offset", off),
+					zap.Error(err))
+			}
+			break
+		}
+
+		n, err := s.moveRecord(seg, off, &rec)
+		if err != nil {
+			return err
+		}
+
+		moved += n
+		off += rec.size()
+	}
+
+	s.mtx.Lock()
+	err := s.removeSegment(seg)
+	s.mtx.Unlock()
+	if err != nil {
+		s.reportError("could not remove segment", err)
+		return err
+	}
+
+	reclaimed := seg.size - moved
+	if reclaimed < 0 {
+		reclaimed = 0
+	}
+	s.metrics.IncSegmentCompactions()
+	s.metrics.AddSegmentReclaimedBytes(uint64(reclaimed))
+
+	s.log.Debug("segment compacted",
+		zap.Uint64("id", id),
+		zap.Int64("moved", moved),
+		zap.Int64("reclaimed", reclaimed))
+	return nil
+}
+
+// moveRecord appends the record from the compacted segment to the active one
+// if it is still needed. Returns the amount of bytes written.
+func (s *Segments) moveRecord(seg *segment, off int64, rec *record) (int64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	switch rec.typ {
+	case recordPut:
+		loc, ok := s.index[rec.addr]
+		if !ok || loc.segment != seg.id || loc.offset != off {
+			return 0, nil
+		}
+
+		newLoc, err := s.append(rec)
+		if err != nil {
+			return 0, err
+		}
+
+		s.index[rec.addr] = newLoc
+		seg.live -= loc.size
+		s.active.live += newLoc.size
+		return newLoc.size, nil
+	case recordDelete:
+		target := rec.target()
+		if _, ok := s.segments[target]; !ok || target == seg.id {
+			return 0, nil
+		}
+
+		newLoc, err := s.append(rec)
+		if err != nil {
+			return 0, err
+		}
+
+		seg.live -= newLoc.size
+		seg.tombstones[target] -= newLoc.size
+		s.active.live += newLoc.size
+		s.active.tombstones[target] += newLoc.size
+		return newLoc.size, nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,90 @@
+package segment
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testMetrics struct {
+	compactions uint64
+	reclaimed   uint64
+}
+
+func (m *testMetrics) IncSegmentCompactions()            { m.compactions++ }
+func (m *testMetrics) AddSegmentReclaimedBytes(n uint64) { m.reclaimed += n }
+
+func TestCompact(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "segments")
+	m := new(testMetrics)
+
+	const objSize = 1024
+	newStorage := func() *Segments {
+		s := New(
+			WithPath(dir),
+			WithNoSync(true),
+			WithSegmentSize(16*(objSize+headerSize)),
+			WithCompactionInterval(0),
+			WithMetrics(m))
+		s.SetCompressor(new(compression.Config))
+		require.NoError(t, s.Open(false))
+		require.NoError(t, s.Init())
+		return s
+	}
+
+	s := newStorage()
+
+	addrs := make([]oid.Address, 64)
+	data := make([][]byte, len(addrs))
+	for i := range addrs {
+		addrs[i] = oidtest.Address()
+		data[i] = make([]byte, objSize)
+		data[i][0] = byte(i)
+
+		_, err := s.Put(common.PutPrm{Address: addrs[i], RawData: data[i], DontCompress: true})
+		require.NoError(t, err)
+	}
+
+	// Delete all objects except every 8th one.
+	for i := range addrs {
+		if i%8 != 0 {
+			_, err := s.Delete(common.DeletePrm{Address: addrs[i]})
+			require.NoError(t, err)
+		}
+	}
+
+	_, err := os.Stat(s.segmentPath(1))
+	require.NoError(t, err)
+
+	require.NoError(t, s.Compact())
+	require.NotZero(t, m.compactions)
+	require.NotZero(t, m.reclaimed)
+
+	_, err = os.Stat(s.segmentPath(1))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	check := func(s *Segments) {
+		for i := range addrs {
+			res, err := s.getRaw(addrs[i])
+			if i%8 != 0 {
+				require.Error(t, err)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, data[i], res)
+		}
+	}
+
+	check(s)
+	require.NoError(t, s.Close())
+
+	s = newStorage()
+	check(s)
+	require.NoError(t, s.Close())
+}
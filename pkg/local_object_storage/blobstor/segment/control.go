@@ -0,0 +1,265 @@
+package segment
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// Open opens all the segments and restores the index.
+// In read-write mode the last segment becomes active.
+func (s *Segments) Open(readOnly bool) error {
+	s.readOnly = readOnly
+	s.index = make(map[oid.Address]location)
+	s.segments = make(map[uint64]*segment)
+	s.active = nil
+
+	if !readOnly {
+		if err := util.MkdirAllX(s.path, s.perm); err != nil {
+			return fmt.Errorf("can't create dir %s for the segment storage: %w", s.path, err)
+		}
+	}
+
+	ids, err := s.listSegments()
+	if err != nil {
+		if readOnly && errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("can't list segments: %w", err)
+	}
+
+	flag := os.O_RDWR
+	if readOnly {
+		flag = os.O_RDONLY
+	}
+
+	for i, id := range ids {
+		f, err := os.OpenFile(s.segmentPath(id), flag, s.perm)
+		if err != nil {
+			s.closeSegments()
+			s.reportError("could not open segment", err)
+			return fmt.Errorf("can't open segment %d: %w", id, err)
+		}
+
+		seg := &segment{id: id, f: f, tombstones: make(map[uint64]int64)}
+		s.segments[id] = seg
+
+		if err := s.loadSegment(seg, !readOnly && i == len(ids)-1); err != nil {
+			s.closeSegments()
+			s.reportError("could not load segment", err)
+			return fmt.Errorf("can't load segment %d: %w", id, err)
+		}
+	}
+
+	if readOnly {
+		return nil
+	}
+
+	if len(ids) != 0 {
+		s.active = s.segments[ids[len(ids)-1]]
+		return nil
+	}
+
+	s.active, err = s.newSegment(1)
+	if err != nil {
+		return fmt.Errorf("can't create segment: %w", err)
+	}
+	return nil
+}
+
+// Init starts background compaction in read-write mode.
+func (s *Segments) Init() error {
+	if s.readOnly || s.compactionInterval <= 0 || s.closeCh != nil {
+		return nil
+	}
+
+	s.closeCh = make(chan struct{})
+	s.wg.Add(1)
+	go s.compactLoop(s.closeCh)
+	return nil
+}
+
+// Close stops background compaction and closes all the segments.
+func (s *Segments) Close() error {
+	if s.closeCh != nil {
+		close(s.closeCh)
+		s.wg.Wait()
+		s.closeCh = nil
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.closeSegments()
+}
+
+func (s *Segments) closeSegments() error {
+	var firstErr error
+	for _, seg := range s.segments {
+		if err := seg.f.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	s.segments = nil
+	s.index = nil
+	s.active = nil
+	return firstErr
+}
+
+// listSegments returns the IDs of the segments in ascending order.
+func (s *Segments) listSegments() ([]uint64, error) {
+	des, err := os.ReadDir(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint64
+	for i := range des {
+		name := des[i].Name()
+		if des[i].IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 16, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// loadSegment applies all the records of the segment to the index.
+// If truncate is true, the invalid tail of the segment is removed,
+// otherwise it is considered garbage.
+//
+// Only headers of put records are read, their checksums are verified
+// when the object is read.
+func (s *Segments) loadSegment(seg *segment, truncate bool) error {
+	fi, err := seg.f.Stat()
+	if err != nil {
+		return err
+	}
+
+	var off int64
+	for {
+		var target uint64
+
+		h, err := readHeader(seg.f, off, fi.Size())
+		if err == nil && h.typ == recordDelete {
+			var rec record
+			rec, err = readRecord(seg.f, off, fi.Size())
+			if err == nil {
+				target = rec.target()
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			s.log.Warn("segment is corrupted, skip the rest of it",
+				zap.String("path", seg.f.Name()),
+				zap.Int64("offset", off),
+				zap.Error(err))
+
+			if truncate {
+				if err := seg.f.Truncate(off); err != nil {
+					return err
+				}
+				fi, err = seg.f.Stat()
+				if err != nil {
+					return err
+				}
+			}
+			break
+		}
+
+		s.applyRecord(seg, off, &h, target)
+		off += h.recordSize()
+	}
+
+	seg.size = fi.Size()
+	return nil
+}
+
+// applyRecord updates the index with the record stored in seg at the offset.
+// target is the segment ID from the data of the delete record.
+func (s *Segments) applyRecord(seg *segment, off int64, h *header, target uint64) {
+	size := h.recordSize()
+	switch h.typ {
+	case recordPut:
+		if old, ok := s.index[h.addr]; ok {
+			s.segments[old.segment].live -= old.size
+		}
+		s.index[h.addr] = location{segment: seg.id, offset: off, size: size}
+		seg.live += size
+	case recordDelete:
+		if loc, ok := s.index[h.addr]; ok && loc.segment == target {
+			delete(s.index, h.addr)
+			s.segments[target].live -= loc.size
+		}
+		if _, ok := s.segments[target]; ok {
+			seg.live += size
+			seg.tombstones[target] += size
+		}
+	}
+}
+
+// newSegment creates a new empty segment.
+func (s *Segments) newSegment(id uint64) (*segment, error) {
+	f, err := os.OpenFile(s.segmentPath(id), os.O_RDWR|os.O_CREATE|os.O_EXCL, s.perm)
+	if err != nil {
+		s.reportError("could not create segment", err)
+		return nil, err
+	}
+
+	seg := &segment{id: id, f: f, tombstones: make(map[uint64]int64)}
+	s.segments[id] = seg
+	return seg, nil
+}
+
+// removeSegment closes and removes the segment. Delete records
+// for the objects in it become garbage.
+func (s *Segments) removeSegment(seg *segment) error {
+	delete(s.segments, seg.id)
+	for _, other := range s.segments {
+		if sz, ok := other.tombstones[seg.id]; ok {
+			other.live -= sz
+			delete(other.tombstones, seg.id)
+		}
+	}
+
+	if err := seg.f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(seg.f.Name())
+}
+
+func (s *Segments) compactLoop(closeCh <-chan struct{}) {
+	defer s.wg.Done()
+
+	t := time.NewTicker(s.compactionInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-closeCh:
+			return
+		case <-t.C:
+			if err := s.Compact(); err != nil {
+				s.log.Error("segment compaction failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package segment
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+)
+
+// Delete implements common.Storage.
+// The delete record is appended to the active segment, the space
+// occupied by the object is reclaimed by the compaction.
+func (s *Segments) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
+	if s.readOnly {
+		return common.DeleteRes{}, common.ErrReadOnly
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	loc, ok := s.index[prm.Address]
+	if !ok {
+		return common.DeleteRes{}, logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	rec := newTombstone(prm.Address, loc.segment)
+	tsLoc, err := s.append(&rec)
+	if err != nil {
+		return common.DeleteRes{}, err
+	}
+
+	delete(s.index, prm.Address)
+	s.segments[loc.segment].live -= loc.size
+	s.active.live += tsLoc.size
+	s.active.tombstones[loc.segment] += tsLoc.size
+	return common.DeleteRes{}, nil
+}
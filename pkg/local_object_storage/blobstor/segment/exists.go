@@ -0,0 +1,14 @@
+package segment
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+)
+
+// Exists implements common.Storage.
+func (s *Segments) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	_, ok := s.index[prm.Address]
+	return common.ExistsRes{Exists: ok}, nil
+}
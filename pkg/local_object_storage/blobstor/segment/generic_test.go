@@ -0,0 +1,48 @@
+package segment
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/internal/blobstortest"
+)
+
+func TestGeneric(t *testing.T) {
+	defer func() { _ = os.RemoveAll(t.Name()) }()
+
+	helper := func(t *testing.T, dir string) common.Storage {
+		return New(WithPath(dir), WithNoSync(true), WithSegmentSize(16*1024))
+	}
+
+	var n int
+	newStorage := func(t *testing.T) common.Storage {
+		n++
+		dir := filepath.Join(t.Name(), strconv.Itoa(n))
+		return helper(t, dir)
+	}
+
+	blobstortest.TestAll(t, newStorage, 1024, 16*1024)
+
+	t.Run("info", func(t *testing.T) {
+		dir := filepath.Join(t.Name(), "info")
+		blobstortest.TestInfo(t, func(t *testing.T) common.Storage {
+			return helper(t, dir)
+		}, Type, dir)
+	})
+}
+
+func TestControl(t *testing.T) {
+	defer func() { _ = os.RemoveAll(t.Name()) }()
+
+	var n int
+	newStorage := func(t *testing.T) common.Storage {
+		n++
+		dir := filepath.Join(t.Name(), strconv.Itoa(n))
+		return New(WithPath(dir), WithNoSync(true), WithSegmentSize(16*1024))
+	}
+
+	blobstortest.TestControl(t, newStorage, 1024, 2048)
+}
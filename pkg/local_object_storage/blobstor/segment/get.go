@@ -0,0 +1,73 @@
+package segment
+
+import (
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Get implements common.Storage.
+func (s *Segments) Get(prm common.GetPrm) (common.GetRes, error) {
+	data, err := s.getRaw(prm.Address)
+	if err != nil {
+		return common.GetRes{}, err
+	}
+
+	data, err = s.Decompress(data)
+	if err != nil {
+		return common.GetRes{}, err
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return common.GetRes{}, err
+	}
+
+	return common.GetRes{Object: obj, RawData: data}, nil
+}
+
+// GetRange implements common.Storage.
+func (s *Segments) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	res, err := s.Get(common.GetPrm{Address: prm.Address})
+	if err != nil {
+		return common.GetRangeRes{}, err
+	}
+
+	payload := res.Object.Payload()
+	from := prm.Range.GetOffset()
+	to := from + prm.Range.GetLength()
+
+	if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+		return common.GetRangeRes{}, logicerr.Wrap(apistatus.ObjectOutOfRange{})
+	}
+
+	return common.GetRangeRes{
+		Data: payload[from:to],
+	}, nil
+}
+
+// getRaw returns the stored (possibly compressed) object data.
+func (s *Segments) getRaw(addr oid.Address) ([]byte, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	loc, ok := s.index[addr]
+	if !ok {
+		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	seg := s.segments[loc.segment]
+	rec, err := readRecord(seg.f, loc.offset, seg.size)
+	if err == nil && rec.addr != addr {
+		err = errInvalidRecord
+	}
+	if err != nil {
+		s.reportError("could not read object from segment", err)
+		return nil, fmt.Errorf("could not read object from segment %d at %d: %w", loc.segment, loc.offset, err)
+	}
+	return rec.data, nil
+}
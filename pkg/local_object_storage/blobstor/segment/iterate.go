@@ -0,0 +1,75 @@
+package segment
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Iterate implements common.Storage.
+// Objects are iterated in the order they are stored in the segments.
+func (s *Segments) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
+	type addrLoc struct {
+		addr oid.Address
+		loc  location
+	}
+
+	s.mtx.RLock()
+	objs := make([]addrLoc, 0, len(s.index))
+	for addr, loc := range s.index {
+		objs = append(objs, addrLoc{addr: addr, loc: loc})
+	}
+	s.mtx.RUnlock()
+
+	sort.Slice(objs, func(i, j int) bool {
+		if objs[i].loc.segment != objs[j].loc.segment {
+			return objs[i].loc.segment < objs[j].loc.segment
+		}
+		return objs[i].loc.offset < objs[j].loc.offset
+	})
+
+	for i := range objs {
+		addr := objs[i].addr
+
+		var err error
+		if prm.LazyHandler != nil {
+			err = prm.LazyHandler(addr, func() ([]byte, error) {
+				return s.getRaw(addr)
+			})
+		} else {
+			var data []byte
+			data, err = s.getRaw(addr)
+			if errors.As(err, new(apistatus.ObjectNotFound)) {
+				// Removed concurrently.
+				continue
+			}
+			if err == nil {
+				data, err = s.Decompress(data)
+			}
+			if err != nil {
+				if prm.IgnoreErrors {
+					if prm.ErrorHandler != nil {
+						return common.IterateRes{}, prm.ErrorHandler(addr, err)
+					}
+					continue
+				}
+				return common.IterateRes{}, err
+			}
+
+			err = prm.Handler(common.IterationElement{
+				Address:    addr,
+				ObjectData: data,
+				StorageID:  storageID,
+			})
+		}
+
+		if err != nil {
+			return common.IterateRes{}, err
+		}
+	}
+
+	return common.IterateRes{}, nil
+}
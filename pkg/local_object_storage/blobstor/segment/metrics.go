@@ -0,0 +1,11 @@
+package segment
+
+import "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+
+// Metrics is an interface for segment compaction statistics.
+type Metrics = common.CompactionMetrics
+
+type noopMetrics struct{}
+
+func (noopMetrics) IncSegmentCompactions()          {}
+func (noopMetrics) AddSegmentReclaimedBytes(uint64) {}
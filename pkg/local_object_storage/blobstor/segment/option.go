@@ -0,0 +1,108 @@
+package segment
+
+import (
+	"io/fs"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"go.uber.org/zap"
+)
+
+type cfg struct {
+	log                 *logger.Logger
+	path                string
+	perm                fs.FileMode
+	noSync              bool
+	segmentSize         int64
+	compactionThreshold float64
+	compactionInterval  time.Duration
+	metrics             Metrics
+	// reportError is the function called when encountering disk errors.
+	reportError func(string, error)
+}
+
+type Option func(*cfg)
+
+const (
+	defaultPerm                = 0700
+	defaultSegmentSize         = 64 << 20
+	defaultCompactionThreshold = 0.5
+	defaultCompactionInterval  = time.Minute
+)
+
+func initConfig(c *cfg) {
+	*c = cfg{
+		log:                 &logger.Logger{Logger: zap.L()},
+		perm:                defaultPerm,
+		segmentSize:         defaultSegmentSize,
+		compactionThreshold: defaultCompactionThreshold,
+		compactionInterval:  defaultCompactionInterval,
+		metrics:             noopMetrics{},
+		reportError:         func(string, error) {},
+	}
+}
+
+// WithLogger returns option to specify the logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(c *cfg) {
+		c.log = l
+	}
+}
+
+// WithPath returns option to set the path to the directory with segment files.
+func WithPath(p string) Option {
+	return func(c *cfg) {
+		c.path = p
+	}
+}
+
+// WithPerm returns option to set permission bits of the created files and directories.
+func WithPerm(perm fs.FileMode) Option {
+	return func(c *cfg) {
+		c.perm = perm
+	}
+}
+
+// WithNoSync returns option to disable syncing of the segment file on every write.
+func WithNoSync(noSync bool) Option {
+	return func(c *cfg) {
+		c.noSync = noSync
+	}
+}
+
+// WithSegmentSize returns option to set the size of a segment file
+// after which a new segment is started.
+func WithSegmentSize(sz int64) Option {
+	return func(c *cfg) {
+		if sz > 0 {
+			c.segmentSize = sz
+		}
+	}
+}
+
+// WithCompactionThreshold returns option to set the ratio of the garbage
+// in a segment after which the segment is compacted. Must be in (0, 1].
+func WithCompactionThreshold(ratio float64) Option {
+	return func(c *cfg) {
+		if ratio > 0 && ratio <= 1 {
+			c.compactionThreshold = ratio
+		}
+	}
+}
+
+// WithCompactionInterval returns option to set the interval between
+// background compactions. Zero value disables background compaction.
+func WithCompactionInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		c.compactionInterval = d
+	}
+}
+
+// WithMetrics returns option to specify compaction statistics receiver.
+func WithMetrics(m Metrics) Option {
+	return func(c *cfg) {
+		if m != nil {
+			c.metrics = m
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package segment
+
+import (
+	"errors"
+	"syscall"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+)
+
+// Put implements common.Storage.
+// The object is not written again if it is already stored.
+func (s *Segments) Put(prm common.PutPrm) (common.PutRes, error) {
+	if s.readOnly {
+		return common.PutRes{}, common.ErrReadOnly
+	}
+
+	if !prm.DontCompress {
+		prm.RawData = s.CompressObject(prm.Object, prm.RawData)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, ok := s.index[prm.Address]; ok {
+		return common.PutRes{StorageID: storageID}, nil
+	}
+
+	rec := record{typ: recordPut, addr: prm.Address, data: prm.RawData}
+	loc, err := s.append(&rec)
+	if err != nil {
+		return common.PutRes{}, err
+	}
+
+	s.index[prm.Address] = loc
+	s.active.live += loc.size
+	return common.PutRes{StorageID: storageID}, nil
+}
+
+// append writes the record to the active segment, starting a new one if
+// the active segment is full. Must be called with the write lock held.
+func (s *Segments) append(rec *record) (location, error) {
+	if s.active.size != 0 && s.active.size+rec.size() > s.segmentSize {
+		seg, err := s.newSegment(s.active.id + 1)
+		if err != nil {
+			return location{}, err
+		}
+		s.active = seg
+	}
+
+	data := rec.marshal()
+	if _, err := s.active.f.WriteAt(data, s.active.size); err != nil {
+		if errors.Is(err, syscall.ENOSPC) {
+			return location{}, common.ErrNoSpace
+		}
+		s.reportError("could not write to segment", err)
+		return location{}, err
+	}
+	if !s.noSync {
+		if err := s.active.f.Sync(); err != nil {
+			s.reportError("could not sync segment", err)
+			return location{}, err
+		}
+	}
+
+	loc := location{segment: s.active.id, offset: s.active.size, size: int64(len(data))}
+	s.active.size += loc.size
+	return loc, nil
+}
@@ -0,0 +1,150 @@
+package segment
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Record layout:
+// - type (1 byte),
+// - container ID (32 bytes),
+// - object ID (32 bytes),
+// - data length (4 bytes, little-endian),
+// - CRC32 checksum of all the previous fields and data (4 bytes, little-endian),
+// - data.
+//
+// Data of a put record is the (possibly compressed) object.
+// Data of a delete record is the ID of the segment containing the removed object
+// (8 bytes, little-endian).
+const (
+	recordPut    byte = 1
+	recordDelete byte = 2
+
+	addressSize = 2 * 32
+	headerSize  = 1 + addressSize + 4 + 4
+
+	tombstoneSize = headerSize + 8
+)
+
+var errInvalidRecord = errors.New("invalid segment record")
+
+type record struct {
+	typ  byte
+	addr oid.Address
+	data []byte
+}
+
+// size returns the size of the encoded record.
+func (r *record) size() int64 {
+	return int64(headerSize + len(r.data))
+}
+
+func (r *record) marshal() []byte {
+	buf := make([]byte, headerSize+len(r.data))
+	buf[0] = r.typ
+	r.addr.Container().Encode(buf[1:])
+	r.addr.Object().Encode(buf[1+32:])
+	binary.LittleEndian.PutUint32(buf[1+addressSize:], uint32(len(r.data)))
+	copy(buf[headerSize:], r.data)
+
+	sum := crc32.ChecksumIEEE(buf[:headerSize-4])
+	sum = crc32.Update(sum, crc32.IEEETable, r.data)
+	binary.LittleEndian.PutUint32(buf[headerSize-4:], sum)
+	return buf
+}
+
+// header is a decoded record header.
+type header struct {
+	raw     [headerSize]byte
+	typ     byte
+	addr    oid.Address
+	dataLen int64
+}
+
+// recordSize returns the size of the encoded record.
+func (h *header) recordSize() int64 {
+	return headerSize + h.dataLen
+}
+
+// readHeader reads the header of the record at the offset of r.
+// limit is the size of r, records are not allowed to cross it.
+// io.EOF is returned if there are no records at the offset,
+// io.ErrUnexpectedEOF is returned if the record is truncated.
+func readHeader(r io.ReaderAt, off, limit int64) (header, error) {
+	var h header
+	n, err := r.ReadAt(h.raw[:], off)
+	if n == 0 && errors.Is(err, io.EOF) {
+		return header{}, io.EOF
+	} else if n < headerSize {
+		if errors.Is(err, io.EOF) {
+			return header{}, io.ErrUnexpectedEOF
+		}
+		return header{}, err
+	}
+
+	h.typ = h.raw[0]
+	if h.typ != recordPut && h.typ != recordDelete {
+		return header{}, errInvalidRecord
+	}
+
+	var cnr cid.ID
+	if err := cnr.Decode(h.raw[1 : 1+32]); err != nil {
+		return header{}, err
+	}
+	var obj oid.ID
+	if err := obj.Decode(h.raw[1+32 : 1+addressSize]); err != nil {
+		return header{}, err
+	}
+	h.addr.SetContainer(cnr)
+	h.addr.SetObject(obj)
+
+	h.dataLen = int64(binary.LittleEndian.Uint32(h.raw[1+addressSize:]))
+	if h.typ == recordDelete && h.dataLen != 8 {
+		return header{}, errInvalidRecord
+	}
+	if off+h.recordSize() > limit {
+		return header{}, io.ErrUnexpectedEOF
+	}
+	return h, nil
+}
+
+// readRecord reads the record at the offset of r and verifies its checksum.
+// limit is the size of r, see readHeader.
+func readRecord(r io.ReaderAt, off, limit int64) (record, error) {
+	h, err := readHeader(r, off, limit)
+	if err != nil {
+		return record{}, err
+	}
+
+	rec := record{typ: h.typ, addr: h.addr, data: make([]byte, h.dataLen)}
+	n, err := r.ReadAt(rec.data, off+headerSize)
+	if n < len(rec.data) {
+		if errors.Is(err, io.EOF) {
+			return record{}, io.ErrUnexpectedEOF
+		}
+		return record{}, err
+	}
+
+	sum := crc32.ChecksumIEEE(h.raw[:headerSize-4])
+	sum = crc32.Update(sum, crc32.IEEETable, rec.data)
+	if sum != binary.LittleEndian.Uint32(h.raw[headerSize-4:]) {
+		return record{}, errInvalidRecord
+	}
+	return rec, nil
+}
+
+// target returns the ID of the segment which contained the object removed by the delete record.
+func (r *record) target() uint64 {
+	return binary.LittleEndian.Uint64(r.data)
+}
+
+func newTombstone(addr oid.Address, target uint64) record {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, target)
+	return record{typ: recordDelete, addr: addr, data: data}
+}
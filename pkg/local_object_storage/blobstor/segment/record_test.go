@@ -0,0 +1,78 @@
+package segment
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRecord(t *testing.T) {
+	rec := record{typ: recordPut, addr: oidtest.Address(), data: []byte{1, 2, 3}}
+	data := rec.marshal()
+
+	t.Run("valid", func(t *testing.T) {
+		res, err := readRecord(bytes.NewReader(data), 0, int64(len(data)))
+		require.NoError(t, err)
+		require.Equal(t, rec, res)
+	})
+	t.Run("data length exceeds the limit", func(t *testing.T) {
+		bad := append([]byte(nil), data...)
+		binary.LittleEndian.PutUint32(bad[1+addressSize:], math.MaxUint32)
+
+		_, err := readRecord(bytes.NewReader(bad), 0, int64(len(bad)))
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+	t.Run("invalid checksum", func(t *testing.T) {
+		bad := append([]byte(nil), data...)
+		bad[len(bad)-1]++
+
+		_, err := readRecord(bytes.NewReader(bad), 0, int64(len(bad)))
+		require.ErrorIs(t, err, errInvalidRecord)
+	})
+}
+
+func TestOpenTruncatedTail(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "segments")
+	newStorage := func() *Segments {
+		s := New(WithPath(dir), WithNoSync(true), WithCompactionInterval(0))
+		s.SetCompressor(new(compression.Config))
+		require.NoError(t, s.Open(false))
+		require.NoError(t, s.Init())
+		return s
+	}
+
+	s := newStorage()
+	addr := oidtest.Address()
+	_, err := s.Put(common.PutPrm{Address: addr, RawData: []byte{1, 2, 3}, DontCompress: true})
+	require.NoError(t, err)
+	size := s.active.size
+	require.NoError(t, s.Close())
+
+	// Append the header of a record with a huge data length.
+	tail := record{typ: recordPut, addr: oidtest.Address()}
+	hdr := tail.marshal()
+	binary.LittleEndian.PutUint32(hdr[1+addressSize:], math.MaxUint32)
+
+	f, err := os.OpenFile(s.segmentPath(1), os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = f.Write(hdr)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s = newStorage()
+	require.Equal(t, size, s.active.size)
+
+	res, err := s.getRaw(addr)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, res)
+	require.NoError(t, s.Close())
+}
@@ -0,0 +1,126 @@
+package segment
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/compression"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Segments represents an object storage appending objects to the segment files.
+//
+// Every put or delete operation appends a record to the active segment, which is
+// sealed and replaced with a new one after reaching the configured size.
+// Object locations are kept in the in-memory index which is restored
+// by scanning all the segments on open. Sealed segments having too much garbage
+// are compacted in background: live records are re-appended to the active segment
+// and the old segment file is removed.
+//
+// The storage is intended to be used for small objects instead of the blobovnicza tree,
+// i.e. as the first sub-storage of the blobstor, because the non-empty storage ID is returned on Put.
+type Segments struct {
+	cfg
+
+	*compression.Config
+
+	readOnly bool
+
+	mtx sync.RWMutex
+	// index maps object address to the location of its put record.
+	index map[oid.Address]location
+	// segments contains all segments by ID.
+	segments map[uint64]*segment
+	// active is the segment the records are appended to, nil in read-only mode.
+	active *segment
+
+	// compactMtx serializes compactions.
+	compactMtx sync.Mutex
+	closeCh    chan struct{}
+	wg         sync.WaitGroup
+}
+
+// location is the position of the put record in the segment.
+type location struct {
+	segment uint64
+	offset  int64
+	size    int64
+}
+
+type segment struct {
+	id uint64
+	f  *os.File
+	// size is the total size of the records in the segment.
+	size int64
+	// live is the total size of the records which are still needed:
+	// put records of the stored objects and delete records
+	// for the objects which are still present in the older segments.
+	live int64
+	// tombstones maps segment ID to the size of delete records
+	// for the objects in that segment.
+	tombstones map[uint64]int64
+}
+
+// garbage returns the ratio of the garbage in the segment.
+func (s *segment) garbage() float64 {
+	if s.size == 0 {
+		return 0
+	}
+	return float64(s.size-s.live) / float64(s.size)
+}
+
+// Type is segment storage type used in logs and configuration.
+const Type = "segment"
+
+// storageID is returned as the storage ID of every stored object.
+var storageID = []byte(Type)
+
+const segmentExt = ".seg"
+
+var _ common.Storage = (*Segments)(nil)
+
+// New creates new segment storage instance.
+func New(opts ...Option) *Segments {
+	s := new(Segments)
+	initConfig(&s.cfg)
+
+	for i := range opts {
+		opts[i](&s.cfg)
+	}
+
+	return s
+}
+
+func (s *Segments) segmentPath(id uint64) string {
+	return filepath.Join(s.path, fmt.Sprintf("%016x%s", id, segmentExt))
+}
+
+// Type implements common.Storage.
+func (*Segments) Type() string {
+	return Type
+}
+
+// Path implements common.Storage.
+func (s *Segments) Path() string {
+	return s.path
+}
+
+// SetCompressor implements common.Storage.
+func (s *Segments) SetCompressor(cc *compression.Config) {
+	s.Config = cc
+}
+
+// SetReportErrorFunc implements common.Storage.
+func (s *Segments) SetReportErrorFunc(f func(string, error)) {
+	s.reportError = f
+}
+
+// SetMetrics sets compaction statistics receiver.
+func (s *Segments) SetMetrics(m Metrics) {
+	if m != nil {
+		s.metrics = m
+	}
+}
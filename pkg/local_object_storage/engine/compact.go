@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+)
+
+// CompactBlobovniczasPrm groups the parameters of CompactBlobovniczas operation.
+type CompactBlobovniczasPrm struct {
+	shardID   *shard.ID
+	threshold float64
+}
+
+// SetShardID is an option to set shard ID.
+//
+// Option is required.
+func (p *CompactBlobovniczasPrm) SetShardID(id *shard.ID) {
+	p.shardID = id
+}
+
+// SetThreshold sets the ratio of the stored objects size to the blobovnicza size
+// below which the blobovnicza is compacted. Zero value means the threshold
+// from the shard configuration.
+func (p *CompactBlobovniczasPrm) SetThreshold(v float64) {
+	p.threshold = v
+}
+
+// CompactBlobovniczasRes groups the resulting values of CompactBlobovniczas operation.
+type CompactBlobovniczasRes struct {
+	res shard.CompactBlobovniczasRes
+}
+
+// Compacted returns the number of removed blobovniczas.
+func (r CompactBlobovniczasRes) Compacted() uint64 {
+	return r.res.Compacted()
+}
+
+// Moved returns the number of objects moved to other blobovniczas.
+func (r CompactBlobovniczasRes) Moved() uint64 {
+	return r.res.Moved()
+}
+
+// Reclaimed returns the amount of the freed disk space in bytes.
+func (r CompactBlobovniczasRes) Reclaimed() uint64 {
+	return r.res.Reclaimed()
+}
+
+// CompactBlobovniczas compacts under-utilized blobovniczas on a single shard.
+//
+// See shard.Shard.CompactBlobovniczas for details.
+func (e *StorageEngine) CompactBlobovniczas(p CompactBlobovniczasPrm) (CompactBlobovniczasRes, error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[p.shardID.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return CompactBlobovniczasRes{}, errShardNotFound
+	}
+
+	var prm shard.CompactBlobovniczasPrm
+	prm.SetThreshold(p.threshold)
+
+	res, err := sh.CompactBlobovniczas(prm)
+	return CompactBlobovniczasRes{res: res}, err
+}
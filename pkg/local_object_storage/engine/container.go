@@ -13,7 +13,8 @@ type ContainerSizePrm struct {
 
 // ContainerSizeRes resulting values of ContainerSize operation.
 type ContainerSizeRes struct {
-	size uint64
+	size  uint64
+	count uint64
 }
 
 // ListContainersPrm groups parameters of ListContainers operation.
@@ -34,6 +35,12 @@ func (r ContainerSizeRes) Size() uint64 {
 	return r.size
 }
 
+// ObjectCount returns the number of available regular objects of the container
+// stored in all shards.
+func (r ContainerSizeRes) ObjectCount() uint64 {
+	return r.count
+}
+
 // Containers returns a list of identifiers of the containers in which local objects are stored.
 func (r ListContainersRes) Containers() []cid.ID {
 	return r.containers
@@ -82,6 +89,7 @@ func (e *StorageEngine) containerSize(prm ContainerSizePrm) (res ContainerSizeRe
 		}
 
 		res.size += csRes.Size()
+		res.count += csRes.ObjectCount()
 
 		return false
 	})
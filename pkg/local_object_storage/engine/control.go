@@ -130,6 +130,7 @@ var errClosed = errors.New("storage engine is closed")
 //
 // The method MUST only be called when the application exits.
 func (e *StorageEngine) Close() error {
+	_ = e.StopEvacuation()
 	close(e.closeCh)
 	defer e.wg.Wait()
 	return e.setBlockExecErr(errClosed)
@@ -32,6 +32,13 @@ type StorageEngine struct {
 
 		err error
 	}
+
+	evacuation struct {
+		mtx sync.Mutex
+
+		// job is the last started evacuation job.
+		job *evacuationJob
+	}
 }
 
 type shardWrapper struct {
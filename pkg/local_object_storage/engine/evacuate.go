@@ -22,6 +22,7 @@ type EvacuateShardPrm struct {
 
 // EvacuateShardRes represents result of the EvacuateShard operation.
 type EvacuateShardRes struct {
+	jobID string
 	count int
 }
 
@@ -40,8 +41,16 @@ func (p *EvacuateShardPrm) WithFaultHandler(f func(oid.Address, *objectSDK.Objec
 	p.handler = f
 }
 
+// JobID returns identifier of the evacuation job.
+func (p EvacuateShardRes) JobID() string {
+	return p.jobID
+}
+
 // Count returns amount of evacuated objects.
 // Objects for which handler returned no error are also assumed evacuated.
+//
+// Count is always zero for the evacuation started with StartEvacuation,
+// use EvacuationStatus to get the progress.
 func (p EvacuateShardRes) Count() int {
 	return p.count
 }
@@ -57,33 +66,71 @@ var errMustHaveTwoShards = errors.New("must have at least 1 spare shard")
 
 // Evacuate moves data from one shard to the others.
 // The shard being moved must be in read-only mode.
+//
+// Evacuate starts the evacuation job and waits for it to finish,
+// see StartEvacuation for details.
 func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error) {
+	job, err := e.startEvacuation(prm)
+	if err != nil {
+		return EvacuateShardRes{}, err
+	}
+
+	<-job.done
+
+	st := job.getState()
+	return EvacuateShardRes{jobID: st.JobID, count: int(st.Evacuated())}, st.Error
+}
+
+// StartEvacuation starts moving data from one shard to the others in the background.
+// The shard being moved must be in read-only mode. Only one evacuation job can be
+// run at a time.
+//
+// If the previous evacuation of the shard was stopped or failed, the new one
+// continues from the last checkpoint. Checkpoints are saved next to the shard
+// metabase, so they survive the restart. Checkpoint is dropped when the shard
+// is opened or switched to a mode allowing writes.
+//
+// Use EvacuationStatus to get the progress of the job and StopEvacuation to stop it.
+func (e *StorageEngine) StartEvacuation(prm EvacuateShardPrm) (EvacuateShardRes, error) {
+	job, err := e.startEvacuation(prm)
+	if err != nil {
+		return EvacuateShardRes{}, err
+	}
+	return EvacuateShardRes{jobID: job.id}, nil
+}
+
+func (e *StorageEngine) startEvacuation(prm EvacuateShardPrm) (*evacuationJob, error) {
 	sidList := make([]string, len(prm.shardID))
 	for i := range prm.shardID {
 		sidList[i] = prm.shardID[i].String()
 	}
 
+	e.evacuation.mtx.Lock()
+	defer e.evacuation.mtx.Unlock()
+
+	if e.evacuation.job != nil && e.evacuation.job.running() {
+		return nil, errEvacuationInProgress
+	}
+
 	e.mtx.RLock()
 	for i := range sidList {
 		sh, ok := e.shards[sidList[i]]
 		if !ok {
 			e.mtx.RUnlock()
-			return EvacuateShardRes{}, errShardNotFound
+			return nil, errShardNotFound
 		}
 
 		if !sh.GetMode().ReadOnly() {
 			e.mtx.RUnlock()
-			return EvacuateShardRes{}, shard.ErrMustBeReadOnly
+			return nil, shard.ErrMustBeReadOnly
 		}
 	}
 
 	if len(e.shards)-len(sidList) < 1 && prm.handler == nil {
 		e.mtx.RUnlock()
-		return EvacuateShardRes{}, errMustHaveTwoShards
+		return nil, errMustHaveTwoShards
 	}
 
-	e.log.Info("started shards evacuation", zap.Strings("shard_ids", sidList))
-
 	// We must have all shards, to have correct information about their
 	// indexes in a sorted slice and set appropriate marks in the metabase.
 	// Evacuated shard is skipped during put.
@@ -96,6 +143,48 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 	}
 	e.mtx.RUnlock()
 
+	job := newEvacuationJob(prm.shardID)
+	e.evacuation.job = job
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.evacuate(job, prm, sidList, shards)
+	}()
+
+	return job, nil
+}
+
+func (e *StorageEngine) evacuate(job *evacuationJob, prm EvacuateShardPrm, sidList []string, shards []pooledShard) {
+	e.log.Info("started shards evacuation",
+		zap.String("job_id", job.id),
+		zap.Strings("shard_ids", sidList))
+
+	err := e.evacuateShards(job, prm, sidList, shards)
+	job.finish(err)
+
+	if err != nil {
+		e.log.Error("shards evacuation failed",
+			zap.String("job_id", job.id),
+			zap.Strings("shard_ids", sidList),
+			zap.Error(err))
+		return
+	}
+
+	for i := range shards {
+		for j := range sidList {
+			if shards[i].ID().String() == sidList[j] {
+				e.resetEvacuationCheckpoint(shards[i].Shard)
+			}
+		}
+	}
+
+	e.log.Info("finished shards evacuation",
+		zap.String("job_id", job.id),
+		zap.Strings("shard_ids", sidList))
+}
+
+func (e *StorageEngine) evacuateShards(job *evacuationJob, prm EvacuateShardPrm, sidList []string, shards []pooledShard) error {
 	weights := make([]float64, 0, len(shards))
 	for i := range shards {
 		weights = append(weights, e.shardWeight(shards[i].Shard))
@@ -113,13 +202,16 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 	var listPrm shard.ListWithCursorPrm
 	listPrm.WithCount(defaultEvacuateBatchSize)
 
-	var res EvacuateShardRes
-
 mainLoop:
 	for n := range sidList {
 		sh := shardMap[sidList[n]]
 
-		var c *meta.Cursor
+		cp := e.evacuationCheckpoint(sh)
+		if cp.done {
+			continue
+		}
+
+		c := cp.cursor
 		for {
 			listPrm.WithCursor(c)
 
@@ -128,9 +220,10 @@ mainLoop:
 			listRes, err := sh.ListWithCursor(listPrm)
 			if err != nil {
 				if errors.Is(err, meta.ErrEndOfListing) || errors.Is(err, shard.ErrDegradedMode) {
+					e.setEvacuationCheckpoint(sh, evacuationCheckpoint{done: true})
 					continue mainLoop
 				}
-				return res, err
+				return err
 			}
 
 			// TODO (@fyrchik): #1731 parallelize the loop
@@ -138,6 +231,10 @@ mainLoop:
 
 		loop:
 			for i := range lst {
+				if job.ctx.Err() != nil {
+					return errEvacuationStopped
+				}
+
 				addr := lst[i].Address
 
 				var getPrm shard.GetPrm
@@ -145,10 +242,11 @@ mainLoop:
 
 				getRes, err := sh.Get(getPrm)
 				if err != nil {
+					job.incFailed(n)
 					if prm.ignoreErrors {
 						continue
 					}
-					return res, err
+					return err
 				}
 
 				hrw.SortHasherSliceByWeightValue(shards, weights, hrw.Hash([]byte(addr.EncodeToString())))
@@ -164,30 +262,33 @@ mainLoop:
 								zap.Stringer("to", shards[j].ID()),
 								zap.Stringer("addr", addr))
 
-							res.count++
+							job.incEvacuated(n)
+						} else {
+							job.incSkipped(n)
 						}
 						continue loop
 					}
 				}
 
 				if prm.handler == nil {
+					job.incFailed(n)
 					// Do not check ignoreErrors flag here because
 					// ignoring errors on put make this command kinda useless.
-					return res, fmt.Errorf("%w: %s", errPutShard, lst[i])
+					return fmt.Errorf("%w: %s", errPutShard, lst[i])
 				}
 
 				err = prm.handler(addr, getRes.Object())
 				if err != nil {
-					return res, err
+					job.incFailed(n)
+					return err
 				}
-				res.count++
+				job.incReplicated(n)
 			}
 
 			c = listRes.Cursor()
+			e.setEvacuationCheckpoint(sh, evacuationCheckpoint{cursor: c})
 		}
 	}
 
-	e.log.Info("finished shards evacuation",
-		zap.Strings("shard_ids", sidList))
-	return res, nil
+	return nil
 }
@@ -0,0 +1,295 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	errEvacuationInProgress = logicerr.New("evacuation is already in progress")
+	errEvacuationNotFound   = logicerr.New("evacuation has never been started")
+	errEvacuationNotRunning = logicerr.New("evacuation is not running")
+	errEvacuationStopped    = logicerr.New("evacuation was stopped")
+)
+
+// EvacuationStatus represents the status of the evacuation job.
+type EvacuationStatus uint8
+
+const (
+	// EvacuationRunning means that the evacuation is in progress.
+	EvacuationRunning EvacuationStatus = iota
+	// EvacuationCompleted means that all objects were processed.
+	EvacuationCompleted
+	// EvacuationFailed means that the evacuation was interrupted by an error.
+	EvacuationFailed
+	// EvacuationStopped means that the evacuation was stopped with StopEvacuation.
+	EvacuationStopped
+)
+
+// String implements fmt.Stringer.
+func (s EvacuationStatus) String() string {
+	switch s {
+	case EvacuationRunning:
+		return "RUNNING"
+	case EvacuationCompleted:
+		return "COMPLETED"
+	case EvacuationFailed:
+		return "FAILED"
+	case EvacuationStopped:
+		return "STOPPED"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+// ShardEvacuationState groups the evacuation counters of a single shard.
+type ShardEvacuationState struct {
+	ID *shard.ID
+	// Evacuated is the number of objects moved to other shards
+	// or handled by the fault handler.
+	Evacuated uint64
+	// Replicated is the number of evacuated objects handled by the fault handler,
+	// i.e. the objects which were saved outside the local storage.
+	Replicated uint64
+	// Failed is the number of objects which could not be read or saved.
+	Failed uint64
+	// Skipped is the number of objects which are already stored on other shards.
+	Skipped uint64
+}
+
+// EvacuationState groups the information about the evacuation job.
+type EvacuationState struct {
+	JobID  string
+	Status EvacuationStatus
+	Shards []ShardEvacuationState
+	// StartedAt is the time of the job start, FinishedAt is zero for the running job.
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Error is the reason of the failure for EvacuationFailed status.
+	Error error
+}
+
+// Evacuated returns the total number of evacuated objects.
+func (s EvacuationState) Evacuated() uint64 {
+	var n uint64
+	for i := range s.Shards {
+		n += s.Shards[i].Evacuated
+	}
+	return n
+}
+
+type evacuationJob struct {
+	id     string
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mtx   sync.RWMutex
+	state EvacuationState
+}
+
+// evacuationCheckpoint is the progress of the shard evacuation
+// saved between the jobs and the restarts.
+type evacuationCheckpoint struct {
+	// cursor points to the last processed listing batch.
+	cursor *meta.Cursor
+	// done is true if all objects of the shard were processed.
+	done bool
+}
+
+func newEvacuationJob(ids []*shard.ID) *evacuationJob {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	shards := make([]ShardEvacuationState, len(ids))
+	for i := range ids {
+		shards[i].ID = ids[i]
+	}
+
+	return &evacuationJob{
+		id:     uuid.NewString(),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		state: EvacuationState{
+			Status:    EvacuationRunning,
+			Shards:    shards,
+			StartedAt: time.Now(),
+		},
+	}
+}
+
+func (j *evacuationJob) running() bool {
+	select {
+	case <-j.done:
+		return false
+	default:
+		return true
+	}
+}
+
+func (j *evacuationJob) getState() EvacuationState {
+	j.mtx.RLock()
+	defer j.mtx.RUnlock()
+
+	st := j.state
+	st.JobID = j.id
+	st.Shards = make([]ShardEvacuationState, len(j.state.Shards))
+	copy(st.Shards, j.state.Shards)
+	return st
+}
+
+func (j *evacuationJob) finish(err error) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	j.state.FinishedAt = time.Now()
+	switch {
+	case err == nil:
+		j.state.Status = EvacuationCompleted
+	case err == errEvacuationStopped:
+		j.state.Status = EvacuationStopped
+		j.state.Error = err
+	default:
+		j.state.Status = EvacuationFailed
+		j.state.Error = err
+	}
+
+	j.cancel()
+	close(j.done)
+}
+
+func (j *evacuationJob) incEvacuated(i int) {
+	j.mtx.Lock()
+	j.state.Shards[i].Evacuated++
+	j.mtx.Unlock()
+}
+
+func (j *evacuationJob) incReplicated(i int) {
+	j.mtx.Lock()
+	j.state.Shards[i].Evacuated++
+	j.state.Shards[i].Replicated++
+	j.mtx.Unlock()
+}
+
+func (j *evacuationJob) incFailed(i int) {
+	j.mtx.Lock()
+	j.state.Shards[i].Failed++
+	j.mtx.Unlock()
+}
+
+func (j *evacuationJob) incSkipped(i int) {
+	j.mtx.Lock()
+	j.state.Shards[i].Skipped++
+	j.mtx.Unlock()
+}
+
+// EvacuationStatus returns the state of the last evacuation job.
+func (e *StorageEngine) EvacuationStatus() (EvacuationState, error) {
+	e.evacuation.mtx.Lock()
+	job := e.evacuation.job
+	e.evacuation.mtx.Unlock()
+
+	if job == nil {
+		return EvacuationState{}, errEvacuationNotFound
+	}
+	return job.getState(), nil
+}
+
+// StopEvacuation stops the running evacuation job and waits until it is finished.
+// The progress is saved, so the next evacuation of the same shards continues
+// from the place it was stopped at.
+func (e *StorageEngine) StopEvacuation() error {
+	e.evacuation.mtx.Lock()
+	job := e.evacuation.job
+	e.evacuation.mtx.Unlock()
+
+	if job == nil || !job.running() {
+		return errEvacuationNotRunning
+	}
+
+	job.cancel()
+	<-job.done
+	return nil
+}
+
+// evacuationCheckpoint returns the saved evacuation progress of the shard.
+// Invalid checkpoint is ignored, so the evacuation starts over.
+func (e *StorageEngine) evacuationCheckpoint(sh *shard.Shard) evacuationCheckpoint {
+	var cp evacuationCheckpoint
+
+	data, err := sh.ReadEvacuationCheckpoint()
+	if err == nil {
+		err = cp.unmarshal(data)
+	}
+	if err != nil {
+		e.log.Warn("could not read evacuation checkpoint, evacuation starts over",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Error(err))
+		return evacuationCheckpoint{}
+	}
+	return cp
+}
+
+// setEvacuationCheckpoint saves the evacuation progress of the shard.
+// Failures are logged only: the evacuation can go on, it just repeats
+// the work after a restart.
+func (e *StorageEngine) setEvacuationCheckpoint(sh *shard.Shard, cp evacuationCheckpoint) {
+	if err := sh.WriteEvacuationCheckpoint(cp.marshal()); err != nil {
+		e.log.Warn("could not save evacuation checkpoint",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Error(err))
+	}
+}
+
+// resetEvacuationCheckpoint drops the saved evacuation progress of the shard.
+func (e *StorageEngine) resetEvacuationCheckpoint(sh *shard.Shard) {
+	if err := sh.DeleteEvacuationCheckpoint(); err != nil {
+		e.log.Warn("could not drop evacuation checkpoint",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Error(err))
+	}
+}
+
+const (
+	checkpointCursor byte = iota
+	checkpointDone
+)
+
+// marshal encodes the checkpoint as a single byte with the checkpoint type
+// followed by the marshaled cursor, if any.
+func (cp evacuationCheckpoint) marshal() []byte {
+	if cp.done {
+		return []byte{checkpointDone}
+	}
+	if cp.cursor == nil {
+		return []byte{checkpointCursor}
+	}
+	return append([]byte{checkpointCursor}, cp.cursor.Marshal()...)
+}
+
+func (cp *evacuationCheckpoint) unmarshal(data []byte) error {
+	*cp = evacuationCheckpoint{}
+
+	switch {
+	case len(data) == 0:
+		return nil
+	case data[0] == checkpointDone:
+		cp.done = true
+		return nil
+	case data[0] != checkpointCursor:
+		return fmt.Errorf("unknown evacuation checkpoint type %d", data[0])
+	case len(data) == 1:
+		return nil
+	}
+
+	cp.cursor = new(meta.Cursor)
+	return cp.cursor.Unmarshal(data[1:])
+}
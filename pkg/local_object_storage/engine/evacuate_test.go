@@ -177,6 +177,12 @@ func TestEvacuateNetwork(t *testing.T) {
 		res, err = e.Evacuate(prm)
 		require.ErrorIs(t, err, errReplication)
 		require.Equal(t, 2, res.Count())
+
+		st, err := e.EvacuationStatus()
+		require.NoError(t, err)
+		require.Equal(t, EvacuationFailed, st.Status)
+		require.Equal(t, uint64(2), st.Shards[0].Replicated)
+		require.Equal(t, uint64(1), st.Shards[0].Failed)
 	})
 	t.Run("multiple shards, evacuate one", func(t *testing.T) {
 		e, ids, objects := newEngineEvacuate(t, 2, 3)
@@ -204,12 +210,13 @@ func TestEvacuateNetwork(t *testing.T) {
 		e, ids, objects := newEngineEvacuate(t, 4, 5)
 		evacuateIDs := ids[0:3]
 
-		var totalCount int
+		var totalCount, lastCount int
 		for i := range evacuateIDs {
 			res, err := e.shards[ids[i].String()].List()
 			require.NoError(t, err)
 
 			totalCount += len(res.AddressList())
+			lastCount = len(res.AddressList())
 		}
 
 		for i := range ids {
@@ -227,9 +234,130 @@ func TestEvacuateNetwork(t *testing.T) {
 		t.Run("no errors", func(t *testing.T) {
 			prm.handler = acceptOneOf(objects, totalCount)
 
+			// Evacuation continues from the last unfinished shard.
 			res, err := e.Evacuate(prm)
 			require.NoError(t, err)
-			require.Equal(t, totalCount, res.Count())
+			require.Equal(t, lastCount, res.Count())
 		})
 	})
 }
+
+func TestEvacuateAsync(t *testing.T) {
+	e, ids, _ := newEngineEvacuate(t, 2, 3)
+
+	_, err := e.EvacuationStatus()
+	require.ErrorIs(t, err, errEvacuationNotFound)
+	require.ErrorIs(t, e.StopEvacuation(), errEvacuationNotRunning)
+
+	require.NoError(t, e.shards[ids[1].String()].SetMode(mode.ReadOnly))
+
+	stop := make(chan struct{})
+	release := make(chan struct{})
+
+	var handled int
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids[1:2])
+	prm.WithFaultHandler(func(oid.Address, *objectSDK.Object) error {
+		if handled++; handled == 2 {
+			close(stop)
+			<-release
+		}
+		return nil
+	})
+
+	// Other shard is read-only, so every object is passed to the handler.
+	require.NoError(t, e.shards[ids[0].String()].SetMode(mode.ReadOnly))
+
+	res, err := e.StartEvacuation(prm)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.JobID())
+
+	<-stop
+
+	_, err = e.StartEvacuation(prm)
+	require.ErrorIs(t, err, errEvacuationInProgress)
+
+	st, err := e.EvacuationStatus()
+	require.NoError(t, err)
+	require.Equal(t, res.JobID(), st.JobID)
+	require.Equal(t, EvacuationRunning, st.Status)
+	require.Equal(t, uint64(1), st.Evacuated())
+
+	job := e.evacuation.job
+	go func() {
+		<-job.ctx.Done()
+		close(release)
+	}()
+	require.NoError(t, e.StopEvacuation())
+
+	st, err = e.EvacuationStatus()
+	require.NoError(t, err)
+	require.Equal(t, EvacuationStopped, st.Status)
+	require.Len(t, st.Shards, 1)
+	require.Equal(t, ids[1].String(), st.Shards[0].ID.String())
+	require.Equal(t, uint64(2), st.Shards[0].Evacuated)
+	require.False(t, st.FinishedAt.IsZero())
+
+	t.Run("resume", func(t *testing.T) {
+		prm.WithFaultHandler(func(oid.Address, *objectSDK.Object) error { return nil })
+
+		res, err := e.Evacuate(prm)
+		require.NoError(t, err)
+		require.Equal(t, 3, res.Count())
+
+		st, err := e.EvacuationStatus()
+		require.NoError(t, err)
+		require.Equal(t, EvacuationCompleted, st.Status)
+		require.NoError(t, st.Error)
+	})
+}
+
+func TestEvacuateResumeAfterReopen(t *testing.T) {
+	const objPerShard = defaultEvacuateBatchSize + 10
+
+	e, ids, _ := newEngineEvacuate(t, 2, objPerShard)
+
+	// Other shard is read-only, so every object is passed to the handler.
+	for i := range ids {
+		require.NoError(t, e.shards[ids[i].String()].SetMode(mode.ReadOnly))
+	}
+
+	errHandler := errors.New("handler failure")
+
+	var handled int
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids[1:2])
+	prm.WithFaultHandler(func(oid.Address, *objectSDK.Object) error {
+		if handled++; handled > defaultEvacuateBatchSize {
+			return errHandler
+		}
+		return nil
+	})
+
+	_, err := e.Evacuate(prm)
+	require.ErrorIs(t, err, errHandler)
+
+	require.NoError(t, e.Close())
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+
+	for i := range ids {
+		require.Equal(t, mode.ReadOnly, e.shards[ids[i].String()].GetMode())
+	}
+
+	prm.WithFaultHandler(func(oid.Address, *objectSDK.Object) error { return nil })
+
+	res, err := e.Evacuate(prm)
+	require.NoError(t, err)
+	require.Equal(t, objPerShard-defaultEvacuateBatchSize, res.Count())
+
+	t.Run("checkpoint is dropped for writable shard", func(t *testing.T) {
+		sh := e.shards[ids[1].String()]
+		require.NoError(t, sh.WriteEvacuationCheckpoint([]byte{checkpointDone}))
+		require.NoError(t, e.SetShardMode(ids[1], mode.ReadWrite, false))
+
+		data, err := sh.ReadEvacuationCheckpoint()
+		require.NoError(t, err)
+		require.Nil(t, data)
+	})
+}
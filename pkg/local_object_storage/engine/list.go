@@ -5,6 +5,7 @@ import (
 
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 )
 
 // ErrEndOfListing is returned from an object listing with cursor
@@ -22,6 +23,7 @@ type Cursor struct {
 type ListWithCursorPrm struct {
 	count  uint32
 	cursor *Cursor
+	cnr    *cid.ID
 }
 
 // WithCount sets the maximum amount of addresses that ListWithCursor should return.
@@ -36,6 +38,12 @@ func (p *ListWithCursorPrm) WithCursor(cursor *Cursor) {
 	p.cursor = cursor
 }
 
+// WithContainerID restricts ListWithCursor operation to the objects
+// of the specified container.
+func (p *ListWithCursorPrm) WithContainerID(cnr cid.ID) {
+	p.cnr = &cnr
+}
+
 // ListWithCursorRes contains values returned from ListWithCursor operation.
 type ListWithCursorRes struct {
 	addrList []objectcore.AddressWithType
@@ -104,6 +112,9 @@ func (e *StorageEngine) ListWithCursor(prm ListWithCursorPrm) (ListWithCursorRes
 		count := uint32(int(prm.count) - len(result))
 		var shardPrm shard.ListWithCursorPrm
 		shardPrm.WithCount(count)
+		if prm.cnr != nil {
+			shardPrm.WithContainerID(*prm.cnr)
+		}
 		if shardIDs[i] == cursor.shardID {
 			shardPrm.WithCursor(cursor.shardCursor)
 		}
@@ -24,6 +24,12 @@ type MetricRegister interface {
 
 	AddToContainerSize(cnrID string, size int64)
 	AddToPayloadCounter(shardID string, size int64)
+
+	ObserveCompressionRatio(shardID string, ratio float64)
+	IncCompressionSkipped(shardID string)
+
+	IncSegmentCompactions(shardID string)
+	AddSegmentReclaimedBytes(shardID string, size uint64)
 }
 
 func elapsed(addFunc func(d time.Duration)) func() {
@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+)
+
+// RebuildMetabasePrm groups the parameters of RebuildMetabase operation.
+type RebuildMetabasePrm struct {
+	shardID *shard.ID
+}
+
+// SetShardID is an option to set shard ID.
+//
+// Option is required.
+func (p *RebuildMetabasePrm) SetShardID(id *shard.ID) {
+	p.shardID = id
+}
+
+// RebuildMetabaseRes groups the resulting values of RebuildMetabase operation.
+type RebuildMetabaseRes struct{}
+
+// RebuildMetabase starts the background metabase rebuild on a single shard.
+// Shard error counter is reset, so that the shard is not moved to
+// another mode while the rebuild is in progress.
+//
+// See shard.Shard.RebuildMetabase for details.
+func (e *StorageEngine) RebuildMetabase(p RebuildMetabasePrm) (RebuildMetabaseRes, error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[p.shardID.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return RebuildMetabaseRes{}, errShardNotFound
+	}
+
+	sh.errorCount.Store(0)
+
+	return RebuildMetabaseRes{}, sh.RebuildMetabase()
+}
+
+// MetabaseRebuildStatusPrm groups the parameters of MetabaseRebuildStatus operation.
+type MetabaseRebuildStatusPrm struct {
+	shardID *shard.ID
+}
+
+// SetShardID is an option to set shard ID.
+//
+// Option is required.
+func (p *MetabaseRebuildStatusPrm) SetShardID(id *shard.ID) {
+	p.shardID = id
+}
+
+// MetabaseRebuildStatusRes groups the resulting values of MetabaseRebuildStatus operation.
+type MetabaseRebuildStatusRes struct {
+	status shard.MetabaseRebuildStatus
+}
+
+// Status returns the status of the metabase rebuild.
+func (r MetabaseRebuildStatusRes) Status() shard.MetabaseRebuildStatus {
+	return r.status
+}
+
+// MetabaseRebuildStatus returns the status of the last metabase rebuild on a single shard.
+func (e *StorageEngine) MetabaseRebuildStatus(p MetabaseRebuildStatusPrm) (MetabaseRebuildStatusRes, error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[p.shardID.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return MetabaseRebuildStatusRes{}, errShardNotFound
+	}
+
+	return MetabaseRebuildStatusRes{status: sh.MetabaseRebuildStatus()}, nil
+}
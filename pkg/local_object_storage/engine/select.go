@@ -1,6 +1,8 @@
 package engine
 
 import (
+	"bytes"
+
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
@@ -11,11 +13,15 @@ import (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	cursor *oid.ID
+	limit  uint32
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	cursor   *oid.ID
 }
 
 // WithContainerID is a Select option to set the container id to search in.
@@ -28,11 +34,32 @@ func (p *SelectPrm) WithFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// WithCursor is a Select option to return only the objects with
+// identifiers greater than the cursor.
+func (p *SelectPrm) WithCursor(cursor *oid.ID) {
+	p.cursor = cursor
+}
+
+// WithLimit is a Select option to set the maximum number of the returned objects.
+// Zero means no limit.
+//
+// If the limit or the cursor is set, the objects are returned
+// in the ascending order of their identifiers.
+func (p *SelectPrm) WithLimit(limit uint32) {
+	p.limit = limit
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Cursor returns the cursor for the next Select call.
+// It is nil if there are no more objects to return.
+func (r SelectRes) Cursor() *oid.ID {
+	return r.cursor
+}
+
 // Select selects the objects from local storage that match select parameters.
 //
 // Returns any error encountered that did not allow to completely select the objects.
@@ -52,6 +79,10 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 		defer elapsed(e.metrics.AddSearchDuration)()
 	}
 
+	if prm.cursor != nil || prm.limit != 0 {
+		return e.selectPage(prm)
+	}
+
 	addrList := make([]oid.Address, 0)
 	uniqueMap := make(map[string]struct{})
 
@@ -83,6 +114,90 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 	}, outError
 }
 
+// selectPage merges the pages of all the shards. Every shard returns
+// at most prm.limit first objects after the cursor in the ascending order,
+// so the first objects of the merged pages are the first objects of the
+// whole storage.
+func (e *StorageEngine) selectPage(prm SelectPrm) (SelectRes, error) {
+	var shPrm shard.SelectPrm
+	shPrm.SetContainerID(prm.cnr)
+	shPrm.SetFilters(prm.filters)
+	shPrm.SetCursor(prm.cursor)
+	shPrm.SetLimit(prm.limit)
+
+	var (
+		pages   [][]oid.Address
+		hasMore bool
+	)
+
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		res, err := sh.Select(shPrm)
+		if err != nil {
+			e.reportShardError(sh, "could not select objects from shard", err)
+			return false
+		}
+
+		hasMore = hasMore || res.Cursor() != nil
+		if len(res.AddressList()) != 0 {
+			pages = append(pages, res.AddressList())
+		}
+
+		return false
+	})
+
+	var addrList []oid.Address
+	if prm.limit != 0 {
+		addrList = make([]oid.Address, 0, prm.limit)
+	}
+
+	var last *oid.ID
+	for {
+		// pick the smallest identifier among the heads of the pages
+		next := -1
+		for i := range pages {
+			if len(pages[i]) == 0 {
+				continue
+			}
+			if next < 0 || compareIDs(pages[i][0].Object(), pages[next][0].Object()) < 0 {
+				next = i
+			}
+		}
+		if next < 0 {
+			break
+		}
+
+		addr := pages[next][0]
+		pages[next] = pages[next][1:]
+
+		id := addr.Object()
+		if last != nil && *last == id {
+			continue // the object is stored in several shards
+		}
+
+		if prm.limit != 0 && len(addrList) == int(prm.limit) {
+			hasMore = true
+			break
+		}
+
+		addrList = append(addrList, addr)
+		last = &id
+	}
+
+	var cursor *oid.ID
+	if hasMore && len(addrList) != 0 {
+		cursor = last
+	}
+
+	return SelectRes{
+		addrList: addrList,
+		cursor:   cursor,
+	}, nil
+}
+
+func compareIDs(a, b oid.ID) int {
+	return bytes.Compare(a[:], b[:])
+}
+
 // List returns `limit` available physically storage object addresses in engine.
 // If limit is zero, then returns all available object addresses.
 //
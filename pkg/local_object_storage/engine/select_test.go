@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"bytes"
+	"os"
+	"sort"
+	"testing"
+
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectWithCursor(t *testing.T) {
+	s1 := testNewShard(t, 1)
+	s2 := testNewShard(t, 2)
+	e := testNewEngineWithShards(s1, s2)
+
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	const total = 20
+
+	cnr := cidtest.ID()
+	expected := make([]oid.ID, 0, total)
+
+	for i := 0; i < total; i++ {
+		obj := generateObjectWithCID(t, cnr)
+
+		var prm PutPrm
+		prm.WithObject(obj)
+
+		_, err := e.Put(prm)
+		require.NoError(t, err)
+
+		id, _ := obj.ID()
+		expected = append(expected, id)
+	}
+
+	sort.Slice(expected, func(i, j int) bool {
+		return bytes.Compare(expected[i][:], expected[j][:]) < 0
+	})
+
+	for _, limit := range []uint32{1, 3, total, total + 1} {
+		var prm SelectPrm
+		prm.WithContainerID(cnr)
+		prm.WithLimit(limit)
+
+		var got []oid.ID
+		for {
+			res, err := e.Select(prm)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.AddressList()), int(limit))
+
+			for _, addr := range res.AddressList() {
+				got = append(got, addr.Object())
+			}
+
+			if res.Cursor() == nil {
+				break
+			}
+			prm.WithCursor(res.Cursor())
+		}
+
+		require.Equal(t, expected, got, "limit: %d", limit)
+	}
+}
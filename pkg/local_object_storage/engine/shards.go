@@ -60,6 +60,22 @@ func (m *metricsWithID) AddToPayloadSize(size int64) {
 	m.mw.AddToPayloadCounter(m.id, size)
 }
 
+func (m *metricsWithID) ObserveCompressionRatio(ratio float64) {
+	m.mw.ObserveCompressionRatio(m.id, ratio)
+}
+
+func (m *metricsWithID) IncCompressionSkipped() {
+	m.mw.IncCompressionSkipped(m.id)
+}
+
+func (m *metricsWithID) IncSegmentCompactions() {
+	m.mw.IncSegmentCompactions(m.id)
+}
+
+func (m *metricsWithID) AddSegmentReclaimedBytes(size uint64) {
+	m.mw.AddSegmentReclaimedBytes(m.id, size)
+}
+
 // AddShard adds a new shard to the storage engine.
 //
 // Returns any error encountered that did not allow adding a shard.
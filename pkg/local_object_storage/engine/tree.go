@@ -59,7 +59,8 @@ func (e *StorageEngine) TreeApply(d pilorama.CIDDescriptor, treeID string, m *pi
 
 	err = lst[index].TreeApply(d, treeID, m, backgroundSync)
 	if err != nil {
-		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled {
+		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled &&
+			!errors.Is(err, pilorama.ErrBelowSnapshot) {
 			e.reportShardError(lst[index], "can't perform `TreeApply`", err,
 				zap.Stringer("cid", d.CID),
 				zap.String("tree", treeID))
@@ -69,6 +70,45 @@ func (e *StorageEngine) TreeApply(d pilorama.CIDDescriptor, treeID string, m *pi
 	return nil
 }
 
+// TreeBatch implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeBatch(d pilorama.CIDDescriptor, treeID string, ops []pilorama.BatchOperation) ([][]pilorama.Move, error) {
+	index, lst, err := e.getTreeShard(d.CID, treeID)
+	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+		return nil, err
+	}
+
+	lm, err := lst[index].TreeBatch(d, treeID, ops)
+	if err != nil {
+		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled {
+			e.reportShardError(lst[index], "can't perform `TreeBatch`", err,
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID))
+		}
+		return nil, err
+	}
+	return lm, nil
+}
+
+// TreeApplyBatch implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeApplyBatch(d pilorama.CIDDescriptor, treeID string, ms []*pilorama.Move) error {
+	index, lst, err := e.getTreeShard(d.CID, treeID)
+	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+		return err
+	}
+
+	err = lst[index].TreeApplyBatch(d, treeID, ms)
+	if err != nil {
+		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled &&
+			!errors.Is(err, pilorama.ErrBelowSnapshot) {
+			e.reportShardError(lst[index], "can't perform `TreeApplyBatch`", err,
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID))
+		}
+		return err
+	}
+	return nil
+}
+
 // TreeGetByPath implements the pilorama.Forest interface.
 func (e *StorageEngine) TreeGetByPath(cid cidSDK.ID, treeID string, attr string, path []string, latest bool) ([]pilorama.Node, error) {
 	var err error
@@ -136,6 +176,28 @@ func (e *StorageEngine) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID pil
 	return nil, err
 }
 
+// TreeSortedByFilename implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeSortedByFilename(cid cidSDK.ID, treeID string, nodeID pilorama.Node, start string, count int) ([]pilorama.NodeInfo, error) {
+	var err error
+	var nodes []pilorama.NodeInfo
+	for _, sh := range e.sortShardsByWeight(cid) {
+		nodes, err = sh.TreeSortedByFilename(cid, treeID, nodeID, start, count)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) {
+				e.reportShardError(sh, "can't perform `TreeSortedByFilename`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return nodes, nil
+	}
+	return nil, err
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (e *StorageEngine) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilorama.Move, error) {
 	var err error
@@ -158,6 +220,28 @@ func (e *StorageEngine) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64
 	return lm, err
 }
 
+// TreeLogDigest implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeLogDigest(cid cidSDK.ID, treeID string, start, end uint64) (pilorama.LogDigest, error) {
+	var err error
+	var d pilorama.LogDigest
+	for _, sh := range e.sortShardsByWeight(cid) {
+		d, err = sh.TreeLogDigest(cid, treeID, start, end)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) {
+				e.reportShardError(sh, "can't perform `TreeLogDigest`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return d, nil
+	}
+	return d, err
+}
+
 // TreeDrop implements the pilorama.Forest interface.
 func (e *StorageEngine) TreeDrop(cid cidSDK.ID, treeID string) error {
 	var err error
@@ -213,6 +297,68 @@ func (e *StorageEngine) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	return err == nil, err
 }
 
+// TreeCompact implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeCompact(cid cidSDK.ID, treeID string, height uint64) error {
+	index, lst, err := e.getTreeShard(cid, treeID)
+	if err != nil {
+		return err
+	}
+
+	err = lst[index].TreeCompact(cid, treeID, height)
+	if err != nil {
+		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled {
+			e.reportShardError(lst[index], "can't perform `TreeCompact`", err,
+				zap.Stringer("cid", cid),
+				zap.String("tree", treeID))
+		}
+		return err
+	}
+	return nil
+}
+
+// TreeGetSnapshot implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeGetSnapshot(cid cidSDK.ID, treeID string, start pilorama.Node, count int) (uint64, []pilorama.SnapshotNode, error) {
+	var err error
+	var height uint64
+	var nodes []pilorama.SnapshotNode
+	for _, sh := range e.sortShardsByWeight(cid) {
+		height, nodes, err = sh.TreeGetSnapshot(cid, treeID, start, count)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) {
+				e.reportShardError(sh, "can't perform `TreeGetSnapshot`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return height, nodes, nil
+	}
+	return 0, nil, err
+}
+
+// TreeApplySnapshot implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeApplySnapshot(d pilorama.CIDDescriptor, treeID string, height uint64, nodes []pilorama.SnapshotNode) error {
+	index, lst, err := e.getTreeShard(d.CID, treeID)
+	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+		return err
+	}
+
+	err = lst[index].TreeApplySnapshot(d, treeID, height, nodes)
+	if err != nil {
+		if !errors.Is(err, shard.ErrReadOnlyMode) && err != shard.ErrPiloramaDisabled &&
+			!errors.Is(err, pilorama.ErrTreeNotEmpty) {
+			e.reportShardError(lst[index], "can't perform `TreeApplySnapshot`", err,
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID))
+		}
+		return err
+	}
+	return nil
+}
+
 func (e *StorageEngine) getTreeShard(cid cidSDK.ID, treeID string) (int, []hashedShard, error) {
 	lst := e.sortShardsByWeight(cid)
 	for i, sh := range lst {
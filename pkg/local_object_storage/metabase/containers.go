@@ -66,6 +66,30 @@ func (db *DB) containerSize(tx *bbolt.Tx, id cid.ID) (uint64, error) {
 	return parseContainerSize(containerVolume.Get(key)), nil
 }
 
+// ContainerObjectCount returns the number of available regular objects
+// (including parts of the split objects) of the container stored in
+// the metabase.
+func (db *DB) ContainerObjectCount(id cid.ID) (count uint64, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		containerVolume := tx.Bucket(containerVolumeBucketName)
+		key := make([]byte, cidSize)
+		id.Encode(key)
+
+		count = parseContainerObjectCount(containerVolume.Get(key))
+
+		return nil
+	})
+
+	return count, err
+}
+
 func parseContainerID(dst *cid.ID, name []byte, ignore map[string]struct{}) bool {
 	if len(name) != bucketKeySize {
 		return false
@@ -76,6 +100,15 @@ func parseContainerID(dst *cid.ID, name []byte, ignore map[string]struct{}) bool
 	return dst.Decode(name[1:bucketKeySize]) == nil
 }
 
+// container volume value is a little-endian payload size optionally followed
+// by a little-endian number of the regular objects. The latter is missing in
+// the values written by the older versions and is restored by
+// syncContainerObjectCounters.
+const (
+	containerSizeLen   = 8
+	containerVolumeLen = containerSizeLen + 8
+)
+
 func parseContainerSize(v []byte) uint64 {
 	if len(v) == 0 {
 		return 0
@@ -84,23 +117,108 @@ func parseContainerSize(v []byte) uint64 {
 	return binary.LittleEndian.Uint64(v)
 }
 
-func changeContainerSize(tx *bbolt.Tx, id cid.ID, delta uint64, increase bool) error {
+func parseContainerObjectCount(v []byte) uint64 {
+	if len(v) < containerVolumeLen {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(v[containerSizeLen:])
+}
+
+func putContainerVolume(b *bbolt.Bucket, key []byte, size, count uint64) error {
+	buf := make([]byte, containerVolumeLen) // consider using sync.Pool to decrease allocations
+	binary.LittleEndian.PutUint64(buf, size)
+	binary.LittleEndian.PutUint64(buf[containerSizeLen:], count)
+
+	return b.Put(key, buf)
+}
+
+// changeContainerSize changes the container volume by delta bytes and the number
+// of its regular objects by objects.
+func changeContainerSize(tx *bbolt.Tx, id cid.ID, delta, objects uint64, increase bool) error {
 	containerVolume := tx.Bucket(containerVolumeBucketName)
 	key := make([]byte, cidSize)
 	id.Encode(key)
 
-	size := parseContainerSize(containerVolume.Get(key))
+	v := containerVolume.Get(key)
+	size := parseContainerSize(v)
+	count := parseContainerObjectCount(v)
 
 	if increase {
 		size += delta
-	} else if size > delta {
-		size -= delta
+		count += objects
 	} else {
-		size = 0
+		size = decreaseSaturating(size, delta)
+		count = decreaseSaturating(count, objects)
 	}
 
-	buf := make([]byte, 8) // consider using sync.Pool to decrease allocations
-	binary.LittleEndian.PutUint64(buf, size)
+	return putContainerVolume(containerVolume, key, size, count)
+}
+
+func decreaseSaturating(v, delta uint64) uint64 {
+	if v > delta {
+		return v - delta
+	}
+	return 0
+}
+
+// syncContainerObjectCounters counts regular objects of the containers which
+// volume values have no object counter.
+func syncContainerObjectCounters(tx *bbolt.Tx) error {
+	containerVolume := tx.Bucket(containerVolumeBucketName)
+
+	var outdated [][]byte
+	err := containerVolume.ForEach(func(k, v []byte) error {
+		if len(v) < containerVolumeLen {
+			// keys must not be used after the modification of the bucket
+			outdated = append(outdated, append([]byte(nil), k...))
+		}
+		return nil
+	})
+	if err != nil || len(outdated) == 0 {
+		return err
+	}
+
+	graveyardBKT := tx.Bucket(graveyardBucketName)
+	garbageBKT := tx.Bucket(garbageBucketName)
+	bucketName := make([]byte, bucketKeySize)
+	addrKey := make([]byte, addressKeySize)
+
+	for i := range outdated {
+		var cnr cid.ID
+		if err := cnr.Decode(outdated[i]); err != nil {
+			continue
+		}
+
+		var count uint64
+
+		primaryBKT := tx.Bucket(primaryBucketName(cnr, bucketName))
+		if primaryBKT != nil {
+			copy(addrKey, outdated[i])
+
+			err := primaryBKT.ForEach(func(k, _ []byte) error {
+				if len(k) != objectKeySize {
+					return nil
+				}
+
+				copy(addrKey[cidSize:], k)
+				if inGraveyardWithKey(addrKey, graveyardBKT, garbageBKT) == 0 {
+					count++
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		size := parseContainerSize(containerVolume.Get(outdated[i]))
+
+		err := putContainerVolume(containerVolume, outdated[i], size, count)
+		if err != nil {
+			return err
+		}
+	}
 
-	return containerVolume.Put(key, buf)
+	return nil
 }
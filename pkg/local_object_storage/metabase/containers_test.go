@@ -171,11 +171,16 @@ func TestDB_ContainerSize(t *testing.T) {
 		n, err := db.ContainerSize(cnr)
 		require.NoError(t, err)
 		require.Equal(t, volume, int(n))
+
+		count, err := db.ContainerObjectCount(cnr)
+		require.NoError(t, err)
+		require.Equal(t, uint64(N), count)
 	}
 
 	t.Run("Inhume", func(t *testing.T) {
 		for cnr, list := range objs {
 			volume := cids[cnr]
+			count := uint64(N)
 
 			for _, obj := range list {
 				require.NoError(t, metaInhume(
@@ -185,10 +190,15 @@ func TestDB_ContainerSize(t *testing.T) {
 				))
 
 				volume -= int(obj.PayloadSize())
+				count--
 
 				n, err := db.ContainerSize(cnr)
 				require.NoError(t, err)
 				require.Equal(t, volume, int(n))
+
+				c, err := db.ContainerObjectCount(cnr)
+				require.NoError(t, err)
+				require.Equal(t, count, c)
 			}
 		}
 	})
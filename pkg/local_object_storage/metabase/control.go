@@ -138,6 +138,11 @@ func (db *DB) init(reset bool) error {
 				return fmt.Errorf("could not sync object counter: %w", err)
 			}
 
+			err = syncContainerObjectCounters(tx)
+			if err != nil {
+				return fmt.Errorf("could not sync container object counters: %w", err)
+			}
+
 			return nil
 		}
 
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"io/fs"
+	"math/big"
 	"os"
 	"strconv"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	v2object "github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
@@ -104,6 +106,10 @@ func New(opts ...Option) *DB {
 				matchSlow:   stringCommonPrefixMatcher,
 				matchBucket: stringCommonPrefixMatcherBucket,
 			},
+			objectcore.MatchNumGT: numericMatcher(func(c int) bool { return c > 0 }),
+			objectcore.MatchNumGE: numericMatcher(func(c int) bool { return c >= 0 }),
+			objectcore.MatchNumLT: numericMatcher(func(c int) bool { return c < 0 }),
+			objectcore.MatchNumLE: numericMatcher(func(c int) bool { return c <= 0 }),
 		},
 	}
 }
@@ -248,6 +254,44 @@ func stringCommonPrefixMatcherBucket(b *bbolt.Bucket, fKey string, fVal string,
 	return nil
 }
 
+// numericMatcher returns the matcher comparing object and filter values as decimal integers.
+// cmp is called with the result of the comparison of the object value with the filter one.
+// Non-numeric values never match.
+func numericMatcher(cmp func(int) bool) matcher {
+	match := func(objVal string, filterVal *big.Int) bool {
+		var n big.Int
+		if _, ok := n.SetString(objVal, 10); !ok {
+			return false
+		}
+		return cmp(n.Cmp(filterVal))
+	}
+
+	return matcher{
+		matchSlow: func(key string, objVal []byte, filterVal string) bool {
+			var n big.Int
+			if _, ok := n.SetString(filterVal, 10); !ok {
+				return false
+			}
+			return match(stringifyValue(key, objVal), &n)
+		},
+		matchBucket: func(b *bbolt.Bucket, fKey string, fValue string, f func([]byte, []byte) error) error {
+			var n big.Int
+			if _, ok := n.SetString(fValue, 10); !ok {
+				return nil
+			}
+
+			// Values are stored as strings, so the order of keys is lexicographical
+			// and all of them need to be checked.
+			return b.ForEach(func(k, v []byte) error {
+				if match(stringifyValue(fKey, k), &n) {
+					return f(k, v)
+				}
+				return nil
+			})
+		},
+	}
+}
+
 func unknownMatcher(_ string, _ []byte, _ string) bool {
 	return false
 }
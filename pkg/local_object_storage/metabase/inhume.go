@@ -194,15 +194,17 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 			targetKey := addressKey(prm.target[i], buf)
 			if err == nil {
 				containerID, _ := obj.ContainerID()
+				var available uint64
 				if inGraveyardWithKey(targetKey, graveyardBKT, garbageBKT) == 0 {
 					inhumed++
+					available = 1
 					res.storeDeletionInfo(containerID, obj.PayloadSize())
 				}
 
 				// if object is stored, and it is regular object then update bucket
-				// with container size estimations
+				// with container size estimations and the number of available objects
 				if obj.Type() == object.TypeRegular {
-					err := changeContainerSize(tx, cnr, obj.PayloadSize(), false)
+					err := changeContainerSize(tx, cnr, obj.PayloadSize(), available, false)
 					if err != nil {
 						return err
 					}
@@ -1,6 +1,8 @@
 package meta
 
 import (
+	"errors"
+
 	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
@@ -20,10 +22,32 @@ type Cursor struct {
 	inBucketOffset []byte
 }
 
+// Marshal encodes the cursor into a binary form suitable for Unmarshal.
+func (c *Cursor) Marshal() []byte {
+	data := make([]byte, 1+len(c.bucketName)+len(c.inBucketOffset))
+	data[0] = byte(len(c.bucketName))
+	copy(data[1:], c.bucketName)
+	copy(data[1+len(c.bucketName):], c.inBucketOffset)
+	return data
+}
+
+// Unmarshal decodes the cursor from the binary form produced by Marshal.
+func (c *Cursor) Unmarshal(data []byte) error {
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return errors.New("invalid cursor length")
+	}
+
+	n := 1 + int(data[0])
+	c.bucketName = append([]byte(nil), data[1:n]...)
+	c.inBucketOffset = append([]byte(nil), data[n:]...)
+	return nil
+}
+
 // ListPrm contains parameters for ListWithCursor operation.
 type ListPrm struct {
 	count  int
 	cursor *Cursor
+	cnr    *cid.ID
 }
 
 // SetCount sets maximum amount of addresses that ListWithCursor should return.
@@ -38,6 +62,12 @@ func (l *ListPrm) SetCursor(cursor *Cursor) {
 	l.cursor = cursor
 }
 
+// SetContainerID restricts ListWithCursor operation to the objects
+// of the specified container.
+func (l *ListPrm) SetContainerID(cnr cid.ID) {
+	l.cnr = &cnr
+}
+
 // ListRes contains values returned from ListWithCursor operation.
 type ListRes struct {
 	addrList []objectcore.AddressWithType
@@ -71,14 +101,14 @@ func (db *DB) ListWithCursor(prm ListPrm) (res ListRes, err error) {
 	result := make([]objectcore.AddressWithType, 0, prm.count)
 
 	err = db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.addrList, res.cursor, err = db.listWithCursor(tx, result, prm.count, prm.cursor)
+		res.addrList, res.cursor, err = db.listWithCursor(tx, result, prm.count, prm.cursor, prm.cnr)
 		return err
 	})
 
 	return res, err
 }
 
-func (db *DB) listWithCursor(tx *bbolt.Tx, result []objectcore.AddressWithType, count int, cursor *Cursor) ([]objectcore.AddressWithType, *Cursor, error) {
+func (db *DB) listWithCursor(tx *bbolt.Tx, result []objectcore.AddressWithType, count int, cursor *Cursor, cnr *cid.ID) ([]objectcore.AddressWithType, *Cursor, error) {
 	threshold := cursor == nil // threshold is a flag to ignore cursor
 	var bucketName []byte
 
@@ -99,7 +129,7 @@ func (db *DB) listWithCursor(tx *bbolt.Tx, result []objectcore.AddressWithType,
 loop:
 	for ; name != nil; name, _ = c.Next() {
 		cidRaw, prefix := parseContainerIDWithPrefix(&containerID, name)
-		if cidRaw == nil {
+		if cidRaw == nil || cnr != nil && !containerID.Equals(*cnr) {
 			continue
 		}
 
@@ -158,10 +158,61 @@ func TestLisObjectsWithCursor(t *testing.T) {
 		}
 	})
 
+	t.Run("marshaled cursor", func(t *testing.T) {
+		const countPerReq = 3
+
+		var got []object.AddressWithType
+		var cursor *meta.Cursor
+		for {
+			res, c, err := metaListWithCursor(db, countPerReq, cursor)
+			if errors.Is(err, meta.ErrEndOfListing) {
+				break
+			}
+			require.NoError(t, err)
+			got = append(got, res...)
+
+			cursor = new(meta.Cursor)
+			require.NoError(t, cursor.Unmarshal(c.Marshal()))
+		}
+
+		require.Equal(t, expected, sortAddresses(got))
+		require.Error(t, new(meta.Cursor).Unmarshal(nil))
+		require.Error(t, new(meta.Cursor).Unmarshal([]byte{2, 1}))
+	})
+
 	t.Run("invalid count", func(t *testing.T) {
 		_, _, err := metaListWithCursor(db, 0, nil)
 		require.ErrorIs(t, err, meta.ErrEndOfListing)
 	})
+
+	t.Run("single container", func(t *testing.T) {
+		cnr := expected[0].Address.Container()
+
+		var expectedCnr []object.AddressWithType
+		for i := range expected {
+			if expected[i].Address.Container().Equals(cnr) {
+				expectedCnr = append(expectedCnr, expected[i])
+			}
+		}
+
+		var listPrm meta.ListPrm
+		listPrm.SetCount(2)
+		listPrm.SetContainerID(cnr)
+
+		var got []object.AddressWithType
+		for {
+			r, err := db.ListWithCursor(listPrm)
+			if errors.Is(err, meta.ErrEndOfListing) {
+				break
+			}
+			require.NoError(t, err)
+
+			got = append(got, r.AddressList()...)
+			listPrm.SetCursor(r.Cursor())
+		}
+
+		require.Equal(t, expectedCnr, sortAddresses(got))
+	})
 }
 
 func TestAddObjectDuringListingWithCursor(t *testing.T) {
@@ -142,7 +142,7 @@ func (db *DB) put(
 
 	// update container volume size estimation
 	if obj.Type() == objectSDK.TypeRegular && !isParent {
-		err = changeContainerSize(tx, cnr, obj.PayloadSize(), true)
+		err = changeContainerSize(tx, cnr, obj.PayloadSize(), 1, true)
 		if err != nil {
 			return err
 		}
@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -32,11 +33,15 @@ type (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	cursor *oid.ID
+	limit  uint32
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	cursor   *oid.ID
 }
 
 // SetContainerID is a Select option to set the container id to search in.
@@ -49,11 +54,32 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetCursor is a Select option to return only the objects with
+// identifiers greater than the cursor. Use nil to start from the beginning.
+func (p *SelectPrm) SetCursor(cursor *oid.ID) {
+	p.cursor = cursor
+}
+
+// SetLimit is a Select option to set the maximum number of the returned objects.
+// Zero means no limit.
+//
+// If the limit or the cursor is set, the objects are returned
+// in the ascending order of their identifiers.
+func (p *SelectPrm) SetLimit(limit uint32) {
+	p.limit = limit
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Cursor returns the cursor for the next Select call.
+// It is nil if there are no more objects to return.
+func (r SelectRes) Cursor() *oid.ID {
+	return r.cursor
+}
+
 // Select returns list of addresses of objects that match search filters.
 func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 	db.modeMtx.RLock()
@@ -70,22 +96,24 @@ func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 	currEpoch := db.epochState.CurrentEpoch()
 
 	return res, db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.addrList, err = db.selectObjects(tx, prm.cnr, prm.filters, currEpoch)
+		res.addrList, res.cursor, err = db.selectObjects(tx, prm, currEpoch)
 
 		return err
 	})
 }
 
-func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, currEpoch uint64) ([]oid.Address, error) {
-	group, err := groupFilters(fs)
+func (db *DB) selectObjects(tx *bbolt.Tx, prm SelectPrm, currEpoch uint64) ([]oid.Address, *oid.ID, error) {
+	cnr := prm.cnr
+
+	group, err := groupFilters(prm.filters)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// if there are conflicts in query and container then it means that there is no
 	// objects to match this query.
 	if group.withCnrFilter && !cnr.Equals(group.cnr) {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	// keep matched addresses in this cache
@@ -95,6 +123,11 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 	expLen := len(group.fastFilters) // expected value of matched filters in mAddr
 
 	if len(group.fastFilters) == 0 {
+		if prm.cursor != nil || prm.limit != 0 {
+			// all the objects match, the page is read from the buckets directly
+			return db.selectPage(tx, cnr, group, nil, 0, prm.cursor, prm.limit, currEpoch)
+		}
+
 		expLen = 1
 
 		db.selectAll(tx, cnr, mAddr)
@@ -104,6 +137,10 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 		}
 	}
 
+	if prm.cursor != nil || prm.limit != 0 {
+		return db.selectPage(tx, cnr, group, mAddr, expLen, prm.cursor, prm.limit, currEpoch)
+	}
+
 	res := make([]oid.Address, 0, len(mAddr))
 
 	for a, ind := range mAddr {
@@ -114,7 +151,7 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 		var id oid.ID
 		err = id.Decode([]byte(a))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		var addr oid.Address
@@ -132,7 +169,131 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 		res = append(res, addr)
 	}
 
-	return res, nil
+	return res, nil, nil
+}
+
+// selectPage is similar to the tail of selectObjects, but iterates over the
+// objects of the container in the ascending order of their identifiers starting
+// after the cursor and stops after the limit is reached. If mAddr is nil,
+// the objects are not filtered by the fast filters.
+func (db *DB) selectPage(tx *bbolt.Tx, cnr cid.ID, group filterGroup, mAddr map[string]int, expLen int,
+	cursor *oid.ID, limit uint32, currEpoch uint64) ([]oid.Address, *oid.ID, error) {
+	var cursorKey []byte
+	if cursor != nil {
+		cursorKey = objectKey(*cursor, make([]byte, objectKeySize))
+	}
+
+	var (
+		res  = make([]oid.Address, 0, limit)
+		next *oid.ID
+	)
+
+	err := iterateOrdered(tx, containerBucketNames(cnr), cursorKey, func(k []byte) (bool, error) {
+		if mAddr != nil && mAddr[string(k)] != expLen {
+			return false, nil // ignore objects with unmatched fast filters
+		}
+
+		var id oid.ID
+		if err := id.Decode(k); err != nil {
+			return false, err
+		}
+
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(id)
+
+		if objectStatus(tx, addr, currEpoch) > 0 {
+			return false, nil // ignore removed objects
+		}
+
+		if !db.matchSlowFilters(tx, addr, group.slowFilters, currEpoch) {
+			return false, nil // ignore objects with unmatched slow filters
+		}
+
+		if limit != 0 && len(res) == int(limit) {
+			// There is at least one more object, so the cursor is returned.
+			last := res[len(res)-1].Object()
+			next = &last
+			return true, nil
+		}
+
+		res = append(res, addr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, next, nil
+}
+
+// containerBucketNames returns names of the buckets which
+// contain all the objects of the container, see selectAll.
+func containerBucketNames(cnr cid.ID) [][]byte {
+	return [][]byte{
+		primaryBucketName(cnr, make([]byte, bucketKeySize)),
+		tombstoneBucketName(cnr, make([]byte, bucketKeySize)),
+		storageGroupBucketName(cnr, make([]byte, bucketKeySize)),
+		parentBucketName(cnr, make([]byte, bucketKeySize)),
+		bucketNameLockers(cnr, make([]byte, bucketKeySize)),
+	}
+}
+
+// iterateOrdered calls f for the keys of all the buckets in the ascending order
+// starting after the specified key, nil starts from the beginning. Every key
+// is passed once even if it is present in several buckets. The iteration stops
+// when f returns true or an error.
+func iterateOrdered(tx *bbolt.Tx, names [][]byte, after []byte, f func(k []byte) (bool, error)) error {
+	var (
+		cursors = make([]*bbolt.Cursor, 0, len(names))
+		keys    = make([][]byte, 0, len(names))
+	)
+
+	for i := range names {
+		bkt := tx.Bucket(names[i])
+		if bkt == nil {
+			continue
+		}
+
+		c := bkt.Cursor()
+
+		var k []byte
+		if after == nil {
+			k, _ = c.First()
+		} else {
+			k, _ = c.Seek(after)
+			if bytes.Equal(k, after) {
+				k, _ = c.Next()
+			}
+		}
+
+		cursors = append(cursors, c)
+		keys = append(keys, k)
+	}
+
+	for {
+		// Keys stay valid until the end of the read-only transaction.
+		var k []byte
+		for i := range keys {
+			if keys[i] != nil && (k == nil || bytes.Compare(keys[i], k) < 0) {
+				k = keys[i]
+			}
+		}
+		if k == nil {
+			return nil
+		}
+
+		for i := range keys {
+			if bytes.Equal(keys[i], k) {
+				keys[i], _ = cursors[i].Next()
+			}
+		}
+
+		stop, err := f(k)
+		if err != nil || stop {
+			return err
+		}
+	}
 }
 
 // selectAll adds to resulting cache all available objects in metabase.
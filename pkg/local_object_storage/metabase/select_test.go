@@ -1,7 +1,9 @@
 package meta_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -526,6 +528,206 @@ func TestDB_SelectWithSlowFilters(t *testing.T) {
 	})
 }
 
+func TestDB_SelectNumeric(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	raw1 := generateObjectWithCID(t, cnr)
+	raw1.SetCreationEpoch(5)
+	addAttribute(raw1, "size", "9")
+	err := putBig(db, raw1)
+	require.NoError(t, err)
+
+	raw2 := generateObjectWithCID(t, cnr)
+	raw2.SetCreationEpoch(10)
+	addAttribute(raw2, "size", "10")
+	err = putBig(db, raw2)
+	require.NoError(t, err)
+
+	raw3 := generateObjectWithCID(t, cnr)
+	raw3.SetCreationEpoch(20)
+	addAttribute(raw3, "size", "100000000000000000000000")
+	err = putBig(db, raw3)
+	require.NoError(t, err)
+
+	raw4 := generateObjectWithCID(t, cnr)
+	addAttribute(raw4, "size", "large")
+	err = putBig(db, raw4)
+	require.NoError(t, err)
+
+	t.Run("user attribute", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("size", "10", object.MatchNumGT)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw3))
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter("size", "10", object.MatchNumGE)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw2), object.AddressOf(raw3))
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter("size", "10", object.MatchNumLT)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw1))
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter("size", "10", object.MatchNumLE)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw1), object.AddressOf(raw2))
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter("size", "-1", object.MatchNumGT)
+		fs.AddFilter("size", "100000000000000000000000", object.MatchNumLT)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw1), object.AddressOf(raw2))
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter("size", "ten", object.MatchNumGT)
+		testSelect(t, db, cnr, fs)
+	})
+
+	t.Run("creation epoch", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "5", object.MatchNumGT)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw2), object.AddressOf(raw3))
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "10", object.MatchNumLE)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw1), object.AddressOf(raw2), object.AddressOf(raw4))
+	})
+}
+
+func TestDB_SelectPage(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	const objCount = 10
+
+	ids := make([]oid.ID, 0, objCount)
+	for i := 0; i < objCount; i++ {
+		raw := generateObjectWithCID(t, cnr)
+		addAttribute(raw, "even", strconv.FormatBool(i%2 == 0))
+		require.NoError(t, putBig(db, raw))
+
+		id, _ := raw.ID()
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+
+	selectAll := func(t *testing.T, fs objectSDK.SearchFilters, limit uint32) ([]oid.ID, int) {
+		var prm meta.SelectPrm
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+		prm.SetLimit(limit)
+
+		var res []oid.ID
+		var pages int
+		for {
+			r, err := db.Select(prm)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(r.AddressList()), int(limit))
+
+			for _, addr := range r.AddressList() {
+				res = append(res, addr.Object())
+			}
+			pages++
+
+			if r.Cursor() == nil {
+				return res, pages
+			}
+			prm.SetCursor(r.Cursor())
+		}
+	}
+
+	t.Run("all objects", func(t *testing.T) {
+		res, pages := selectAll(t, objectSDK.SearchFilters{}, 3)
+		require.Equal(t, ids, res)
+		require.Equal(t, 4, pages)
+
+		res, pages = selectAll(t, objectSDK.SearchFilters{}, 5)
+		require.Equal(t, ids, res)
+		require.Equal(t, 2, pages)
+	})
+
+	t.Run("with filters", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("even", "true", objectSDK.MatchStringEqual)
+
+		res, _ := selectAll(t, fs, 2)
+		require.Len(t, res, objCount/2)
+		require.True(t, sort.SliceIsSorted(res, func(i, j int) bool {
+			return bytes.Compare(res[i][:], res[j][:]) < 0
+		}))
+	})
+
+	t.Run("cursor without limit", func(t *testing.T) {
+		var prm meta.SelectPrm
+		prm.SetContainerID(cnr)
+		prm.SetCursor(&ids[objCount-3])
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		require.Nil(t, res.Cursor())
+		require.Len(t, res.AddressList(), 2)
+		require.Equal(t, ids[objCount-2], res.AddressList()[0].Object())
+		require.Equal(t, ids[objCount-1], res.AddressList()[1].Object())
+	})
+}
+
+func TestDB_SelectPageObjectTypes(t *testing.T) {
+	db := newDB(t)
+	cnr := cidtest.ID()
+
+	for _, typ := range []objectSDK.Type{objectSDK.TypeRegular, objectSDK.TypeTombstone,
+		objectSDK.TypeStorageGroup, objectSDK.TypeLock} {
+		for i := 0; i < 3; i++ {
+			raw := generateObjectWithCID(t, cnr)
+			raw.SetType(typ)
+			require.NoError(t, putBig(db, raw))
+		}
+	}
+
+	parent := generateObjectWithCID(t, cnr)
+	child := generateObjectWithCID(t, cnr)
+	child.SetParent(parent)
+	idParent, _ := parent.ID()
+	child.SetParentID(idParent)
+	require.NoError(t, putBig(db, child))
+
+	var prm meta.SelectPrm
+	prm.SetContainerID(cnr)
+
+	r, err := db.Select(prm)
+	require.NoError(t, err)
+
+	expected := make([]oid.ID, 0, len(r.AddressList()))
+	for _, addr := range r.AddressList() {
+		expected = append(expected, addr.Object())
+	}
+	sort.Slice(expected, func(i, j int) bool {
+		return bytes.Compare(expected[i][:], expected[j][:]) < 0
+	})
+
+	prm.SetLimit(2)
+
+	var res []oid.ID
+	for {
+		r, err := db.Select(prm)
+		require.NoError(t, err)
+
+		for _, addr := range r.AddressList() {
+			res = append(res, addr.Object())
+		}
+
+		if r.Cursor() == nil {
+			break
+		}
+		prm.SetCursor(r.Cursor())
+	}
+	require.Equal(t, expected, res)
+}
+
 func TestDB_SelectObjectID(t *testing.T) {
 	db := newDB(t)
 
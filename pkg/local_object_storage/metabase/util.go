@@ -44,7 +44,8 @@ const (
 	toMoveItPrefix
 	// containerVolumePrefix is used for storing container size estimations.
 	//	Key: container ID
-	//  Value: container size in bytes as little-endian uint64
+	//  Value: container size in bytes as little-endian uint64 followed by
+	//  the number of available regular objects as little-endian uint64
 	containerVolumePrefix
 	// lockedPrefix is used for storing locked objects information.
 	//  Key: container ID
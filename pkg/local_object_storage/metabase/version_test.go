@@ -7,6 +7,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
 )
@@ -65,6 +68,56 @@ func TestVersion(t *testing.T) {
 		check(t, db)
 		require.NoError(t, db.Close())
 	})
+	t.Run("old container volume", func(t *testing.T) {
+		db := newDB(t)
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+
+		cnr := cidtest.ID()
+		ids := []oid.ID{oidtest.ID(), oidtest.ID(), oidtest.ID()}
+
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			primary, err := tx.CreateBucket(primaryBucketName(cnr, make([]byte, bucketKeySize)))
+			if err != nil {
+				return err
+			}
+			for i := range ids {
+				err := primary.Put(objectKey(ids[i], make([]byte, objectKeySize)), []byte{})
+				if err != nil {
+					return err
+				}
+			}
+
+			var addr oid.Address
+			addr.SetContainer(cnr)
+			addr.SetObject(ids[0])
+
+			err = tx.Bucket(garbageBucketName).Put(addressKey(addr, make([]byte, addressKeySize)), zeroValue)
+			if err != nil {
+				return err
+			}
+
+			key := make([]byte, cidSize)
+			cnr.Encode(key)
+
+			size := make([]byte, 8)
+			binary.LittleEndian.PutUint64(size, 100)
+			return tx.Bucket(containerVolumeBucketName).Put(key, size)
+		}))
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+
+		size, err := db.ContainerSize(cnr)
+		require.NoError(t, err)
+		require.Equal(t, uint64(100), size)
+
+		count, err := db.ContainerObjectCount(cnr)
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(ids)-1), count)
+		require.NoError(t, db.Close())
+	})
 	t.Run("invalid version", func(t *testing.T) {
 		db := newDB(t)
 		require.NoError(t, db.Open(false))
@@ -33,6 +33,8 @@ func (b *batch) trigger() {
 }
 
 func (b *batch) run() {
+	var below []chan<- error
+
 	fullID := bucketName(b.cid, b.treeID)
 	err := b.forest.db.Update(func(tx *bbolt.Tx) error {
 		bLog, bTree, err := b.forest.getTreeBuckets(tx, fullID)
@@ -44,16 +46,36 @@ func (b *batch) run() {
 		b.timer = nil
 		b.mtx.Unlock()
 
+		// Operations below the snapshot height can't be applied, they are rejected separately.
+		// Filtering without a mutex is ok, because we append to these slices only if timer is non-nil.
+		h := getSnapshotHeight(tx.Bucket(fullID))
+		ops, results := b.operations[:0], b.results[:0]
+		for i := range b.operations {
+			if b.operations[i].Time < h {
+				below = append(below, b.results[i])
+				continue
+			}
+			ops = append(ops, b.operations[i])
+			results = append(results, b.results[i])
+		}
+		b.operations, b.results = ops, results
+		if len(ops) == 0 {
+			return nil
+		}
+
 		// Sorting without a mutex is ok, because we append to this slice only if timer is non-nil.
 		// See (*boltForest).addBatch for details.
-		sort.Slice(b.operations, func(i, j int) bool {
-			return b.operations[i].Time < b.operations[j].Time
+		sort.Slice(ops, func(i, j int) bool {
+			return ops[i].Time < ops[j].Time
 		})
 
 		var lm Move
-		return b.forest.applyOperation(bLog, bTree, b.operations, &lm)
+		return b.forest.applyOperation(bLog, bTree, ops, &lm)
 	})
-	for i := range b.operations {
+	for i := range b.results {
 		b.results[i] <- err
 	}
+	for i := range below {
+		below[i] <- ErrBelowSnapshot
+	}
 }
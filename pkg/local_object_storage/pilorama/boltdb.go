@@ -5,9 +5,11 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -33,10 +35,18 @@ type boltForest struct {
 }
 
 var (
-	dataBucket = []byte{0}
-	logBucket  = []byte{1}
+	dataBucket     = []byte{0}
+	logBucket      = []byte{1}
+	snapshotBucket = []byte{2}
+
+	versionKey   = []byte{'v'}
+	pathAttrsKey = []byte{'a'}
 )
 
+// fileNameIndexVersion is the tree index version starting from which
+// the ordered children index is maintained.
+const fileNameIndexVersion = 1
+
 // ErrDegradedMode is returned when pilorama is in a degraded mode.
 var ErrDegradedMode = logicerr.New("pilorama is in a degraded mode")
 
@@ -59,7 +69,14 @@ var ErrReadOnlyMode = logicerr.New("pilorama is in a read-only mode")
 // - 'p' + node (id) -> parent (id),
 // - 'm' + node (id) -> serialized meta,
 // - 'c' + parent (id) + child (id) -> 0/1,
-// - 'i' + 0 + attrKey + 0 + attrValue + 0 + parent (id) + node (id) -> 0/1 (1 for automatically created nodes).
+// - 'i' + 0 + attrKey + 0 + attrValue + 0 + parent (id) + node (id) -> 0/1 (1 for automatically created nodes),
+// - 'f' + parent (id) + filename + 0 + node (id) in big-endian -> 1, children ordered by AttributeFilename,
+// - 'v' -> index version, the 'f' index is present for version 1,
+// - 'a' -> attributes indexed with 'i' keys in addition to AttributeFilename, see WithPathAttributes.
+//
+// snapshot storage (snapshotBucket), created on the first compaction:
+// - 'h' -> snapshot height in big-endian,
+// - 's' + node (id) in big-endian -> parent (id) + timestamp when the node first appeared + meta.
 func NewBoltForest(opts ...Option) ForestStorage {
 	b := boltForest{
 		cfg: cfg{
@@ -131,9 +148,87 @@ func (t *boltForest) Init() error {
 		if err != nil {
 			return err
 		}
-		return nil
+		return tx.ForEach(func(name []byte, treeRoot *bbolt.Bucket) error {
+			bTree := treeRoot.Bucket(dataBucket)
+			if bTree == nil || len(name) < 32 {
+				return nil
+			}
+			if err := t.buildFileNameIndex(bTree); err != nil {
+				return err
+			}
+			return t.buildPathIndex(bTree, t.pathAttributes(string(name[32:])))
+		})
 	})
 }
+
+// buildFileNameIndex fills the ordered children index for the trees
+// created before it was introduced.
+func (t *boltForest) buildFileNameIndex(bTree *bbolt.Bucket) error {
+	if v := bTree.Get(versionKey); len(v) == 1 && v[0] >= fileNameIndexVersion {
+		return nil
+	}
+
+	c := bTree.Cursor()
+	for k, v := c.Seek([]byte{'s'}); len(k) == 9 && k[0] == 's'; k, v = c.Next() {
+		var meta Meta
+		if err := meta.FromBytes(v[16:]); err != nil {
+			return err
+		}
+
+		node := binary.LittleEndian.Uint64(k[1:])
+		parent := binary.LittleEndian.Uint64(v)
+		if err := bTree.Put(fileNameKey(parent, meta.GetAttr(AttributeFilename), node), []byte{1}); err != nil {
+			return err
+		}
+	}
+	return bTree.Put(versionKey, []byte{fileNameIndexVersion})
+}
+
+// buildPathIndex rebuilds the path index if the set of indexed attributes
+// has been changed since the last start.
+func (t *boltForest) buildPathIndex(bTree *bbolt.Bucket, attrs []string) error {
+	stored := getPathAttributes(bTree)
+	if equalStrings(stored, attrs) {
+		return nil
+	}
+
+	var oldKeys [][]byte
+	c := bTree.Cursor()
+	for k, _ := c.Seek([]byte{'i'}); len(k) != 0 && k[0] == 'i'; k, _ = c.Next() {
+		oldKeys = append(oldKeys, append([]byte(nil), k...))
+	}
+	for i := range oldKeys {
+		if err := bTree.Delete(oldKeys[i]); err != nil {
+			return err
+		}
+	}
+
+	var key []byte
+	for k, v := c.Seek([]byte{'s'}); len(k) == 9 && k[0] == 's'; k, v = c.Next() {
+		var meta Meta
+		if err := meta.FromBytes(v[16:]); err != nil {
+			return err
+		}
+
+		node := binary.LittleEndian.Uint64(k[1:])
+		parent := binary.LittleEndian.Uint64(v)
+		for i := range meta.Items {
+			if !isAttributeInternal(attrs, meta.Items[i].Key) {
+				continue
+			}
+
+			key = internalKey(key, meta.Items[i].Key, string(meta.Items[i].Value), parent, node)
+			value := []byte{0}
+			if len(meta.Items) == 1 {
+				value[0] = 1
+			}
+			if err := bTree.Put(key, value); err != nil {
+				return err
+			}
+		}
+	}
+	return putPathAttributes(bTree, attrs)
+}
 func (t *boltForest) Close() error {
 	if t.db != nil {
 		return t.db.Close()
@@ -164,11 +259,11 @@ func (t *boltForest) TreeMove(d CIDDescriptor, treeID string, m *Move) (*Move, e
 			return err
 		}
 
-		lm.Time = t.getLatestTimestamp(bLog, d.Position, d.Size)
+		lm.Time = t.getLatestTimestamp(tx.Bucket(fullID), d.Position, d.Size)
 		if lm.Child == RootID {
 			lm.Child = t.findSpareID(bTree)
 		}
-		return t.do(bLog, bTree, make([]byte, 17), &lm)
+		return t.do(bLog, bTree, getPathAttributes(bTree), make([]byte, 17), &lm)
 	})
 }
 
@@ -197,7 +292,7 @@ func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string,
 	if !d.checkValid() {
 		return nil, ErrInvalidCIDDescriptor
 	}
-	if !isAttributeInternal(attr) {
+	if !isAttributeInternal(t.pathAttributes(treeID), attr) {
 		return nil, ErrNotPathAttribute
 	}
 
@@ -211,7 +306,6 @@ func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string,
 	}
 
 	var lm []Move
-	var key [17]byte
 
 	fullID := bucketName(d.CID, treeID)
 	err := t.db.Batch(func(tx *bbolt.Tx) error {
@@ -220,55 +314,124 @@ func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string,
 			return err
 		}
 
-		i, node, err := t.getPathPrefix(bTree, attr, path)
-		if err != nil {
-			return err
-		}
-
-		ts := t.getLatestTimestamp(bLog, d.Position, d.Size)
-		lm = make([]Move, len(path)-i+1)
-		for j := i; j < len(path); j++ {
-			lm[j-i] = Move{
-				Parent: node,
-				Meta: Meta{
-					Time:  ts,
-					Items: []KeyValue{{Key: attr, Value: []byte(path[j])}},
-				},
-				Child: t.findSpareID(bTree),
-			}
+		ts := t.getLatestTimestamp(tx.Bucket(fullID), d.Position, d.Size)
+		lm, err = t.addByPath(bLog, bTree, getPathAttributes(bTree), d, ts, attr, path, meta)
+		return err
+	})
+	return lm, err
+}
 
-			err := t.do(bLog, bTree, key[:], &lm[j-i])
-			if err != nil {
-				return err
-			}
+// addByPath adds the node with the specified meta by path, creating the missing intermediate nodes.
+// Operations get timestamps starting from ts.
+func (t *boltForest) addByPath(bLog, bTree *bbolt.Bucket, attrs []string, d CIDDescriptor, ts Timestamp, attr string, path []string, meta []KeyValue) ([]Move, error) {
+	var key [17]byte
 
-			ts = nextTimestamp(ts, uint64(d.Position), uint64(d.Size))
-			node = lm[j-i].Child
-		}
+	i, node, err := t.getPathPrefix(bTree, attr, path)
+	if err != nil {
+		return nil, err
+	}
 
-		lm[len(lm)-1] = Move{
+	lm := make([]Move, len(path)-i+1)
+	for j := i; j < len(path); j++ {
+		lm[j-i] = Move{
 			Parent: node,
 			Meta: Meta{
 				Time:  ts,
-				Items: meta,
+				Items: []KeyValue{{Key: attr, Value: []byte(path[j])}},
 			},
 			Child: t.findSpareID(bTree),
 		}
-		return t.do(bLog, bTree, key[:], &lm[len(lm)-1])
+
+		err := t.do(bLog, bTree, attrs, key[:], &lm[j-i])
+		if err != nil {
+			return nil, err
+		}
+
+		ts = nextTimestamp(ts, uint64(d.Position), uint64(d.Size))
+		node = lm[j-i].Child
+	}
+
+	lm[len(lm)-1] = Move{
+		Parent: node,
+		Meta: Meta{
+			Time:  ts,
+			Items: meta,
+		},
+		Child: t.findSpareID(bTree),
+	}
+	return lm, t.do(bLog, bTree, attrs, key[:], &lm[len(lm)-1])
+}
+
+// TreeBatch implements the Forest interface.
+func (t *boltForest) TreeBatch(d CIDDescriptor, treeID string, ops []BatchOperation) ([][]Move, error) {
+	if !d.checkValid() {
+		return nil, ErrInvalidCIDDescriptor
+	}
+	for i := range ops {
+		if ops[i].Attr != "" && !isAttributeInternal(t.pathAttributes(treeID), ops[i].Attr) {
+			return nil, ErrNotPathAttribute
+		}
+	}
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+
+	var lm [][]Move
+	var key [17]byte
+
+	fullID := bucketName(d.CID, treeID)
+	err := t.db.Batch(func(tx *bbolt.Tx) error {
+		bLog, bTree, err := t.getTreeBuckets(tx, fullID)
+		if err != nil {
+			return err
+		}
+
+		attrs := getPathAttributes(bTree)
+		ts := t.getLatestTimestamp(tx.Bucket(fullID), d.Position, d.Size)
+		lm = make([][]Move, len(ops))
+		for i := range ops {
+			if ops[i].Attr != "" {
+				lm[i], err = t.addByPath(bLog, bTree, attrs, d, ts, ops[i].Attr, ops[i].Path, ops[i].Meta.Items)
+				if err != nil {
+					return err
+				}
+			} else {
+				m := ops[i].Move
+				m.Time = ts
+				if m.Child == RootID {
+					m.Child = t.findSpareID(bTree)
+				}
+				if err := t.do(bLog, bTree, attrs, key[:], &m); err != nil {
+					return err
+				}
+				lm[i] = []Move{m}
+			}
+			ts = nextTimestamp(lm[i][len(lm[i])-1].Time, uint64(d.Position), uint64(d.Size))
+		}
+		return nil
 	})
 	return lm, err
 }
 
 // getLatestTimestamp returns timestamp for a new operation which is guaranteed to be bigger than
-// all timestamps corresponding to already stored operations.
-func (t *boltForest) getLatestTimestamp(bLog *bbolt.Bucket, pos, size int) uint64 {
+// all timestamps corresponding to already stored operations and not less than the snapshot height.
+func (t *boltForest) getLatestTimestamp(treeRoot *bbolt.Bucket, pos, size int) uint64 {
 	var ts uint64
 
-	c := bLog.Cursor()
+	c := treeRoot.Bucket(logBucket).Cursor()
 	key, _ := c.Last()
 	if len(key) != 0 {
 		ts = binary.BigEndian.Uint64(key)
 	}
+	if h := getSnapshotHeight(treeRoot); ts < h {
+		ts = h - 1
+	}
 	return nextTimestamp(ts, uint64(pos), uint64(size))
 }
 
@@ -329,6 +492,10 @@ func (t *boltForest) TreeApply(d CIDDescriptor, treeID string, m *Move, backgrou
 				return err
 			}
 
+			if m.Time < getSnapshotHeight(tx.Bucket(fullID)) {
+				return ErrBelowSnapshot
+			}
+
 			var lm Move
 			return t.applyOperation(bLog, bTree, []*Move{m}, &lm)
 		})
@@ -339,6 +506,44 @@ func (t *boltForest) TreeApply(d CIDDescriptor, treeID string, m *Move, backgrou
 	return <-ch
 }
 
+// TreeApplyBatch implements the Forest interface.
+func (t *boltForest) TreeApplyBatch(d CIDDescriptor, treeID string, ms []*Move) error {
+	if !d.checkValid() {
+		return ErrInvalidCIDDescriptor
+	}
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	ops := make([]*Move, len(ms))
+	copy(ops, ms)
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].Time < ops[j].Time
+	})
+
+	fullID := bucketName(d.CID, treeID)
+	return t.db.Update(func(tx *bbolt.Tx) error {
+		bLog, bTree, err := t.getTreeBuckets(tx, fullID)
+		if err != nil {
+			return err
+		}
+
+		// The batch is applied atomically, so it is rejected as a whole.
+		if len(ops) != 0 && ops[0].Time < getSnapshotHeight(tx.Bucket(fullID)) {
+			return ErrBelowSnapshot
+		}
+
+		var lm Move
+		return t.applyOperation(bLog, bTree, ops, &lm)
+	})
+}
+
 func (t *boltForest) addBatch(d CIDDescriptor, treeID string, m *Move, ch chan error) {
 	t.mtx.Lock()
 	for i := 0; i < len(t.batches); i++ {
@@ -402,11 +607,15 @@ func (t *boltForest) getTreeBuckets(tx *bbolt.Tx, treeRoot []byte) (*bbolt.Bucke
 	if err != nil {
 		return nil, nil, err
 	}
-	return bLog, bData, nil
+	if err := bData.Put(versionKey, []byte{fileNameIndexVersion}); err != nil {
+		return nil, nil, err
+	}
+	return bLog, bData, putPathAttributes(bData, t.pathAttributes(string(treeRoot[32:])))
 }
 
 // applyOperations applies log operations. Assumes lm are sorted by timestamp.
 func (t *boltForest) applyOperation(logBucket, treeBucket *bbolt.Bucket, ms []*Move, lm *Move) error {
+	attrs := getPathAttributes(treeBucket)
 	var tmp Move
 	var cKey [17]byte
 
@@ -427,7 +636,7 @@ func (t *boltForest) applyOperation(logBucket, treeBucket *bbolt.Bucket, ms []*M
 		if r.Err != nil {
 			return r.Err
 		}
-		if err := t.undo(&tmp, treeBucket, cKey[:]); err != nil {
+		if err := t.undo(&tmp, treeBucket, attrs, cKey[:]); err != nil {
 			return err
 		}
 		key, value = c.Prev()
@@ -438,7 +647,7 @@ func (t *boltForest) applyOperation(logBucket, treeBucket *bbolt.Bucket, ms []*M
 
 		// 2. Insert the operation.
 		*lm = *ms[i]
-		if err := t.do(logBucket, treeBucket, cKey[:], lm); err != nil {
+		if err := t.do(logBucket, treeBucket, attrs, cKey[:], lm); err != nil {
 			return err
 		}
 
@@ -452,7 +661,7 @@ func (t *boltForest) applyOperation(logBucket, treeBucket *bbolt.Bucket, ms []*M
 			if err := t.logFromBytes(&tmp, value); err != nil {
 				return err
 			}
-			if err := t.redo(treeBucket, cKey[:], &tmp, value[16:]); err != nil {
+			if err := t.redo(treeBucket, attrs, cKey[:], &tmp, value[16:]); err != nil {
 				return err
 			}
 			key, value = c.Next()
@@ -462,17 +671,17 @@ func (t *boltForest) applyOperation(logBucket, treeBucket *bbolt.Bucket, ms []*M
 	return nil
 }
 
-func (t *boltForest) do(lb *bbolt.Bucket, b *bbolt.Bucket, key []byte, op *Move) error {
+func (t *boltForest) do(lb *bbolt.Bucket, b *bbolt.Bucket, attrs []string, key []byte, op *Move) error {
 	binary.BigEndian.PutUint64(key, op.Time)
 	rawLog := t.logToBytes(op)
 	if err := lb.Put(key[:8], rawLog); err != nil {
 		return err
 	}
 
-	return t.redo(b, key, op, rawLog[16:])
+	return t.redo(b, attrs, key, op, rawLog[16:])
 }
 
-func (t *boltForest) redo(b *bbolt.Bucket, key []byte, op *Move, rawMeta []byte) error {
+func (t *boltForest) redo(b *bbolt.Bucket, attrs []string, key []byte, op *Move, rawMeta []byte) error {
 	var err error
 
 	parent, ts, currMeta, inTree := t.getState(b, stateKey(key, op.Child))
@@ -491,13 +700,16 @@ func (t *boltForest) redo(b *bbolt.Bucket, key []byte, op *Move, rawMeta []byte)
 		if err := b.Delete(childrenKey(key, op.Child, parent)); err != nil {
 			return err
 		}
+		if err := t.removeFileNameKey(b, op.Child); err != nil {
+			return err
+		}
 
 		var meta Meta
 		if err := meta.FromBytes(currMeta); err != nil {
 			return err
 		}
 		for i := range meta.Items {
-			if isAttributeInternal(meta.Items[i].Key) {
+			if isAttributeInternal(attrs, meta.Items[i].Key) {
 				key = internalKey(key, meta.Items[i].Key, string(meta.Items[i].Value), parent, op.Child)
 				err := b.Delete(key)
 				if err != nil {
@@ -506,18 +718,18 @@ func (t *boltForest) redo(b *bbolt.Bucket, key []byte, op *Move, rawMeta []byte)
 			}
 		}
 	}
-	return t.addNode(b, key, op.Child, op.Parent, ts, op.Meta, rawMeta)
+	return t.addNode(b, attrs, key, op.Child, op.Parent, ts, op.Meta, rawMeta)
 }
 
 // removeNode removes node keys from the tree except the children key or its parent.
-func (t *boltForest) removeNode(b *bbolt.Bucket, key []byte, node, parent Node) error {
+func (t *boltForest) removeNode(b *bbolt.Bucket, attrs []string, key []byte, node, parent Node) error {
 	k := stateKey(key, node)
 	_, _, rawMeta, _ := t.getState(b, k)
 
 	var meta Meta
 	if err := meta.FromBytes(rawMeta); err == nil {
 		for i := range meta.Items {
-			if isAttributeInternal(meta.Items[i].Key) {
+			if isAttributeInternal(attrs, meta.Items[i].Key) {
 				err := b.Delete(internalKey(nil, meta.Items[i].Key, string(meta.Items[i].Value), parent, node))
 				if err != nil {
 					return err
@@ -529,7 +741,7 @@ func (t *boltForest) removeNode(b *bbolt.Bucket, key []byte, node, parent Node)
 }
 
 // addNode adds node keys to the tree except the timestamp key.
-func (t *boltForest) addNode(b *bbolt.Bucket, key []byte, child, parent Node, time Timestamp, meta Meta, rawMeta []byte) error {
+func (t *boltForest) addNode(b *bbolt.Bucket, attrs []string, key []byte, child, parent Node, time Timestamp, meta Meta, rawMeta []byte) error {
 	if err := t.putState(b, stateKey(key, child), parent, time, rawMeta); err != nil {
 		return err
 	}
@@ -539,8 +751,13 @@ func (t *boltForest) addNode(b *bbolt.Bucket, key []byte, child, parent Node, ti
 		return err
 	}
 
+	err = b.Put(fileNameKey(parent, meta.GetAttr(AttributeFilename), child), []byte{1})
+	if err != nil {
+		return err
+	}
+
 	for i := range meta.Items {
-		if !isAttributeInternal(meta.Items[i].Key) {
+		if !isAttributeInternal(attrs, meta.Items[i].Key) {
 			continue
 		}
 
@@ -557,21 +774,38 @@ func (t *boltForest) addNode(b *bbolt.Bucket, key []byte, child, parent Node, ti
 	return nil
 }
 
-func (t *boltForest) undo(m *Move, b *bbolt.Bucket, key []byte) error {
+func (t *boltForest) undo(m *Move, b *bbolt.Bucket, attrs []string, key []byte) error {
 	if err := b.Delete(childrenKey(key, m.Child, m.Parent)); err != nil {
 		return err
 	}
+	if err := t.removeFileNameKey(b, m.Child); err != nil {
+		return err
+	}
 
 	parent, ts, rawMeta, ok := t.getState(b, oldKey(key, m.Time))
 	if !ok {
-		return t.removeNode(b, key, m.Child, m.Parent)
+		return t.removeNode(b, attrs, key, m.Child, m.Parent)
 	}
 
 	var meta Meta
 	if err := meta.FromBytes(rawMeta); err != nil {
 		return err
 	}
-	return t.addNode(b, key, m.Child, parent, ts, meta, rawMeta)
+	return t.addNode(b, attrs, key, m.Child, parent, ts, meta, rawMeta)
+}
+
+// removeFileNameKey removes the node from the ordered children index of its current parent.
+func (t *boltForest) removeFileNameKey(b *bbolt.Bucket, node Node) error {
+	parent, _, rawMeta, ok := t.getState(b, stateKey(make([]byte, 9), node))
+	if !ok {
+		return nil
+	}
+
+	var meta Meta
+	if err := meta.FromBytes(rawMeta); err != nil {
+		return err
+	}
+	return b.Delete(fileNameKey(parent, meta.GetAttr(AttributeFilename), node))
 }
 
 func (t *boltForest) isAncestor(b *bbolt.Bucket, parent, child Node) bool {
@@ -590,7 +824,7 @@ func (t *boltForest) isAncestor(b *bbolt.Bucket, parent, child Node) bool {
 
 // TreeGetByPath implements the Forest interface.
 func (t *boltForest) TreeGetByPath(cid cidSDK.ID, treeID string, attr string, path []string, latest bool) ([]Node, error) {
-	if !isAttributeInternal(attr) {
+	if !isAttributeInternal(t.pathAttributes(treeID), attr) {
 		return nil, ErrNotPathAttribute
 	}
 
@@ -614,6 +848,10 @@ func (t *boltForest) TreeGetByPath(cid cidSDK.ID, treeID string, attr string, pa
 		}
 
 		b := treeRoot.Bucket(dataBucket)
+		if !isAttributeInternal(getPathAttributes(b), attr) {
+			// The index is not built yet, e.g. in the read-only mode.
+			return ErrNotPathAttribute
+		}
 
 		i, curNode, err := t.getPathPrefix(b, attr, path[:len(path)-1])
 		if err != nil {
@@ -710,6 +948,110 @@ func (t *boltForest) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node)
 	return children, err
 }
 
+// TreeSortedByFilename implements the Forest interface.
+func (t *boltForest) TreeSortedByFilename(cid cidSDK.ID, treeID string, nodeID Node, start string, count int) ([]NodeInfo, error) {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	prefix := fileNameKey(nodeID, nil, 0)
+	prefix = prefix[:len(prefix)-9]
+
+	var res []NodeInfo
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		b := treeRoot.Bucket(dataBucket)
+		if v := b.Get(versionKey); len(v) != 1 || v[0] < fileNameIndexVersion {
+			// The index is built in Init, which is skipped for the read-only databases.
+			var err error
+			res, err = t.sortedByFilenameNoIndex(b, nodeID, start, count)
+			return err
+		}
+
+		c := b.Cursor()
+
+		seek := prefix
+		if start != "" {
+			seek = fileNameKey(nodeID, []byte(start), math.MaxUint64)
+		}
+
+		var last []byte
+		key := make([]byte, 9)
+		for k, _ := c.Seek(seek); len(k) >= len(prefix)+9 && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			name := k[len(prefix) : len(k)-9]
+			if start != "" && string(name) <= start {
+				continue
+			}
+			if 0 < count && count <= len(res) && !bytes.Equal(name, last) {
+				break
+			}
+
+			node := binary.BigEndian.Uint64(k[len(k)-8:])
+			parent, _, rawMeta, _ := t.getState(b, stateKey(key, node))
+			info := NodeInfo{ID: node, ParentID: parent}
+			if err := info.Meta.FromBytes(rawMeta); err != nil {
+				return err
+			}
+
+			res = append(res, info)
+			last = name
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+// sortedByFilenameNoIndex works like TreeSortedByFilename for the trees
+// created before the filename index was introduced. Children are sorted in memory.
+func (t *boltForest) sortedByFilenameNoIndex(b *bbolt.Bucket, nodeID Node, start string, count int) ([]NodeInfo, error) {
+	key := make([]byte, 9)
+	key[0] = 'c'
+	binary.LittleEndian.PutUint64(key[1:], nodeID)
+
+	var res []NodeInfo
+
+	c := b.Cursor()
+	for k, _ := c.Seek(key); len(k) == 17 && binary.LittleEndian.Uint64(k[1:]) == nodeID; k, _ = c.Next() {
+		node := binary.LittleEndian.Uint64(k[9:])
+		parent, _, rawMeta, _ := t.getState(b, stateKey(make([]byte, 9), node))
+		info := NodeInfo{ID: node, ParentID: parent}
+		if err := info.Meta.FromBytes(rawMeta); err != nil {
+			return nil, err
+		}
+		if start != "" && string(info.Meta.GetAttr(AttributeFilename)) <= start {
+			continue
+		}
+		res = append(res, info)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		ni := string(res[i].Meta.GetAttr(AttributeFilename))
+		nj := string(res[j].Meta.GetAttr(AttributeFilename))
+		return ni < nj || ni == nj && res[i].ID < res[j].ID
+	})
+
+	if count <= 0 || len(res) <= count {
+		return res, nil
+	}
+
+	// Nodes with the same filename are returned together, like with the index.
+	last := string(res[count-1].Meta.GetAttr(AttributeFilename))
+	n := count
+	for n < len(res) && string(res[n].Meta.GetAttr(AttributeFilename)) == last {
+		n++
+	}
+	return res[:n], nil
+}
+
 // TreeList implements the Forest interface.
 func (t *boltForest) TreeList(cid cidSDK.ID) ([]string, error) {
 	t.modeMtx.RLock()
@@ -774,6 +1116,38 @@ func (t *boltForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (
 	return lm, err
 }
 
+// TreeLogDigest implements the pilorama.Forest interface.
+func (t *boltForest) TreeLogDigest(cid cidSDK.ID, treeID string, start, end uint64) (LogDigest, error) {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return LogDigest{}, ErrDegradedMode
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, start)
+
+	b := newDigestBuilder()
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		c := treeRoot.Bucket(logBucket).Cursor()
+		for k, v := c.Seek(key); len(k) == 8; k, v = c.Next() {
+			ts := binary.BigEndian.Uint64(k)
+			if ts >= end {
+				break
+			}
+			b.add(ts, v)
+		}
+		return nil
+	})
+	return b.digest(), err
+}
+
 // TreeDrop implements the pilorama.Forest interface.
 func (t *boltForest) TreeDrop(cid cidSDK.ID, treeID string) error {
 	t.modeMtx.RLock()
@@ -806,6 +1180,241 @@ func (t *boltForest) TreeDrop(cid cidSDK.ID, treeID string) error {
 	})
 }
 
+// TreeCompact implements the pilorama.Forest interface.
+func (t *boltForest) TreeCompact(cid cidSDK.ID, treeID string, height uint64) error {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return t.db.Update(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		bLog := treeRoot.Bucket(logBucket)
+		bTree := treeRoot.Bucket(dataBucket)
+
+		key, _ := bLog.Cursor().Last()
+		if len(key) != 8 {
+			return nil
+		}
+		if last := binary.BigEndian.Uint64(key); last < height {
+			height = last
+		}
+		if height <= getSnapshotHeight(treeRoot) {
+			return nil
+		}
+
+		var tmp Move
+		var cKey [17]byte
+		attrs := getPathAttributes(bTree)
+
+		// 1. Undo all operations above the snapshot height.
+		c := bLog.Cursor()
+		for key, value := c.Last(); len(key) == 8 && height <= binary.BigEndian.Uint64(key); key, value = c.Prev() {
+			if err := t.logFromBytes(&tmp, value); err != nil {
+				return err
+			}
+			if err := t.undo(&tmp, bTree, attrs, cKey[:]); err != nil {
+				return err
+			}
+		}
+
+		// 2. Save the tree state.
+		if err := t.saveSnapshot(treeRoot, bTree, height); err != nil {
+			return err
+		}
+
+		// 3. Remove compacted operations together with the information needed to undo them.
+		var compacted []uint64
+		for key, _ := c.First(); len(key) == 8 && binary.BigEndian.Uint64(key) < height; key, _ = c.Next() {
+			compacted = append(compacted, binary.BigEndian.Uint64(key))
+		}
+		for _, ts := range compacted {
+			binary.BigEndian.PutUint64(cKey[:], ts)
+			if err := bLog.Delete(cKey[:8]); err != nil {
+				return err
+			}
+			if err := bTree.Delete(oldKey(cKey[:], ts)); err != nil {
+				return err
+			}
+		}
+
+		// 4. Re-apply the operations above the snapshot height.
+		binary.BigEndian.PutUint64(cKey[:], height)
+		c = bLog.Cursor()
+		for key, value := c.Seek(cKey[:8]); len(key) == 8; key, value = c.Next() {
+			if err := t.logFromBytes(&tmp, value); err != nil {
+				return err
+			}
+			if err := t.redo(bTree, attrs, cKey[:], &tmp, value[16:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// saveSnapshot replaces the stored snapshot with the current tree state.
+func (t *boltForest) saveSnapshot(treeRoot, bTree *bbolt.Bucket, height uint64) error {
+	err := treeRoot.DeleteBucket(snapshotBucket)
+	if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+		return err
+	}
+
+	bSnap, err := treeRoot.CreateBucket(snapshotBucket)
+	if err != nil {
+		return err
+	}
+	if err := putSnapshotHeight(bSnap, height); err != nil {
+		return err
+	}
+
+	c := bTree.Cursor()
+	for k, v := c.Seek([]byte{'s'}); len(k) == 9 && k[0] == 's'; k, v = c.Next() {
+		node := binary.LittleEndian.Uint64(k[1:])
+		if err := bSnap.Put(snapshotKey(node), append([]byte(nil), v...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TreeGetSnapshot implements the pilorama.Forest interface.
+func (t *boltForest) TreeGetSnapshot(cid cidSDK.ID, treeID string, start Node, count int) (uint64, []SnapshotNode, error) {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return 0, nil, ErrDegradedMode
+	}
+
+	var height uint64
+	var nodes []SnapshotNode
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		bSnap := treeRoot.Bucket(snapshotBucket)
+		if bSnap == nil {
+			return nil
+		}
+
+		height = getSnapshotHeight(treeRoot)
+
+		c := bSnap.Cursor()
+		for k, _ := c.Seek(snapshotKey(start)); len(k) == 9 && k[0] == 's' && len(nodes) < count; k, _ = c.Next() {
+			parent, ts, rawMeta, _ := t.getState(bSnap, k)
+
+			n := SnapshotNode{
+				ID:        binary.BigEndian.Uint64(k[1:]),
+				Parent:    parent,
+				Timestamp: ts,
+			}
+			if err := n.Meta.FromBytes(rawMeta); err != nil {
+				return err
+			}
+			nodes = append(nodes, n)
+		}
+		return nil
+	})
+
+	return height, nodes, err
+}
+
+// TreeApplySnapshot implements the pilorama.Forest interface.
+func (t *boltForest) TreeApplySnapshot(d CIDDescriptor, treeID string, height uint64, nodes []SnapshotNode) error {
+	if !d.checkValid() {
+		return ErrInvalidCIDDescriptor
+	}
+
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if t.mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	fullID := bucketName(d.CID, treeID)
+	return t.db.Update(func(tx *bbolt.Tx) error {
+		bLog, bTree, err := t.getTreeBuckets(tx, fullID)
+		if err != nil {
+			return err
+		}
+		if key, _ := bLog.Cursor().First(); key != nil {
+			return ErrTreeNotEmpty
+		}
+
+		treeRoot := tx.Bucket(fullID)
+		bSnap := treeRoot.Bucket(snapshotBucket)
+		if bSnap == nil || getSnapshotHeight(treeRoot) != height {
+			if err := treeRoot.DeleteBucket(dataBucket); err != nil {
+				return err
+			}
+			if bTree, err = treeRoot.CreateBucket(dataBucket); err != nil {
+				return err
+			}
+			if err := bTree.Put(versionKey, []byte{fileNameIndexVersion}); err != nil {
+				return err
+			}
+			if err := putPathAttributes(bTree, t.pathAttributes(treeID)); err != nil {
+				return err
+			}
+			if bSnap != nil {
+				if err := treeRoot.DeleteBucket(snapshotBucket); err != nil {
+					return err
+				}
+			}
+			if bSnap, err = treeRoot.CreateBucket(snapshotBucket); err != nil {
+				return err
+			}
+			if err := putSnapshotHeight(bSnap, height); err != nil {
+				return err
+			}
+		}
+
+		var key [17]byte
+		attrs := getPathAttributes(bTree)
+		for i := range nodes {
+			rawMeta := nodes[i].Meta.Bytes()
+			err := t.putState(bSnap, snapshotKey(nodes[i].ID), nodes[i].Parent, nodes[i].Timestamp, rawMeta)
+			if err != nil {
+				return err
+			}
+
+			// Node could have been applied already, remove the previous state.
+			if parent, _, _, ok := t.getState(bTree, stateKey(key[:], nodes[i].ID)); ok {
+				if err := bTree.Delete(childrenKey(key[:], nodes[i].ID, parent)); err != nil {
+					return err
+				}
+				if err := t.removeFileNameKey(bTree, nodes[i].ID); err != nil {
+					return err
+				}
+				if err := t.removeNode(bTree, attrs, key[:], nodes[i].ID, parent); err != nil {
+					return err
+				}
+			}
+
+			err = t.addNode(bTree, attrs, key[:], nodes[i].ID, nodes[i].Parent, nodes[i].Timestamp, nodes[i].Meta, rawMeta)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (t *boltForest) getPathPrefix(bTree *bbolt.Bucket, attr string, path []string) (int, Node, error) {
 	c := bTree.Cursor()
 
@@ -868,6 +1477,72 @@ func bucketName(cid cidSDK.ID, treeID string) []byte {
 	return treeRoot
 }
 
+// 's' + node (id) in big-endian, so that snapshot nodes are sorted by ID.
+func snapshotKey(node Node) []byte {
+	key := make([]byte, 9)
+	key[0] = 's'
+	binary.BigEndian.PutUint64(key[1:], node)
+	return key
+}
+
+// getSnapshotHeight returns the height of the last tree snapshot, 0 if there is none.
+func getSnapshotHeight(treeRoot *bbolt.Bucket) uint64 {
+	if bSnap := treeRoot.Bucket(snapshotBucket); bSnap != nil {
+		if data := bSnap.Get([]byte{'h'}); len(data) == 8 {
+			return binary.BigEndian.Uint64(data)
+		}
+	}
+	return 0
+}
+
+func putSnapshotHeight(bSnap *bbolt.Bucket, height uint64) error {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, height)
+	return bSnap.Put([]byte{'h'}, data)
+}
+
+// getPathAttributes returns the attributes indexed in the tree in addition to AttributeFilename.
+func getPathAttributes(bTree *bbolt.Bucket) []string {
+	var attrs []string
+	data := bTree.Get(pathAttrsKey)
+	for len(data) >= 2 {
+		l := int(data[0]) | int(data[1])<<8
+		if len(data) < 2+l {
+			break
+		}
+		attrs = append(attrs, string(data[2:2+l]))
+		data = data[2+l:]
+	}
+	return attrs
+}
+
+// putPathAttributes stores the attributes as a sequence of 2-byte length + attribute name.
+func putPathAttributes(bTree *bbolt.Bucket, attrs []string) error {
+	if len(attrs) == 0 {
+		return bTree.Delete(pathAttrsKey)
+	}
+
+	var data []byte
+	for i := range attrs {
+		l := len(attrs[i])
+		data = append(data, byte(l), byte(l>>8))
+		data = append(data, attrs[i]...)
+	}
+	return bTree.Put(pathAttrsKey, data)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // 'o' + time -> old meta.
 func oldKey(key []byte, ts Timestamp) []byte {
 	key[0] = 'o'
@@ -901,6 +1576,16 @@ func (t *boltForest) getState(b *bbolt.Bucket, key []byte) (Node, Timestamp, []b
 	return parent, timestamp, data[16:], true
 }
 
+// 'f' + parent (id) + filename + 0 + node (id) in big-endian.
+func fileNameKey(parent Node, name []byte, node Node) []byte {
+	key := make([]byte, 1+8+len(name)+1+8)
+	key[0] = 'f'
+	binary.LittleEndian.PutUint64(key[1:], parent)
+	copy(key[9:], name)
+	binary.BigEndian.PutUint64(key[len(key)-8:], node)
+	return key
+}
+
 // 'c' + parent (id) + child (id) -> 0/1.
 func childrenKey(key []byte, child, parent Node) []byte {
 	key[0] = 'c'
@@ -0,0 +1,46 @@
+package pilorama
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+)
+
+// digestBuilder accumulates log operations to LogDigest.
+// Every operation is hashed as timestamp in big-endian + child + parent + meta.
+type digestBuilder struct {
+	h hash.Hash
+	d LogDigest
+}
+
+func newDigestBuilder() *digestBuilder {
+	return &digestBuilder{h: sha256.New()}
+}
+
+// add adds the operation serialized as in the log bucket.
+func (b *digestBuilder) add(ts Timestamp, rawLog []byte) {
+	var key [8]byte
+	binary.BigEndian.PutUint64(key[:], ts)
+	b.h.Write(key[:])
+	b.h.Write(rawLog)
+
+	b.d.Count++
+	b.d.Size += uint64(len(rawLog))
+	b.d.Last = ts
+}
+
+func (b *digestBuilder) addMove(m *Move) {
+	rawLog := make([]byte, 16, 16+m.Meta.Size())
+	binary.LittleEndian.PutUint64(rawLog, m.Child)
+	binary.LittleEndian.PutUint64(rawLog[8:], m.Parent)
+	b.add(m.Time, append(rawLog, m.Meta.Bytes()...))
+}
+
+// digest returns the resulting digest, zero value for an empty range.
+func (b *digestBuilder) digest() LogDigest {
+	if b.d.Count == 0 {
+		return LogDigest{}
+	}
+	copy(b.d.Hash[:], b.h.Sum(nil))
+	return b.d
+}
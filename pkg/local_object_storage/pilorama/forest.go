@@ -12,16 +12,24 @@ import (
 type memoryForest struct {
 	// treeMap maps tree identifier (container ID + name) to the replicated log.
 	treeMap map[string]*state
+
+	cfg
 }
 
 var _ Forest = (*memoryForest)(nil)
 
 // NewMemoryForest creates new empty forest.
 // TODO: this function will eventually be removed and is here for debugging.
-func NewMemoryForest() ForestStorage {
-	return &memoryForest{
+func NewMemoryForest(opts ...Option) ForestStorage {
+	f := &memoryForest{
 		treeMap: make(map[string]*state),
 	}
+
+	for i := range opts {
+		opts[i](&f.cfg)
+	}
+
+	return f
 }
 
 // TreeMove implements the Forest interface.
@@ -52,7 +60,7 @@ func (f *memoryForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string
 	if !d.checkValid() {
 		return nil, ErrInvalidCIDDescriptor
 	}
-	if !isAttributeInternal(attr) {
+	if !isAttributeInternal(f.pathAttributes(treeID), attr) {
 		return nil, ErrNotPathAttribute
 	}
 
@@ -63,32 +71,44 @@ func (f *memoryForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string
 		f.treeMap[fullID] = s
 	}
 
-	i, node := s.getPathPrefix(attr, path)
-	lm := make([]Move, len(path)-i+1)
-	for j := i; j < len(path); j++ {
-		op := s.do(&Move{
-			Parent: node,
-			Meta: Meta{
-				Time:  s.timestamp(d.Position, d.Size),
-				Items: []KeyValue{{Key: attr, Value: []byte(path[j])}}},
-			Child: s.findSpareID(),
-		})
-		lm[j-i] = op.Move
-		node = op.Child
-		s.operations = append(s.operations, op)
-	}
-
-	mCopy := make([]KeyValue, len(m))
-	copy(mCopy, m)
-	op := s.do(&Move{
-		Parent: node,
-		Meta: Meta{
-			Time:  s.timestamp(d.Position, d.Size),
-			Items: mCopy,
-		},
-		Child: s.findSpareID(),
-	})
-	lm[len(lm)-1] = op.Move
+	return s.addByPath(d, attr, path, m), nil
+}
+
+// TreeBatch implements the Forest interface.
+func (f *memoryForest) TreeBatch(d CIDDescriptor, treeID string, ops []BatchOperation) ([][]Move, error) {
+	if !d.checkValid() {
+		return nil, ErrInvalidCIDDescriptor
+	}
+	for i := range ops {
+		if ops[i].Attr != "" && !isAttributeInternal(f.pathAttributes(treeID), ops[i].Attr) {
+			return nil, ErrNotPathAttribute
+		}
+	}
+
+	fullID := d.CID.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		s = newState()
+		f.treeMap[fullID] = s
+	}
+
+	lm := make([][]Move, len(ops))
+	for i := range ops {
+		if ops[i].Attr != "" {
+			lm[i] = s.addByPath(d, ops[i].Attr, ops[i].Path, ops[i].Meta.Items)
+			continue
+		}
+
+		op := ops[i].Move
+		op.Time = s.timestamp(d.Position, d.Size)
+		if op.Child == RootID {
+			op.Child = s.findSpareID()
+		}
+
+		m := s.do(&op)
+		s.operations = append(s.operations, m)
+		lm[i] = []Move{m.Move}
+	}
 	return lm, nil
 }
 
@@ -108,6 +128,34 @@ func (f *memoryForest) TreeApply(d CIDDescriptor, treeID string, op *Move, _ boo
 	return s.Apply(op)
 }
 
+// TreeApplyBatch implements the Forest interface.
+func (f *memoryForest) TreeApplyBatch(d CIDDescriptor, treeID string, ops []*Move) error {
+	if !d.checkValid() {
+		return ErrInvalidCIDDescriptor
+	}
+
+	fullID := d.CID.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		s = newState()
+		f.treeMap[fullID] = s
+	}
+
+	// The batch is applied atomically, so it is rejected as a whole.
+	for i := range ops {
+		if ops[i].Time < s.height {
+			return ErrBelowSnapshot
+		}
+	}
+
+	for i := range ops {
+		if err := s.Apply(ops[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (f *memoryForest) Init() error {
 	return nil
 }
@@ -124,7 +172,7 @@ func (f *memoryForest) Close() error {
 
 // TreeGetByPath implements the Forest interface.
 func (f *memoryForest) TreeGetByPath(cid cidSDK.ID, treeID string, attr string, path []string, latest bool) ([]Node, error) {
-	if !isAttributeInternal(attr) {
+	if !isAttributeInternal(f.pathAttributes(treeID), attr) {
 		return nil, ErrNotPathAttribute
 	}
 
@@ -166,6 +214,41 @@ func (f *memoryForest) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node
 	return res, nil
 }
 
+// TreeSortedByFilename implements the Forest interface.
+func (f *memoryForest) TreeSortedByFilename(cid cidSDK.ID, treeID string, nodeID Node, start string, count int) ([]NodeInfo, error) {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+
+	children := s.childMap[nodeID]
+	i := 0
+	if start != "" {
+		i = sort.Search(len(children), func(i int) bool {
+			return string(s.infoMap[children[i]].Meta.GetAttr(AttributeFilename)) > start
+		})
+	}
+
+	var res []NodeInfo
+	var last string
+	for ; i < len(children); i++ {
+		info := s.infoMap[children[i]]
+		name := string(info.Meta.GetAttr(AttributeFilename))
+		if 0 < count && count <= len(res) && name != last {
+			break
+		}
+
+		res = append(res, NodeInfo{
+			ID:       children[i],
+			Meta:     info.Meta,
+			ParentID: info.Parent,
+		})
+		last = name
+	}
+	return res, nil
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (f *memoryForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error) {
 	fullID := cid.String() + "/" + treeID
@@ -183,6 +266,24 @@ func (f *memoryForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64)
 	return s.operations[n].Move, nil
 }
 
+// TreeLogDigest implements the pilorama.Forest interface.
+func (f *memoryForest) TreeLogDigest(cid cidSDK.ID, treeID string, start, end uint64) (LogDigest, error) {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return LogDigest{}, ErrTreeNotFound
+	}
+
+	b := newDigestBuilder()
+	n := sort.Search(len(s.operations), func(i int) bool {
+		return s.operations[i].Time >= start
+	})
+	for ; n < len(s.operations) && s.operations[n].Time < end; n++ {
+		b.addMove(&s.operations[n].Move)
+	}
+	return b.digest(), nil
+}
+
 // TreeDrop implements the pilorama.Forest interface.
 func (f *memoryForest) TreeDrop(cid cidSDK.ID, treeID string) error {
 	cidStr := cid.String()
@@ -226,3 +327,68 @@ func (f *memoryForest) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	_, ok := f.treeMap[fullID]
 	return ok, nil
 }
+
+// TreeCompact implements the pilorama.Forest interface.
+func (f *memoryForest) TreeCompact(cid cidSDK.ID, treeID string, height uint64) error {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return ErrTreeNotFound
+	}
+
+	if len(s.operations) == 0 {
+		return nil
+	}
+	if last := s.operations[len(s.operations)-1].Time; last < height {
+		height = last
+	}
+	if height <= s.height {
+		return nil
+	}
+
+	s.compact(height)
+	return nil
+}
+
+// TreeGetSnapshot implements the pilorama.Forest interface.
+func (f *memoryForest) TreeGetSnapshot(cid cidSDK.ID, treeID string, start Node, count int) (uint64, []SnapshotNode, error) {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return 0, nil, ErrTreeNotFound
+	}
+
+	n := sort.Search(len(s.snapshot), func(i int) bool {
+		return s.snapshot[i].ID >= start
+	})
+	end := len(s.snapshot)
+	if n+count < end {
+		end = n + count
+	}
+
+	res := make([]SnapshotNode, end-n)
+	copy(res, s.snapshot[n:end])
+	return s.height, res, nil
+}
+
+// TreeApplySnapshot implements the pilorama.Forest interface.
+func (f *memoryForest) TreeApplySnapshot(d CIDDescriptor, treeID string, height uint64, nodes []SnapshotNode) error {
+	if !d.checkValid() {
+		return ErrInvalidCIDDescriptor
+	}
+
+	fullID := d.CID.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if ok && len(s.operations) != 0 {
+		return ErrTreeNotEmpty
+	}
+	if !ok || s.height != height || s.snapshot == nil {
+		s = newState()
+		s.height = height
+		s.snapshot = []SnapshotNode{}
+		f.treeMap[fullID] = s
+	}
+
+	s.applySnapshot(nodes)
+	return nil
+}
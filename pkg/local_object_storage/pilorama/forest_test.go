@@ -1,7 +1,9 @@
 package pilorama
 
 import (
+	"bytes"
 	"fmt"
+	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -13,14 +15,15 @@ import (
 	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
 )
 
 var providers = []struct {
 	name      string
 	construct func(t testing.TB, opts ...Option) Forest
 }{
-	{"inmemory", func(t testing.TB, _ ...Option) Forest {
-		f := NewMemoryForest()
+	{"inmemory", func(t testing.TB, opts ...Option) Forest {
+		f := NewMemoryForest(opts...)
 		require.NoError(t, f.Open(false))
 		require.NoError(t, f.Init())
 		t.Cleanup(func() {
@@ -514,6 +517,566 @@ func testForestTreeGetOpLog(t *testing.T, constructor func(t testing.TB, _ ...Op
 	})
 }
 
+func TestForest_TreeCompact(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeCompact(t, providers[i].construct)
+		})
+	}
+}
+
+func testForestTreeCompact(t *testing.T, constructor func(t testing.TB, _ ...Option) Forest) {
+	rand.Seed(42)
+
+	const (
+		nodeCount = 5
+		opCount   = 20
+	)
+
+	ops := prepareRandomTree(nodeCount, opCount)
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	expected := constructor(t)
+	actual := constructor(t)
+	for i := range ops {
+		require.NoError(t, expected.TreeApply(d, treeID, &ops[i], false))
+		require.NoError(t, actual.TreeApply(d, treeID, &ops[i], false))
+	}
+
+	t.Run("missing tree", func(t *testing.T) {
+		require.ErrorIs(t, actual.TreeCompact(cid, treeID+"123", 1), ErrTreeNotFound)
+		_, _, err := actual.TreeGetSnapshot(cid, treeID+"123", 0, 1)
+		require.ErrorIs(t, err, ErrTreeNotFound)
+	})
+
+	h, nodes, err := actual.TreeGetSnapshot(cid, treeID, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), h)
+	require.Empty(t, nodes)
+
+	height := ops[len(ops)/2].Time
+	require.NoError(t, actual.TreeCompact(cid, treeID, height))
+	compareTrees(t, expected, actual, cid, treeID, nodeCount+10)
+
+	lm, err := actual.TreeGetOpLog(cid, treeID, 0)
+	require.NoError(t, err)
+	require.Equal(t, ops[len(ops)/2], lm)
+
+	h, nodes, err = actual.TreeGetSnapshot(cid, treeID, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, height, h)
+	require.True(t, len(nodes) > 2)
+
+	t.Run("paging", func(t *testing.T) {
+		_, page, err := actual.TreeGetSnapshot(cid, treeID, 0, 2)
+		require.NoError(t, err)
+		require.Equal(t, nodes[:2], page)
+
+		_, page, err = actual.TreeGetSnapshot(cid, treeID, nodes[1].ID+1, 100)
+		require.NoError(t, err)
+		require.Equal(t, nodes[2:], page)
+	})
+
+	t.Run("operations below the snapshot are rejected", func(t *testing.T) {
+		m := &Move{
+			Parent: TrashID,
+			Meta:   Meta{Time: height - 1},
+			Child:  nodes[0].ID,
+		}
+		require.ErrorIs(t, actual.TreeApply(d, treeID, m, false), ErrBelowSnapshot)
+		require.ErrorIs(t, actual.TreeApplyBatch(d, treeID, []*Move{m}), ErrBelowSnapshot)
+		compareTrees(t, expected, actual, cid, treeID, nodeCount+10)
+	})
+
+	t.Run("bootstrap from snapshot", func(t *testing.T) {
+		restored := constructor(t)
+		require.NoError(t, restored.TreeApplySnapshot(d, treeID, h, nodes[:1]))
+		require.NoError(t, restored.TreeApplySnapshot(d, treeID, h, nodes))
+
+		for lm, err := actual.TreeGetOpLog(cid, treeID, h); lm.Time != 0; lm, err = actual.TreeGetOpLog(cid, treeID, lm.Time+1) {
+			require.NoError(t, err)
+			require.NoError(t, restored.TreeApply(d, treeID, &lm, false))
+		}
+		compareTrees(t, expected, restored, cid, treeID, nodeCount+10)
+
+		require.ErrorIs(t, restored.TreeApplySnapshot(d, treeID, h, nodes), ErrTreeNotEmpty)
+	})
+
+	t.Run("last operation is kept", func(t *testing.T) {
+		require.NoError(t, actual.TreeCompact(cid, treeID, math.MaxUint64))
+		compareTrees(t, expected, actual, cid, treeID, nodeCount+10)
+
+		lm, err := actual.TreeGetOpLog(cid, treeID, 0)
+		require.NoError(t, err)
+		require.Equal(t, ops[len(ops)-1], lm)
+
+		m, err := actual.TreeMove(d, treeID, &Move{Parent: RootID})
+		require.NoError(t, err)
+		require.True(t, ops[len(ops)-1].Time < m.Time)
+	})
+}
+
+func TestForest_ApplyBelowSnapshot(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestApplyBelowSnapshot(t, providers[i].construct)
+		})
+	}
+}
+
+func testForestApplyBelowSnapshot(t *testing.T, constructor func(t testing.TB, _ ...Option) Forest) {
+	rand.Seed(42)
+
+	const (
+		nodeCount = 5
+		opCount   = 20
+	)
+
+	// Leave gaps between the timestamps for the late operations.
+	ops := prepareRandomTree(nodeCount, opCount)
+	for i := range ops {
+		ops[i].Time *= 2
+	}
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	// Replicas receive the operations in a different order and are batched differently.
+	forests := []Forest{constructor(t), constructor(t, WithMaxBatchSize(opCount))}
+	for _, i := range rand.Perm(len(ops)) {
+		require.NoError(t, forests[0].TreeApply(d, treeID, &ops[i], false))
+	}
+	for i := range ops {
+		require.NoError(t, forests[1].TreeApply(d, treeID, &ops[i], false))
+	}
+
+	height := ops[len(ops)/2].Time
+	for i := range forests {
+		require.NoError(t, forests[i].TreeCompact(cid, treeID, height))
+	}
+	compareTrees(t, forests[0], forests[1], cid, treeID, nodeCount+10)
+
+	late := []Move{
+		{Parent: TrashID, Meta: Meta{Time: height - 1}, Child: 1},
+		{Parent: RootID, Meta: Meta{Time: height + 1}, Child: 2},
+	}
+
+	// The late operation below the snapshot can't be applied on any replica,
+	// it must not be silently dropped on one and applied on another.
+	require.ErrorIs(t, forests[0].TreeApply(d, treeID, &late[0], false), ErrBelowSnapshot)
+	require.ErrorIs(t, forests[1].TreeApply(d, treeID, &late[0], true), ErrBelowSnapshot)
+	require.ErrorIs(t, forests[0].TreeApplyBatch(d, treeID, []*Move{&late[1], &late[0]}), ErrBelowSnapshot)
+	compareTrees(t, forests[0], forests[1], cid, treeID, nodeCount+10)
+
+	// The out-of-order operation above the snapshot is applied on both replicas.
+	require.NoError(t, forests[0].TreeApply(d, treeID, &late[1], false))
+	require.NoError(t, forests[1].TreeApplyBatch(d, treeID, []*Move{&late[1]}))
+	compareTrees(t, forests[0], forests[1], cid, treeID, nodeCount+10)
+
+	_, parent, err := forests[1].TreeGetMeta(cid, treeID, 2)
+	require.NoError(t, err)
+	require.Equal(t, Node(RootID), parent)
+}
+
+// compareTrees checks that the trees have the same state.
+// Unlike compareForests, it doesn't compare operation logs.
+func compareTrees(t *testing.T, expected, actual Forest, cid cidSDK.ID, treeID string, nodeCount int) {
+	for i := uint64(0); i < uint64(nodeCount); i++ {
+		expectedMeta, expectedParent, err := expected.TreeGetMeta(cid, treeID, i)
+		require.NoError(t, err)
+		actualMeta, actualParent, err := actual.TreeGetMeta(cid, treeID, i)
+		require.NoError(t, err)
+		require.Equal(t, expectedParent, actualParent, "node id: %d", i)
+		require.Equal(t, expectedMeta, actualMeta, "node id: %d", i)
+
+		expectedChildren, err := expected.TreeGetChildren(cid, treeID, i)
+		require.NoError(t, err)
+		actualChildren, err := actual.TreeGetChildren(cid, treeID, i)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expectedChildren, actualChildren, "node id: %d", i)
+	}
+}
+
+func TestForest_TreeSortedByFilename(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeSortedByFilename(t, providers[i].construct(t))
+		})
+	}
+}
+
+func testForestTreeSortedByFilename(t *testing.T, s Forest) {
+	rand.Seed(42)
+
+	const (
+		nodeCount = 5
+		opCount   = 50
+	)
+
+	ops := prepareRandomTree(nodeCount, opCount)
+	for i := range ops {
+		// Produce some duplicate names.
+		ops[i].Meta.Items[0].Value = []byte(strconv.Itoa(rand.Intn(5)))
+	}
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	_, err := s.TreeSortedByFilename(cid, treeID, RootID, "", 0)
+	require.ErrorIs(t, err, ErrTreeNotFound)
+
+	for i := range ops {
+		require.NoError(t, s.TreeApply(d, treeID, &ops[i], false))
+	}
+
+	name := func(n NodeInfo) string {
+		return string(n.Meta.GetAttr(AttributeFilename))
+	}
+
+	for node := Node(0); node < nodeCount+10; node++ {
+		children, err := s.TreeGetChildren(cid, treeID, node)
+		require.NoError(t, err)
+
+		sorted, err := s.TreeSortedByFilename(cid, treeID, node, "", 0)
+		require.NoError(t, err)
+		require.Equal(t, len(children), len(sorted))
+
+		for i := range sorted {
+			require.Contains(t, children, sorted[i].ID)
+
+			meta, parent, err := s.TreeGetMeta(cid, treeID, sorted[i].ID)
+			require.NoError(t, err)
+			require.Equal(t, node, parent)
+			require.Equal(t, node, sorted[i].ParentID)
+			require.Equal(t, meta, sorted[i].Meta)
+
+			if i > 0 {
+				prev, cur := name(sorted[i-1]), name(sorted[i])
+				require.True(t, prev < cur || prev == cur && sorted[i-1].ID < sorted[i].ID)
+			}
+		}
+
+		// Iterate by pages of size 1, the same names must be returned together.
+		var paged []NodeInfo
+		var start string
+		for {
+			page, err := s.TreeSortedByFilename(cid, treeID, node, start, 1)
+			require.NoError(t, err)
+			if len(page) == 0 {
+				break
+			}
+			for i := range page {
+				require.Equal(t, name(page[0]), name(page[i]))
+			}
+			paged = append(paged, page...)
+			start = name(page[0])
+			if start == "" {
+				// Nodes without filename can't be skipped with a cursor.
+				break
+			}
+		}
+		if len(sorted) != 0 && name(sorted[0]) != "" {
+			require.Equal(t, sorted, paged)
+		}
+	}
+}
+
+func TestBoltForest_BuildFileNameIndex(t *testing.T) {
+	f := NewBoltForest(
+		WithPath(filepath.Join(t.TempDir(), "test.db")),
+		WithMaxBatchSize(1))
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+	defer func() { require.NoError(t, f.Close()) }()
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	for _, name := range []string{"b", "c", "a", "b"} {
+		_, err := f.TreeAddByPath(d, treeID, AttributeFilename, nil,
+			[]KeyValue{{Key: AttributeFilename, Value: []byte(name)}})
+		require.NoError(t, err)
+	}
+
+	checkSorted := func(t *testing.T) {
+		nodes, err := f.TreeSortedByFilename(cid, treeID, RootID, "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(nodes))
+		for i, name := range []string{"a", "b", "b", "c"} {
+			require.Equal(t, name, string(nodes[i].Meta.GetAttr(AttributeFilename)))
+			require.EqualValues(t, RootID, nodes[i].ParentID)
+		}
+		require.Less(t, nodes[1].ID, nodes[2].ID)
+
+		// Nodes with the same filename are returned on the same page.
+		nodes, err = f.TreeSortedByFilename(cid, treeID, RootID, "a", 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(nodes))
+		require.Equal(t, "b", string(nodes[0].Meta.GetAttr(AttributeFilename)))
+		require.Equal(t, "b", string(nodes[1].Meta.GetAttr(AttributeFilename)))
+	}
+
+	// Emulate the database created before the index was introduced.
+	err := f.(*boltForest).db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(bucketName(cid, treeID)).Bucket(dataBucket)
+
+		var keys [][]byte
+		c := b.Cursor()
+		for k, _ := c.Seek([]byte{'f'}); len(k) != 0 && k[0] == 'f'; k, _ = c.Next() {
+			keys = append(keys, k)
+		}
+		for i := range keys {
+			if err := b.Delete(keys[i]); err != nil {
+				return err
+			}
+		}
+		return b.Delete(versionKey)
+	})
+	require.NoError(t, err)
+
+	t.Run("without index", checkSorted)
+
+	// Index is not built in the read-only mode.
+	require.NoError(t, f.Close())
+	require.NoError(t, f.Open(true))
+	require.NoError(t, f.Init())
+	t.Run("read-only", checkSorted)
+
+	require.NoError(t, f.Close())
+	require.NoError(t, f.Open(false))
+	require.NoError(t, f.Init())
+	t.Run("with index", checkSorted)
+}
+
+func TestForest_TreeBatch(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeBatch(t, providers[i].construct)
+		})
+	}
+}
+
+func testForestTreeBatch(t *testing.T, constructor func(t testing.TB, _ ...Option) Forest) {
+	s := constructor(t)
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	lm, err := s.TreeAddByPath(d, treeID, AttributeFilename, []string{"dir"},
+		[]KeyValue{{Key: AttributeFilename, Value: []byte("old")}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(lm))
+	dir, old := lm[0].Child, lm[1].Child
+
+	t.Run("invalid attribute", func(t *testing.T) {
+		_, err := s.TreeBatch(d, treeID, []BatchOperation{
+			{Move: Move{Parent: TrashID, Child: old}},
+			{Attr: AttributeVersion, Path: []string{"a"}},
+		})
+		require.ErrorIs(t, err, ErrNotPathAttribute)
+
+		_, parent, err := s.TreeGetMeta(cid, treeID, old)
+		require.NoError(t, err)
+		require.Equal(t, dir, parent, "batch must not be applied partially")
+	})
+
+	meta := Meta{Items: []KeyValue{{Key: AttributeFilename, Value: []byte("new")}}}
+	res, err := s.TreeBatch(d, treeID, []BatchOperation{
+		{Move: Move{Parent: dir, Meta: meta}},
+		{Attr: AttributeFilename, Path: []string{"other", "sub"}, Move: Move{Meta: meta}},
+		{Move: Move{Parent: TrashID, Child: old}},
+		{Move: Move{Parent: RootID, Child: dir, Meta: Meta{Items: []KeyValue{{Key: AttributeFilename, Value: []byte("renamed")}}}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(res))
+	require.Equal(t, 1, len(res[0]))
+	require.Equal(t, 3, len(res[1]))
+	require.Equal(t, []Move{{Parent: TrashID, Child: old, Meta: Meta{Time: res[2][0].Time}}}, res[2])
+	require.Equal(t, dir, res[3][0].Child)
+
+	var ops []*Move
+	for i := range res {
+		for j := range res[i] {
+			if len(ops) != 0 {
+				require.Less(t, ops[len(ops)-1].Time, res[i][j].Time)
+			}
+			ops = append(ops, &res[i][j])
+		}
+	}
+
+	nodes, err := s.TreeGetByPath(cid, treeID, AttributeFilename, []string{"renamed", "new"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []Node{res[0][0].Child}, nodes)
+
+	nodes, err = s.TreeGetByPath(cid, treeID, AttributeFilename, []string{"other", "sub", "new"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []Node{res[1][2].Child}, nodes)
+
+	t.Run("apply batch", func(t *testing.T) {
+		r := constructor(t)
+		for i := range lm {
+			require.NoError(t, r.TreeApply(d, treeID, &lm[i], false))
+		}
+
+		// Operations order must not matter.
+		ops[0], ops[len(ops)-1] = ops[len(ops)-1], ops[0]
+		require.NoError(t, r.TreeApplyBatch(d, treeID, ops))
+
+		for _, id := range []Node{dir, old, res[0][0].Child, res[1][0].Child, res[1][1].Child, res[1][2].Child} {
+			expectedMeta, expectedParent, err := s.TreeGetMeta(cid, treeID, id)
+			require.NoError(t, err)
+			actualMeta, actualParent, err := r.TreeGetMeta(cid, treeID, id)
+			require.NoError(t, err)
+			require.Equal(t, expectedParent, actualParent)
+			require.Equal(t, expectedMeta, actualMeta)
+		}
+	})
+}
+
+func TestForest_TreeLogDigest(t *testing.T) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	ops := prepareRandomTree(10, 20)
+
+	forests := make([]Forest, len(providers))
+	for i := range providers {
+		forests[i] = providers[i].construct(t)
+		for j := range ops {
+			require.NoError(t, forests[i].TreeApply(d, treeID, &ops[len(ops)-j-1], false))
+		}
+	}
+
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			s := forests[i]
+
+			_, err := s.TreeLogDigest(cidtest.ID(), treeID, 0, math.MaxUint64)
+			require.ErrorIs(t, err, ErrTreeNotFound)
+
+			full, err := s.TreeLogDigest(cid, treeID, 0, math.MaxUint64)
+			require.NoError(t, err)
+			require.Equal(t, uint64(len(ops)), full.Count)
+			require.Equal(t, ops[len(ops)-1].Time, full.Last)
+
+			empty, err := s.TreeLogDigest(cid, treeID, ops[len(ops)-1].Time+1, math.MaxUint64)
+			require.NoError(t, err)
+			require.Equal(t, LogDigest{}, empty)
+
+			mid := ops[len(ops)/2].Time
+			left, err := s.TreeLogDigest(cid, treeID, 0, mid)
+			require.NoError(t, err)
+			right, err := s.TreeLogDigest(cid, treeID, mid, math.MaxUint64)
+			require.NoError(t, err)
+			require.Equal(t, uint64(len(ops)/2), left.Count)
+			require.Equal(t, full.Count, left.Count+right.Count)
+			require.Equal(t, full.Size, left.Size+right.Size)
+			require.NotEqual(t, left.Hash, right.Hash)
+
+			// Digests must not depend on the implementation.
+			other, err := forests[(i+1)%len(forests)].TreeLogDigest(cid, treeID, 0, mid)
+			require.NoError(t, err)
+			require.Equal(t, left, other)
+		})
+	}
+}
+
+func TestForest_PathAttributes(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestPathAttributes(t, providers[i].construct(t, WithPathAttributes("version", []string{"Key"})))
+		})
+	}
+}
+
+func testForestPathAttributes(t *testing.T, s Forest) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+
+	meta := []KeyValue{{Key: "Key", Value: []byte("leaf")}, {Key: "Other", Value: []byte("x")}}
+	lm, err := s.TreeAddByPath(d, "version", "Key", []string{"a", "b"}, meta)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(lm))
+
+	nodes, err := s.TreeGetByPath(cid, "version", "Key", []string{"a", "b", "leaf"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []Node{lm[2].Child}, nodes)
+
+	nodes, err = s.TreeGetByPath(cid, "version", "Key", []string{"a"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []Node{lm[0].Child}, nodes)
+
+	_, err = s.TreeAddByPath(d, "version", "Other", []string{"a"}, meta)
+	require.ErrorIs(t, err, ErrNotPathAttribute)
+
+	_, err = s.TreeAddByPath(d, "system", "Key", []string{"a"}, meta)
+	require.ErrorIs(t, err, ErrNotPathAttribute)
+}
+
+func TestBoltForest_BuildPathIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	open := func(opts ...Option) ForestStorage {
+		f := NewBoltForest(append([]Option{WithPath(path), WithMaxBatchSize(1)}, opts...)...)
+		require.NoError(t, f.Open(false))
+		require.NoError(t, f.Init())
+		return f
+	}
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	f := open()
+	meta := []KeyValue{{Key: AttributeFilename, Value: []byte("file")}, {Key: "Key", Value: []byte("leaf")}}
+	lm, err := f.TreeAddByPath(d, treeID, AttributeFilename, []string{"dir"}, meta)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(lm))
+	require.NoError(t, f.Close())
+
+	f = open(WithPathAttributes(treeID, []string{"Key", AttributeFilename}))
+	nodes, err := f.TreeGetByPath(cid, treeID, "Key", []string{"leaf"}, false)
+	require.NoError(t, err)
+	require.Empty(t, nodes, "leaf is not in the root")
+
+	m, err := f.TreeMove(d, treeID, &Move{Parent: RootID, Child: lm[1].Child, Meta: Meta{Items: meta}})
+	require.NoError(t, err)
+	nodes, err = f.TreeGetByPath(cid, treeID, "Key", []string{"leaf"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []Node{m.Child}, nodes)
+
+	nodes, err = f.TreeGetByPath(cid, treeID, AttributeFilename, []string{"file"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []Node{m.Child}, nodes)
+	require.NoError(t, f.Close())
+
+	// Index of the attributes which are not configured anymore is removed.
+	f = open()
+	_, err = f.TreeGetByPath(cid, treeID, "Key", []string{"leaf"}, false)
+	require.ErrorIs(t, err, ErrNotPathAttribute)
+	err = f.(*boltForest).db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(bucketName(cid, treeID)).Bucket(dataBucket)
+		require.Nil(t, b.Get(pathAttrsKey))
+
+		k, _ := b.Cursor().Seek(internalKey(nil, "Key", "", 0, 0)[:3+len("Key")])
+		require.False(t, bytes.HasPrefix(k, internalKey(nil, "Key", "", 0, 0)[:3+len("Key")]))
+		return nil
+	})
+	require.NoError(t, err)
+
+	nodes, err = f.TreeGetByPath(cid, treeID, AttributeFilename, []string{"file"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []Node{m.Child}, nodes)
+	require.NoError(t, f.Close())
+}
+
 func TestForest_TreeExists(t *testing.T) {
 	for i := range providers {
 		t.Run(providers[i].name, func(t *testing.T) {
@@ -1029,4 +1592,14 @@ func testTreeGetTrees(t *testing.T, s Forest) {
 
 		require.ElementsMatch(t, treeIDs[cid], trees)
 	}
+
+	all := make(map[cidSDK.ID][]string, len(cids))
+	require.NoError(t, s.(ForestStorage).IterateTrees(func(cid cidSDK.ID, treeID string) error {
+		all[cid] = append(all[cid], treeID)
+		return nil
+	}))
+	require.Len(t, all, len(cids))
+	for _, cid := range cids {
+		require.ElementsMatch(t, treeIDs[cid], all[cid])
+	}
 }
@@ -1,5 +1,7 @@
 package pilorama
 
+import "sort"
+
 // nodeInfo couples parent and metadata.
 type nodeInfo struct {
 	Parent Node
@@ -16,6 +18,11 @@ type move struct {
 type state struct {
 	operations []move
 	tree
+
+	// height is the snapshot height, operations below it are not stored.
+	height Timestamp
+	// snapshot contains the tree state at height sorted by node ID.
+	snapshot []SnapshotNode
 }
 
 // newState constructs new empty tree.
@@ -47,7 +54,7 @@ func (s *state) undo(op *move) {
 				return
 			}
 		}
-		s.tree.childMap[op.Old.Parent] = append(oldChildren, op.Child)
+		s.tree.insertChild(op.Old.Parent, op.Child)
 	} else {
 		delete(s.tree.infoMap, op.Child)
 	}
@@ -56,6 +63,10 @@ func (s *state) undo(op *move) {
 // Apply puts op in log at a proper position, re-applies all subsequent operations
 // from log and changes s in-place.
 func (s *state) Apply(op *Move) error {
+	if op.Time < s.height {
+		return ErrBelowSnapshot
+	}
+
 	var index int
 	for index = len(s.operations); index > 0; index-- {
 		if s.operations[index-1].Time <= op.Time {
@@ -111,11 +122,62 @@ func (s *state) do(op *Move) move {
 	p.Meta = op.Meta
 	p.Parent = op.Parent
 	s.tree.infoMap[op.Child] = p
-	s.tree.childMap[op.Parent] = append(s.tree.childMap[op.Parent], op.Child)
+	s.tree.insertChild(op.Parent, op.Child)
 
 	return lm
 }
 
+// compact saves the tree state at height and removes all the operations below it.
+func (s *state) compact(height Timestamp) {
+	index := sort.Search(len(s.operations), func(i int) bool {
+		return s.operations[i].Time >= height
+	})
+	for i := len(s.operations) - 1; i >= index; i-- {
+		s.undo(&s.operations[i])
+	}
+
+	s.snapshot = make([]SnapshotNode, 0, len(s.infoMap))
+	for id, info := range s.infoMap {
+		s.snapshot = append(s.snapshot, SnapshotNode{
+			ID:        id,
+			Parent:    info.Parent,
+			Timestamp: info.Meta.Time,
+			Meta:      info.Meta,
+		})
+	}
+	sort.Slice(s.snapshot, func(i, j int) bool {
+		return s.snapshot[i].ID < s.snapshot[j].ID
+	})
+
+	s.operations = append([]move(nil), s.operations[index:]...)
+	for i := range s.operations {
+		s.operations[i] = s.do(&s.operations[i].Move)
+	}
+	s.height = height
+}
+
+// applySnapshot puts snapshot nodes in the tree.
+func (s *state) applySnapshot(nodes []SnapshotNode) {
+	for _, n := range nodes {
+		if info, ok := s.infoMap[n.ID]; ok {
+			s.removeChild(n.ID, info.Parent)
+		}
+		s.infoMap[n.ID] = nodeInfo{Parent: n.Parent, Meta: n.Meta}
+		s.insertChild(n.Parent, n.ID)
+
+		i := sort.Search(len(s.snapshot), func(i int) bool {
+			return s.snapshot[i].ID >= n.ID
+		})
+		if i < len(s.snapshot) && s.snapshot[i].ID == n.ID {
+			s.snapshot[i] = n
+		} else {
+			s.snapshot = append(s.snapshot, SnapshotNode{})
+			copy(s.snapshot[i+1:], s.snapshot[i:])
+			s.snapshot[i] = n
+		}
+	}
+}
+
 func (s *state) removeChild(child, parent Node) {
 	oldChildren := s.tree.childMap[parent]
 	for i := range oldChildren {
@@ -128,11 +190,46 @@ func (s *state) removeChild(child, parent Node) {
 
 func (s *state) timestamp(pos, size int) Timestamp {
 	if len(s.operations) == 0 {
+		if s.height != 0 {
+			return nextTimestamp(s.height-1, uint64(pos), uint64(size))
+		}
 		return nextTimestamp(0, uint64(pos), uint64(size))
 	}
 	return nextTimestamp(s.operations[len(s.operations)-1].Time, uint64(pos), uint64(size))
 }
 
+// addByPath adds the node with meta m by path, creating the missing intermediate nodes.
+func (s *state) addByPath(d CIDDescriptor, attr string, path []string, m []KeyValue) []Move {
+	i, node := s.getPathPrefix(attr, path)
+	lm := make([]Move, len(path)-i+1)
+	for j := i; j < len(path); j++ {
+		op := s.do(&Move{
+			Parent: node,
+			Meta: Meta{
+				Time:  s.timestamp(d.Position, d.Size),
+				Items: []KeyValue{{Key: attr, Value: []byte(path[j])}}},
+			Child: s.findSpareID(),
+		})
+		lm[j-i] = op.Move
+		node = op.Child
+		s.operations = append(s.operations, op)
+	}
+
+	mCopy := make([]KeyValue, len(m))
+	copy(mCopy, m)
+	op := s.do(&Move{
+		Parent: node,
+		Meta: Meta{
+			Time:  s.timestamp(d.Position, d.Size),
+			Items: mCopy,
+		},
+		Child: s.findSpareID(),
+	})
+	lm[len(lm)-1] = op.Move
+	s.operations = append(s.operations, op)
+	return lm
+}
+
 func (s *state) findSpareID() Node {
 	id := uint64(1)
 	for _, ok := s.infoMap[id]; ok; _, ok = s.infoMap[id] {
@@ -142,6 +239,7 @@ func (s *state) findSpareID() Node {
 }
 
 // tree is a mapping from the child nodes to their parent and metadata.
+// Children of every node are sorted by AttributeFilename and then by ID.
 type tree struct {
 	infoMap  map[Node]nodeInfo
 	childMap map[Node][]Node
@@ -154,6 +252,22 @@ func newTree() *tree {
 	}
 }
 
+// insertChild puts child to the list of parent children preserving the order.
+// The child must already be present in infoMap.
+func (t tree) insertChild(parent, child Node) {
+	children := t.childMap[parent]
+	name := string(t.infoMap[child].Meta.GetAttr(AttributeFilename))
+	i := sort.Search(len(children), func(i int) bool {
+		n := string(t.infoMap[children[i]].Meta.GetAttr(AttributeFilename))
+		return name < n || name == n && child <= children[i]
+	})
+
+	children = append(children, 0)
+	copy(children[i+1:], children[i:])
+	children[i] = child
+	t.childMap[parent] = children
+}
+
 // isAncestor returns true if parent is an ancestor of a child.
 // For convenience, also return true if parent == child.
 func (t tree) isAncestor(parent, child Node) bool {
@@ -19,6 +19,12 @@ type Forest interface {
 	// TreeApply applies replicated operation from another node.
 	// If background is true, TreeApply will first check whether an operation exists.
 	TreeApply(d CIDDescriptor, treeID string, m *Move, backgroundSync bool) error
+	// TreeBatch performs multiple operations atomically: either all of them are applied or none.
+	// Operations get increasing timestamps in the order they are specified.
+	// Returns logged operations for each of the batch operations.
+	TreeBatch(d CIDDescriptor, treeID string, ops []BatchOperation) ([][]Move, error)
+	// TreeApplyBatch applies multiple replicated operations from another node atomically.
+	TreeApplyBatch(d CIDDescriptor, treeID string, ms []*Move) error
 	// TreeGetByPath returns all nodes corresponding to the path.
 	// The path is constructed by descending from the root using the values of the
 	// AttributeFilename in meta.
@@ -31,9 +37,20 @@ type Forest interface {
 	// TreeGetChildren returns children of the node with the specified ID. The order is arbitrary.
 	// Should return ErrTreeNotFound if the tree is not found, and empty result if the node is not in the tree.
 	TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node) ([]uint64, error)
+	// TreeSortedByFilename returns children of the node with the specified ID sorted by
+	// the value of AttributeFilename and then by ID. Nodes without the attribute go first.
+	// If start is not empty, only the children with a greater filename are returned.
+	// If count is positive, at most count children are returned, but the children having
+	// the same filename as the last returned one are never omitted, so that the filename
+	// can be used as start for the next call.
+	// Should return ErrTreeNotFound if the tree is not found, and empty result if the node is not in the tree.
+	TreeSortedByFilename(cid cidSDK.ID, treeID string, nodeID Node, start string, count int) ([]NodeInfo, error)
 	// TreeGetOpLog returns first log operation stored at or above the height.
 	// In case no such operation is found, empty Move and nil error should be returned.
 	TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error)
+	// TreeLogDigest returns the digest of the log operations with timestamps in [start, end) range.
+	// Should return ErrTreeNotFound if the tree is not found.
+	TreeLogDigest(cid cidSDK.ID, treeID string, start, end uint64) (LogDigest, error)
 	// TreeDrop drops a tree from the database.
 	// If the tree is not found, ErrTreeNotFound should be returned.
 	// In case of empty treeID drops all trees related to container.
@@ -44,6 +61,23 @@ type Forest interface {
 	// TreeExists checks if a tree exists locally.
 	// If the tree is not found, false and a nil error should be returned.
 	TreeExists(cid cidSDK.ID, treeID string) (bool, error)
+	// TreeCompact takes a snapshot of the tree state at the specified height and removes
+	// all the operations below it from the log. The last operation is never removed,
+	// so the height is capped by its timestamp. Operations below the snapshot height
+	// which are not in the log are rejected by TreeApply and TreeApplyBatch with ErrBelowSnapshot.
+	// The height must not exceed the height every container node has synchronized the tree up to.
+	// Should return ErrTreeNotFound if the tree is not found.
+	TreeCompact(cid cidSDK.ID, treeID string, height uint64) error
+	// TreeGetSnapshot returns the height of the last snapshot and at most count snapshot nodes
+	// with ID not less than start in ascending order. Zero height is returned if the tree
+	// has never been compacted.
+	// Should return ErrTreeNotFound if the tree is not found.
+	TreeGetSnapshot(cid cidSDK.ID, treeID string, start Node, count int) (uint64, []SnapshotNode, error)
+	// TreeApplySnapshot puts the snapshot nodes received from another node to the tree.
+	// The snapshot can be applied in multiple calls with the same height. If the height differs
+	// from the height of the stored snapshot, the tree state is reset first.
+	// Should return ErrTreeNotEmpty if the tree log contains any operations.
+	TreeApplySnapshot(d CIDDescriptor, treeID string, height uint64, nodes []SnapshotNode) error
 }
 
 type ForestStorage interface {
@@ -53,6 +87,8 @@ type ForestStorage interface {
 	Open(bool) error
 	Close() error
 	SetMode(m mode.Mode) error
+	// IterateTrees calls h for every tree in the storage until h returns an error.
+	IterateTrees(h func(cid cidSDK.ID, treeID string) error) error
 	Forest
 }
 
@@ -0,0 +1,48 @@
+package pilorama
+
+import (
+	"strings"
+
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"go.etcd.io/bbolt"
+)
+
+// IterateTrees implements the ForestStorage interface.
+func (t *boltForest) IterateTrees(h func(cid cidSDK.ID, treeID string) error) error {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	return t.db.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+			if len(name) < 32 {
+				return nil
+			}
+
+			var cid cidSDK.ID
+			if err := cid.Decode(name[:32]); err != nil {
+				return err
+			}
+			return h(cid, string(name[32:]))
+		})
+	})
+}
+
+// IterateTrees implements the ForestStorage interface.
+func (f *memoryForest) IterateTrees(h func(cid cidSDK.ID, treeID string) error) error {
+	for k := range f.treeMap {
+		cidAndTree := strings.SplitN(k, "/", 2)
+
+		var cid cidSDK.ID
+		if err := cid.DecodeString(cidAndTree[0]); err != nil {
+			return err
+		}
+		if err := h(cid, cidAndTree[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package pilorama
 
 import (
 	"io/fs"
+	"sort"
 	"time"
 )
 
@@ -13,6 +14,9 @@ type cfg struct {
 	noSync        bool
 	maxBatchDelay time.Duration
 	maxBatchSize  int
+	// pathAttrs maps tree ID to the attributes indexed for `*ByPath` methods
+	// in addition to AttributeFilename.
+	pathAttrs map[string][]string
 }
 
 func WithPath(path string) Option {
@@ -44,3 +48,35 @@ func WithMaxBatchSize(size int) Option {
 		c.maxBatchSize = size
 	}
 }
+
+// WithPathAttributes returns option to index additional attributes
+// for the `*ByPath` methods of the trees with the specified ID.
+// AttributeFilename is always indexed.
+func WithPathAttributes(treeID string, attrs []string) Option {
+	return func(c *cfg) {
+		if c.pathAttrs == nil {
+			c.pathAttrs = make(map[string][]string)
+		}
+		c.pathAttrs[treeID] = append(c.pathAttrs[treeID], attrs...)
+	}
+}
+
+// pathAttributes returns sorted unique attributes to index for the tree
+// with the specified ID, AttributeFilename is not included.
+func (c *cfg) pathAttributes(treeID string) []string {
+	var attrs []string
+	for _, a := range c.pathAttrs[treeID] {
+		if a != AttributeFilename {
+			attrs = append(attrs, a)
+		}
+	}
+	sort.Strings(attrs)
+
+	for i := 1; i < len(attrs); i++ {
+		if attrs[i] == attrs[i-1] {
+			attrs = append(attrs[:i], attrs[i+1:]...)
+			i--
+		}
+	}
+	return attrs
+}
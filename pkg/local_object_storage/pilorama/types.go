@@ -1,6 +1,7 @@
 package pilorama
 
 import (
+	"crypto/sha256"
 	"math"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
@@ -35,6 +36,46 @@ type Move struct {
 	Child Node
 }
 
+// BatchOperation represents a single operation of an atomic batch, see Forest.TreeBatch.
+// If Attr is empty, Move is performed as with TreeMove. Otherwise, the node with Move.Meta
+// is added by Path as with TreeAddByPath, Move.Parent and Move.Child are ignored.
+type BatchOperation struct {
+	Move
+	// Attr is the attribute to build the path with.
+	Attr string
+	// Path is the path to add the node by.
+	Path []string
+}
+
+// NodeInfo groups the information about a tree node.
+type NodeInfo struct {
+	ID       Node
+	Meta     Meta
+	ParentID Node
+}
+
+// LogDigest describes the operations of the tree log in some timestamp range.
+// The digest of an empty range is a zero value.
+type LogDigest struct {
+	// Count is the amount of operations in the range.
+	Count uint64
+	// Size is the total size of the serialized operations.
+	Size uint64
+	// Last is the timestamp of the last operation in the range.
+	Last Timestamp
+	// Hash is the SHA-256 hash of the operations in ascending timestamp order.
+	Hash [sha256.Size]byte
+}
+
+// SnapshotNode represents the state of a single node in the tree snapshot.
+type SnapshotNode struct {
+	ID     Node
+	Parent Node
+	// Timestamp is the time of the first appearance of the node in the tree.
+	Timestamp Timestamp
+	Meta      Meta
+}
+
 const (
 	// RootID represents the ID of a root node.
 	RootID = 0
@@ -46,12 +87,27 @@ var (
 	// ErrTreeNotFound is returned when the requested tree is not found.
 	ErrTreeNotFound = logicerr.New("tree not found")
 	// ErrNotPathAttribute is returned when the path is trying to be constructed with a non-internal
-	// attribute. Currently the only attribute allowed is AttributeFilename.
+	// attribute. AttributeFilename is always allowed, other attributes are configured per tree,
+	// see WithPathAttributes.
 	ErrNotPathAttribute = logicerr.New("attribute can't be used in path construction")
+	// ErrTreeNotEmpty is returned when the snapshot is applied to the tree which already has some operations.
+	ErrTreeNotEmpty = logicerr.New("tree is not empty")
+	// ErrBelowSnapshot is returned when the operation to apply is below the tree snapshot height.
+	// Such operation can't be applied without the compacted log, so the tree must be resynchronized.
+	ErrBelowSnapshot = logicerr.New("operation is below the tree snapshot height")
 )
 
-// isAttributeInternal returns true iff key can be used in `*ByPath` methods.
+// isAttributeInternal returns true iff key can be used in `*ByPath` methods
+// of the tree with the specified additional path attributes.
 // For such attributes an additional index is maintained in the database.
-func isAttributeInternal(key string) bool {
-	return key == AttributeFilename
+func isAttributeInternal(attrs []string, key string) bool {
+	if key == AttributeFilename {
+		return true
+	}
+	for i := range attrs {
+		if attrs[i] == key {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,147 @@
+package shard
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// CompactBlobovniczasPrm groups the parameters of CompactBlobovniczas operation.
+type CompactBlobovniczasPrm struct {
+	threshold float64
+}
+
+// SetThreshold sets the ratio of the stored objects size to the blobovnicza size
+// below which the blobovnicza is compacted. Zero value means the threshold
+// from the shard configuration.
+func (p *CompactBlobovniczasPrm) SetThreshold(v float64) {
+	p.threshold = v
+}
+
+// CompactBlobovniczasRes groups the resulting values of CompactBlobovniczas operation.
+type CompactBlobovniczasRes struct {
+	compacted uint64
+	moved     uint64
+	reclaimed uint64
+}
+
+// Compacted returns the number of removed blobovniczas.
+func (r CompactBlobovniczasRes) Compacted() uint64 {
+	return r.compacted
+}
+
+// Moved returns the number of objects moved to other blobovniczas.
+func (r CompactBlobovniczasRes) Moved() uint64 {
+	return r.moved
+}
+
+// Reclaimed returns the amount of the freed disk space in bytes.
+func (r CompactBlobovniczasRes) Reclaimed() uint64 {
+	return r.reclaimed
+}
+
+// CompactBlobovniczas rewrites under-utilized blobovniczas of the shard:
+// live objects are moved to the active blobovniczas, their storage IDs
+// are updated in the metabase and the old files are removed.
+// Objects which are removed or marked for removal in the metabase are not moved.
+func (s *Shard) CompactBlobovniczas(prm CompactBlobovniczasPrm) (CompactBlobovniczasRes, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return CompactBlobovniczasRes{}, ErrReadOnlyMode
+	}
+	if s.info.Mode.NoMetabase() {
+		return CompactBlobovniczasRes{}, ErrDegradedMode
+	}
+
+	threshold := prm.threshold
+	if threshold <= 0 {
+		threshold = s.compactionThreshold
+	}
+
+	res, err := s.blobStor.CompactBlobovniczas(blobovniczatree.CompactPrm{
+		Threshold: threshold,
+		Filter:    s.keepCompacted,
+		Moved:     s.updateCompacted,
+	})
+	return CompactBlobovniczasRes{
+		compacted: res.Compacted,
+		moved:     res.Moved,
+		reclaimed: res.Reclaimed,
+	}, err
+}
+
+// keepCompacted returns true if the object from the compacted blobovnicza
+// must be moved to another one.
+func (s *Shard) keepCompacted(addr oid.Address, storageID []byte) (bool, error) {
+	var sPrm meta.StorageIDPrm
+	sPrm.SetAddress(addr)
+
+	sRes, err := s.metaBase.StorageID(sPrm)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(sRes.StorageID(), storageID) {
+		// The object is not tracked by the metabase or has another copy.
+		return false, nil
+	}
+
+	var ePrm meta.ExistsPrm
+	ePrm.SetAddress(addr)
+
+	_, err = s.metaBase.Exists(ePrm)
+	if err != nil {
+		if IsErrNotFound(err) || IsErrRemoved(err) {
+			return false, nil
+		}
+		if !IsErrObjectExpired(err) {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// updateCompacted updates the storage ID of the moved object.
+func (s *Shard) updateCompacted(addr oid.Address, storageID []byte) error {
+	var prm meta.UpdateStorageIDPrm
+	prm.SetAddress(addr)
+	prm.SetStorageID(storageID)
+
+	_, err := s.metaBase.UpdateStorageID(prm)
+	if err != nil && (IsErrNotFound(err) || IsErrRemoved(err)) {
+		// Removed concurrently, the moved copy is left until the next compaction.
+		s.log.Debug("object was removed during blobovnicza compaction",
+			zap.Stringer("address", addr),
+			zap.String("error", err.Error()))
+		return nil
+	}
+	return err
+}
+
+// compactBlobovniczas is a background blobovnicza compaction job.
+func (s *Shard) compactBlobovniczas() {
+	if s.GetMode() != mode.ReadWrite {
+		return
+	}
+
+	res, err := s.CompactBlobovniczas(CompactBlobovniczasPrm{})
+	if err != nil {
+		if !errors.Is(err, ErrReadOnlyMode) && !errors.Is(err, ErrDegradedMode) {
+			s.log.Warn("blobovnicza compaction failed", zap.String("error", err.Error()))
+		}
+		return
+	}
+
+	if res.compacted != 0 {
+		s.log.Info("blobovniczas compacted",
+			zap.Uint64("compacted", res.compacted),
+			zap.Uint64("moved", res.moved),
+			zap.Uint64("reclaimed", res.reclaimed))
+	}
+}
@@ -11,7 +11,8 @@ type ContainerSizePrm struct {
 }
 
 type ContainerSizeRes struct {
-	size uint64
+	size  uint64
+	count uint64
 }
 
 func (p *ContainerSizePrm) SetContainerID(cnr cid.ID) {
@@ -22,6 +23,11 @@ func (r ContainerSizeRes) Size() uint64 {
 	return r.size
 }
 
+// ObjectCount returns the number of available regular objects of the container.
+func (r ContainerSizeRes) ObjectCount() uint64 {
+	return r.count
+}
+
 func (s *Shard) ContainerSize(prm ContainerSizePrm) (ContainerSizeRes, error) {
 	s.m.RLock()
 	defer s.m.RUnlock()
@@ -35,7 +41,13 @@ func (s *Shard) ContainerSize(prm ContainerSizePrm) (ContainerSizeRes, error) {
 		return ContainerSizeRes{}, fmt.Errorf("could not get container size: %w", err)
 	}
 
+	count, err := s.metaBase.ContainerObjectCount(prm.cnr)
+	if err != nil {
+		return ContainerSizeRes{}, fmt.Errorf("could not get container object count: %w", err)
+	}
+
 	return ContainerSizeRes{
-		size: size,
+		size:  size,
+		count: count,
 	}, nil
 }
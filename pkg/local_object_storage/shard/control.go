@@ -133,12 +133,15 @@ func (s *Shard) Init() error {
 	}
 
 	s.updateMetrics()
+	s.dropStaleEvacuationCheckpoint()
 
 	s.gc = &gc{
-		gcCfg:       &s.gcCfg,
-		remover:     s.removeGarbage,
-		stopChannel: make(chan struct{}),
-		eventChan:   make(chan Event),
+		gcCfg:         &s.gcCfg,
+		remover:       s.removeGarbage,
+		compactor:     s.compactBlobovniczas,
+		stopChannel:   make(chan struct{}),
+		stopCompactor: make(chan struct{}),
+		eventChan:     make(chan Event),
 		mEventHandler: map[eventType]*eventHandlers{
 			eventNewEpoch: {
 				cancelFunc: func() {},
@@ -157,7 +160,14 @@ func (s *Shard) Init() error {
 }
 
 func (s *Shard) refillMetabase() error {
-	err := s.metaBase.Reset()
+	return s.fillMetabase(s.metaBase, nil)
+}
+
+// fillMetabase resets db and fills it with the objects from the blobstor.
+// If onObject is not nil, it is called after every processed object,
+// non-nil error stops the iteration.
+func (s *Shard) fillMetabase(db *meta.DB, onObject func() error) error {
+	err := db.Reset()
 	if err != nil {
 		return fmt.Errorf("could not reset metabase: %w", err)
 	}
@@ -197,7 +207,7 @@ func (s *Shard) refillMetabase() error {
 			inhumePrm.SetTombstoneAddress(tombAddr)
 			inhumePrm.SetAddresses(tombMembers...)
 
-			_, err = s.metaBase.Inhume(inhumePrm)
+			_, err = db.Inhume(inhumePrm)
 			if err != nil {
 				return fmt.Errorf("could not inhume objects: %w", err)
 			}
@@ -212,7 +222,7 @@ func (s *Shard) refillMetabase() error {
 
 			cnr, _ := obj.ContainerID()
 			id, _ := obj.ID()
-			err = s.metaBase.Lock(cnr, id, locked)
+			err = db.Lock(cnr, id, locked)
 			if err != nil {
 				return fmt.Errorf("could not lock objects: %w", err)
 			}
@@ -222,18 +232,21 @@ func (s *Shard) refillMetabase() error {
 		mPrm.SetObject(obj)
 		mPrm.SetStorageID(descriptor)
 
-		_, err := s.metaBase.Put(mPrm)
+		_, err := db.Put(mPrm)
 		if err != nil && !meta.IsErrRemoved(err) && !errors.Is(err, meta.ErrObjectIsExpired) {
 			return err
 		}
 
+		if onObject != nil {
+			return onObject()
+		}
 		return nil
 	})
 	if err != nil {
 		return fmt.Errorf("could not put objects to the meta: %w", err)
 	}
 
-	err = s.metaBase.SyncCounters()
+	err = db.SyncCounters()
 	if err != nil {
 		return fmt.Errorf("could not sync object counters: %w", err)
 	}
@@ -243,6 +256,8 @@ func (s *Shard) refillMetabase() error {
 
 // Close releases all Shard's components.
 func (s *Shard) Close() error {
+	s.stopMetabaseRebuild()
+
 	components := []interface{ Close() error }{}
 
 	if s.pilorama != nil {
@@ -284,6 +299,10 @@ func (s *Shard) Reload(opts ...Option) error {
 	s.m.Lock()
 	defer s.m.Unlock()
 
+	if s.isMetabaseRebuildRunning() {
+		return ErrMetabaseRebuildInProgress
+	}
+
 	ok, err := s.metaBase.Reload(c.metaOpts...)
 	if err != nil {
 		if errors.Is(err, meta.ErrDegradedMode) {
@@ -1,22 +1,29 @@
 package shard
 
 import (
-	"encoding/binary"
 	"io"
 	"os"
 
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/klauspost/compress/zstd"
 )
 
-var dumpMagic = []byte("NEOF")
-
 // DumpPrm groups the parameters of Dump operation.
 type DumpPrm struct {
 	path         string
 	stream       io.Writer
 	ignoreErrors bool
+	compress     bool
+	containers   []cid.ID
+	types        []objectSDK.Type
+	nodeKey      []byte
+	epoch        uint64
 }
 
 // WithPath is an Dump option to set the destination path.
@@ -36,6 +43,36 @@ func (p *DumpPrm) WithIgnoreErrors(ignore bool) {
 	p.ignoreErrors = ignore
 }
 
+// WithCompression is a Dump option to compress the dump with zstd.
+// Compressed dumps are detected by Restore automatically.
+func (p *DumpPrm) WithCompression(compress bool) {
+	p.compress = compress
+}
+
+// WithContainers is a Dump option to dump only the objects
+// from the specified containers.
+func (p *DumpPrm) WithContainers(cnrs []cid.ID) {
+	p.containers = cnrs
+}
+
+// WithObjectTypes is a Dump option to dump only the objects
+// of the specified types.
+func (p *DumpPrm) WithObjectTypes(types []objectSDK.Type) {
+	p.types = types
+}
+
+// WithNodeKey is a Dump option to set the public key of the node
+// which is written to the dump header.
+func (p *DumpPrm) WithNodeKey(key []byte) {
+	p.nodeKey = key
+}
+
+// WithEpoch is a Dump option to set the current epoch
+// which is written to the dump header.
+func (p *DumpPrm) WithEpoch(epoch uint64) {
+	p.epoch = epoch
+}
+
 // DumpRes groups the result fields of Dump operation.
 type DumpRes struct {
 	count int
@@ -70,60 +107,154 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 		w = f
 	}
 
-	_, err := w.Write(dumpMagic)
+	if prm.compress {
+		enc, err := zstd.NewWriter(w)
+		if err != nil {
+			return DumpRes{}, err
+		}
+
+		res, err := s.dump(enc, prm)
+		if cErr := enc.Close(); err == nil {
+			err = cErr
+		}
+		return res, err
+	}
+
+	return s.dump(w, prm)
+}
+
+func (s *Shard) dump(w io.Writer, prm DumpPrm) (DumpRes, error) {
+	// The shard is read-only, so the number of objects is known before the dump.
+	// Objects failing to be read in the second pass are skipped if errors are
+	// ignored, so the count is a hint only, the footer contains the actual objects.
+	var count uint64
+	err := s.iterateDump(prm, true, func(oid.Address, []byte) error {
+		count++
+		return nil
+	})
 	if err != nil {
 		return DumpRes{}, err
 	}
 
-	var count int
+	hdr := DumpHeader{
+		NodeKey:     prm.nodeKey,
+		Epoch:       prm.epoch,
+		ObjectCount: count,
+	}
+	if s.info.ID != nil {
+		hdr.ShardID = *s.info.ID
+	}
+
+	dw := &dumpWriter{w: w}
+	if err := dw.writeHeader(hdr); err != nil {
+		return DumpRes{}, err
+	}
 
+	err = s.iterateDump(prm, false, dw.writeRecord)
+	if err != nil {
+		return DumpRes{}, err
+	}
+
+	if err := dw.writeFooter(); err != nil {
+		return DumpRes{}, err
+	}
+
+	return DumpRes{count: len(dw.index)}, nil
+}
+
+// iterateDump calls f for every object matching the dump filters.
+// If lazy is true, object data is read only if it is required by the filters
+// and f may be called with nil data.
+func (s *Shard) iterateDump(prm DumpPrm, lazy bool, f func(oid.Address, []byte) error) error {
 	if s.hasWriteCache() {
 		var iterPrm writecache.IterationPrm
 
 		iterPrm.WithIgnoreErrors(prm.ignoreErrors)
 		iterPrm.WithHandler(func(data []byte) error {
-			var size [4]byte
-			binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-			if _, err := w.Write(size[:]); err != nil {
+			obj := objectSDK.New()
+			if err := obj.Unmarshal(data); err != nil {
+				if prm.ignoreErrors {
+					return nil
+				}
 				return err
 			}
 
-			if _, err := w.Write(data); err != nil {
-				return err
+			if !prm.match(obj) {
+				return nil
 			}
-
-			count++
-			return nil
+			return f(objectCore.AddressOf(obj), data)
 		})
 
 		err := s.writeCache.Iterate(iterPrm)
 		if err != nil {
-			return DumpRes{}, err
+			return err
 		}
 	}
 
 	var pi common.IteratePrm
 	pi.IgnoreErrors = prm.ignoreErrors
-	pi.Handler = func(elem common.IterationElement) error {
-		data := elem.ObjectData
-
-		var size [4]byte
-		binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-		if _, err := w.Write(size[:]); err != nil {
-			return err
+	if lazy && len(prm.types) == 0 {
+		pi.LazyHandler = func(addr oid.Address, _ func() ([]byte, error)) error {
+			if !prm.matchContainer(addr.Container()) {
+				return nil
+			}
+			return f(addr, nil)
 		}
+	} else {
+		pi.Handler = func(elem common.IterationElement) error {
+			if len(prm.types) != 0 {
+				obj := objectSDK.New()
+				if err := obj.Unmarshal(elem.ObjectData); err != nil {
+					if prm.ignoreErrors {
+						return nil
+					}
+					return err
+				}
 
-		if _, err := w.Write(data); err != nil {
-			return err
+				if !prm.match(obj) {
+					return nil
+				}
+			} else if !prm.matchContainer(elem.Address.Container()) {
+				return nil
+			}
+			return f(elem.Address, elem.ObjectData)
 		}
+	}
 
-		count++
-		return nil
+	_, err := s.blobStor.Iterate(pi)
+	return err
+}
+
+// match checks whether the object satisfies the dump filters.
+func (p *DumpPrm) match(obj *objectSDK.Object) bool {
+	cnr, _ := obj.ContainerID()
+	if !p.matchContainer(cnr) {
+		return false
 	}
 
-	if _, err := s.blobStor.Iterate(pi); err != nil {
-		return DumpRes{}, err
+	if len(p.types) == 0 {
+		return true
+	}
+
+	typ := obj.Type()
+	for i := range p.types {
+		if p.types[i] == typ {
+			return true
+		}
 	}
+	return false
+}
 
-	return DumpRes{count: count}, nil
+// matchContainer checks whether the objects from the container satisfy the dump filters.
+func (p *DumpPrm) matchContainer(cnr cid.ID) bool {
+	if len(p.containers) == 0 {
+		return true
+	}
+
+	for i := range p.containers {
+		if p.containers[i].Equals(cnr) {
+			return true
+		}
+	}
+	return false
 }
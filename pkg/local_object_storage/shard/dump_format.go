@@ -0,0 +1,452 @@
+package shard
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Dump format versions.
+//
+// Version 1 is a magic followed by the length-prefixed objects.
+//
+// Version 2 layout (all integers are little-endian, checksums are CRC32-C):
+//
+//	magic "FSDP" | uint32 version | uint32 header size | header | uint32 header checksum
+//	records: uint32 object size | uint32 object checksum | object
+//	footer: uint32 0xFFFFFFFF | uint64 count | count * (address | uint64 offset) | uint32 checksum | uint64 footer offset
+//
+// Header contains length-prefixed (uint16) shard ID and node public key
+// followed by uint64 epoch and uint64 object count. Address in the footer index
+// is a container ID followed by an object ID, offset is the position of the record
+// in the uncompressed dump.
+const (
+	DumpVersion1 uint32 = 1
+	DumpVersion2 uint32 = 2
+)
+
+var (
+	dumpMagicV1 = []byte("NEOF")
+	dumpMagicV2 = []byte("FSDP")
+)
+
+// zstdFrameMagic contains first 4 bytes of the dump compressed with zstd.
+var zstdFrameMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// dumpFooterMarker is written instead of the object size before the footer.
+const dumpFooterMarker = ^uint32(0)
+
+const dumpAddressSize = 64
+
+// maxDumpHeaderSize limits the size of the header to detect the corrupted dumps early.
+const maxDumpHeaderSize = 1 << 16
+
+// DefaultDumpMaxObjectSize is the default limit of the object payload size in the dump.
+// It matches the default MaxObjectSize network setting.
+const DefaultDumpMaxObjectSize = 64 << 20
+
+// dumpObjectHeaderReserve is added to the payload size limit to get the limit
+// of the dump record size. Object header is transferred in a single gRPC message
+// which is limited to 4 MiB by default.
+const dumpObjectHeaderReserve = 4 << 20
+
+var dumpChecksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+var (
+	// ErrInvalidMagic is returned when dump format is invalid.
+	ErrInvalidMagic = logicerr.New("invalid magic")
+	// ErrDumpUnsupportedVersion is returned when the dump version is not supported.
+	ErrDumpUnsupportedVersion = logicerr.New("unsupported dump version")
+	// ErrDumpChecksumMismatch is returned when the dump record or section is corrupted.
+	ErrDumpChecksumMismatch = logicerr.New("dump checksum mismatch")
+	// ErrDumpCorrupted is returned when the dump structure is inconsistent.
+	ErrDumpCorrupted = logicerr.New("dump is corrupted")
+)
+
+// DumpHeader describes the dump. For version 1 dumps only Version is set.
+type DumpHeader struct {
+	Version uint32
+	// ShardID is the ID of the dumped shard.
+	ShardID []byte
+	// NodeKey is the public key of the node the dump was made on.
+	NodeKey []byte
+	// Epoch is the epoch the dump was made at.
+	Epoch uint64
+	// ObjectCount is the number of objects expected in the dump.
+	// It is computed before the objects are written and is a hint only:
+	// if errors are ignored during the dump, fewer objects can be written.
+	// The footer index contains the actual objects.
+	ObjectCount uint64
+}
+
+// DumpIndexEntry is an element of the dump footer index.
+type DumpIndexEntry struct {
+	Address oid.Address
+	// Offset is the position of the record in the uncompressed dump.
+	Offset uint64
+}
+
+// dumpWriter writes the dump in the version 2 format.
+type dumpWriter struct {
+	w      io.Writer
+	offset uint64
+	index  []DumpIndexEntry
+}
+
+func (w *dumpWriter) write(p []byte) error {
+	n, err := w.w.Write(p)
+	w.offset += uint64(n)
+	return err
+}
+
+func (w *dumpWriter) writeHeader(hdr DumpHeader) error {
+	body := make([]byte, 0, 2+len(hdr.ShardID)+2+len(hdr.NodeKey)+16)
+	body = appendUint16(body, uint16(len(hdr.ShardID)))
+	body = append(body, hdr.ShardID...)
+	body = appendUint16(body, uint16(len(hdr.NodeKey)))
+	body = append(body, hdr.NodeKey...)
+	body = appendUint64(body, hdr.Epoch)
+	body = appendUint64(body, hdr.ObjectCount)
+
+	buf := make([]byte, 0, len(dumpMagicV2)+8+len(body)+4)
+	buf = append(buf, dumpMagicV2...)
+	buf = appendUint32(buf, DumpVersion2)
+	buf = appendUint32(buf, uint32(len(body)))
+	buf = append(buf, body...)
+	buf = appendUint32(buf, crc32.Checksum(body, dumpChecksumTable))
+
+	return w.write(buf)
+}
+
+func (w *dumpWriter) writeRecord(addr oid.Address, data []byte) error {
+	w.index = append(w.index, DumpIndexEntry{Address: addr, Offset: w.offset})
+
+	var prefix [8]byte
+	binary.LittleEndian.PutUint32(prefix[:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(prefix[4:], crc32.Checksum(data, dumpChecksumTable))
+	if err := w.write(prefix[:]); err != nil {
+		return err
+	}
+	return w.write(data)
+}
+
+func (w *dumpWriter) writeFooter() error {
+	footerOffset := w.offset
+
+	body := make([]byte, 0, 8+len(w.index)*(dumpAddressSize+8))
+	body = appendUint64(body, uint64(len(w.index)))
+	for i := range w.index {
+		body = appendDumpAddress(body, w.index[i].Address)
+		body = appendUint64(body, w.index[i].Offset)
+	}
+
+	buf := make([]byte, 0, 4+len(body)+12)
+	buf = appendUint32(buf, dumpFooterMarker)
+	buf = append(buf, body...)
+	buf = appendUint32(buf, crc32.Checksum(body, dumpChecksumTable))
+	buf = appendUint64(buf, footerOffset)
+
+	return w.write(buf)
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v), byte(v>>8))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func appendDumpAddress(b []byte, addr oid.Address) []byte {
+	var raw [dumpAddressSize]byte
+	addr.Container().Encode(raw[:32])
+	addr.Object().Encode(raw[32:])
+	return append(b, raw[:]...)
+}
+
+// DumpReader reads the dump prepared by Dump. Both versions of the format
+// are supported, compressed dumps are detected automatically.
+type DumpReader struct {
+	r   io.Reader
+	dec *zstd.Decoder
+
+	hdr     DumpHeader
+	offset  uint64
+	count   uint64
+	index   []DumpIndexEntry
+	done    bool
+	maxSize uint64
+}
+
+// NewDumpReader reads the dump header from r and returns the reader of the dump records.
+//
+// Returns ErrInvalidMagic if r does not contain a dump and
+// ErrDumpUnsupportedVersion if the dump version is unknown.
+func NewDumpReader(r io.Reader) (*DumpReader, error) {
+	dr := &DumpReader{maxSize: DefaultDumpMaxObjectSize + dumpObjectHeaderReserve}
+
+	br := bufio.NewReader(r)
+	if m, err := br.Peek(len(zstdFrameMagic)); err == nil && bytes.Equal(m, zstdFrameMagic) {
+		dec, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+
+		dr.dec = dec
+		dr.r = dec
+	} else {
+		dr.r = br
+	}
+
+	var m [4]byte
+	if _, err := dr.read(m[:]); err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		dr.Close()
+		return nil, err
+	}
+
+	var err error
+	switch {
+	case bytes.Equal(m[:], dumpMagicV1):
+		dr.hdr.Version = DumpVersion1
+	case bytes.Equal(m[:], dumpMagicV2):
+		err = dr.readHeader()
+	default:
+		err = ErrInvalidMagic
+	}
+	if err != nil {
+		dr.Close()
+		return nil, err
+	}
+	return dr, nil
+}
+
+func (r *DumpReader) read(p []byte) (int, error) {
+	n, err := io.ReadFull(r.r, p)
+	r.offset += uint64(n)
+	return n, err
+}
+
+func (r *DumpReader) readHeader() error {
+	var prefix [8]byte
+	if _, err := r.read(prefix[:]); err != nil {
+		return unexpectedEOF(err)
+	}
+
+	r.hdr.Version = binary.LittleEndian.Uint32(prefix[:])
+	if r.hdr.Version != DumpVersion2 {
+		return fmt.Errorf("%w: %d", ErrDumpUnsupportedVersion, r.hdr.Version)
+	}
+
+	sz := binary.LittleEndian.Uint32(prefix[4:])
+	if sz > maxDumpHeaderSize {
+		return fmt.Errorf("%w: header is too big", ErrDumpCorrupted)
+	}
+
+	body := make([]byte, sz+4)
+	if _, err := r.read(body); err != nil {
+		return unexpectedEOF(err)
+	}
+
+	sum := binary.LittleEndian.Uint32(body[len(body)-4:])
+	body = body[:len(body)-4]
+	if crc32.Checksum(body, dumpChecksumTable) != sum {
+		return fmt.Errorf("%w: header", ErrDumpChecksumMismatch)
+	}
+
+	var ok bool
+	if r.hdr.ShardID, body, ok = cutDumpBytes(body); !ok {
+		return fmt.Errorf("%w: invalid header", ErrDumpCorrupted)
+	}
+	if r.hdr.NodeKey, body, ok = cutDumpBytes(body); !ok {
+		return fmt.Errorf("%w: invalid header", ErrDumpCorrupted)
+	}
+	if len(body) != 16 {
+		return fmt.Errorf("%w: invalid header", ErrDumpCorrupted)
+	}
+	r.hdr.Epoch = binary.LittleEndian.Uint64(body)
+	r.hdr.ObjectCount = binary.LittleEndian.Uint64(body[8:])
+	return nil
+}
+
+func cutDumpBytes(b []byte) ([]byte, []byte, bool) {
+	if len(b) < 2 {
+		return nil, nil, false
+	}
+
+	sz := int(binary.LittleEndian.Uint16(b))
+	if len(b) < 2+sz {
+		return nil, nil, false
+	}
+	if sz == 0 {
+		return nil, b[2:], true
+	}
+	return b[2 : 2+sz], b[2+sz:], true
+}
+
+// SetMaxObjectSize sets the limit of the object payload size in the dump,
+// usually it is the MaxObjectSize network setting. Records exceeding it along
+// with the space reserved for the object header are considered corrupted.
+// Zero value means DefaultDumpMaxObjectSize.
+func (r *DumpReader) SetMaxObjectSize(sz uint64) {
+	if sz == 0 {
+		sz = DefaultDumpMaxObjectSize
+	}
+	r.maxSize = sz + dumpObjectHeaderReserve
+}
+
+// Header returns the dump header.
+func (r *DumpReader) Header() DumpHeader {
+	return r.hdr
+}
+
+// Next returns the next object from the dump along with the offset of its record.
+// The returned slice is valid until the next call.
+//
+// Returns io.EOF when all objects are read. For the version 2 dumps the footer is
+// checked before that and io.ErrUnexpectedEOF is returned if it is missing.
+// If the object checksum does not match ErrDumpChecksumMismatch is returned,
+// the reading can be continued with the next record in this case.
+func (r *DumpReader) Next(buf []byte) ([]byte, uint64, error) {
+	if r.done {
+		return nil, 0, io.EOF
+	}
+
+	offset := r.offset
+
+	var size [4]byte
+	_, err := r.read(size[:])
+	if err != nil {
+		if errors.Is(err, io.EOF) && r.hdr.Version == DumpVersion1 {
+			r.done = true
+			return nil, 0, io.EOF
+		}
+		return nil, 0, unexpectedEOF(err)
+	}
+
+	sz := binary.LittleEndian.Uint32(size[:])
+	if r.hdr.Version == DumpVersion2 && sz == dumpFooterMarker {
+		if err := r.readFooter(offset); err != nil {
+			return nil, 0, err
+		}
+		r.done = true
+		return nil, 0, io.EOF
+	}
+
+	if uint64(sz) > r.maxSize {
+		return nil, 0, fmt.Errorf("%w: record at offset %d is too big: %d > %d", ErrDumpCorrupted, offset, sz, r.maxSize)
+	}
+
+	var sum uint32
+	if r.hdr.Version == DumpVersion2 {
+		if _, err := r.read(size[:]); err != nil {
+			return nil, 0, unexpectedEOF(err)
+		}
+		sum = binary.LittleEndian.Uint32(size[:])
+	}
+
+	if uint32(cap(buf)) < sz {
+		buf = make([]byte, sz)
+	} else {
+		buf = buf[:sz]
+	}
+
+	if _, err := r.read(buf); err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+
+	r.count++
+
+	if r.hdr.Version == DumpVersion2 && crc32.Checksum(buf, dumpChecksumTable) != sum {
+		return buf, offset, fmt.Errorf("%w: record at offset %d", ErrDumpChecksumMismatch, offset)
+	}
+	return buf, offset, nil
+}
+
+func (r *DumpReader) readFooter(footerOffset uint64) error {
+	var cnt [8]byte
+	if _, err := r.read(cnt[:]); err != nil {
+		return unexpectedEOF(err)
+	}
+
+	n := binary.LittleEndian.Uint64(cnt[:])
+	if n != r.count {
+		return fmt.Errorf("%w: footer contains %d records, read %d", ErrDumpCorrupted, n, r.count)
+	}
+
+	body := make([]byte, n*(dumpAddressSize+8))
+	if _, err := r.read(body); err != nil {
+		return unexpectedEOF(err)
+	}
+
+	var trailer [12]byte
+	if _, err := r.read(trailer[:]); err != nil {
+		return unexpectedEOF(err)
+	}
+
+	h := crc32.New(dumpChecksumTable)
+	_, _ = h.Write(cnt[:])
+	_, _ = h.Write(body)
+	if h.Sum32() != binary.LittleEndian.Uint32(trailer[:]) {
+		return fmt.Errorf("%w: footer", ErrDumpChecksumMismatch)
+	}
+	if binary.LittleEndian.Uint64(trailer[4:]) != footerOffset {
+		return fmt.Errorf("%w: invalid footer offset", ErrDumpCorrupted)
+	}
+
+	r.index = make([]DumpIndexEntry, n)
+	for i := range r.index {
+		var cnr cid.ID
+		var obj oid.ID
+
+		entry := body[i*(dumpAddressSize+8):]
+		if err := cnr.Decode(entry[:32]); err != nil {
+			return fmt.Errorf("%w: invalid container ID in the index: %v", ErrDumpCorrupted, err)
+		}
+		if err := obj.Decode(entry[32:dumpAddressSize]); err != nil {
+			return fmt.Errorf("%w: invalid object ID in the index: %v", ErrDumpCorrupted, err)
+		}
+
+		r.index[i].Address.SetContainer(cnr)
+		r.index[i].Address.SetObject(obj)
+		r.index[i].Offset = binary.LittleEndian.Uint64(entry[dumpAddressSize:])
+	}
+
+	return nil
+}
+
+// Index returns the footer index of the dump. It is available
+// only after Next has returned io.EOF and is always empty for the version 1 dumps.
+func (r *DumpReader) Index() []DumpIndexEntry {
+	return r.index
+}
+
+// Close releases the resources of the reader. It does not close the underlying reader.
+func (r *DumpReader) Close() {
+	if r.dec != nil {
+		r.dec.Close()
+	}
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -2,6 +2,7 @@ package shard_test
 
 import (
 	"bytes"
+	"encoding/binary"
 	"io"
 	"math/rand"
 	"os"
@@ -18,6 +19,7 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/writecache"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
 	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
 	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
@@ -149,13 +151,75 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 				require.ErrorIs(t, err, shard.ErrInvalidMagic)
 			})
 
+			t.Run("version 1", func(t *testing.T) {
+				out := out + ".v1"
+				writeDumpV1(t, out, objects)
+
+				var restorePrm shard.RestorePrm
+				restorePrm.WithPath(out)
+
+				shV1 := newCustomShard(t, filepath.Join(t.TempDir(), "v1"), false, nil, nil)
+				checkRestore(t, shV1, restorePrm, objects)
+				releaseShard(shV1, t)
+
+				fileData, err := os.ReadFile(out)
+				require.NoError(t, err)
+
+				t.Run("incomplete size", func(t *testing.T) {
+					out := out + ".wrongsize"
+					fileData := append(fileData, 1)
+					require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+					var restorePrm shard.RestorePrm
+					restorePrm.WithPath(out)
+
+					_, err := sh.Restore(restorePrm)
+					require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+				})
+				t.Run("incomplete object data", func(t *testing.T) {
+					out := out + ".wrongsize"
+					fileData := append(fileData, 1, 0, 0, 0)
+					require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+					var restorePrm shard.RestorePrm
+					restorePrm.WithPath(out)
+
+					_, err := sh.Restore(restorePrm)
+					require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+				})
+				t.Run("invalid object", func(t *testing.T) {
+					out := out + ".wrongobj"
+					fileData := append(fileData, 1, 0, 0, 0, 0xFF, 4, 0, 0, 0, 1, 2, 3, 4)
+					require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+					var restorePrm shard.RestorePrm
+					restorePrm.WithPath(out)
+
+					_, err := sh.Restore(restorePrm)
+					require.Error(t, err)
+
+					t.Run("skip errors", func(t *testing.T) {
+						sh := newCustomShard(t, filepath.Join(t.TempDir(), "ignore"), false, nil, nil)
+						t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+						var restorePrm shard.RestorePrm
+						restorePrm.WithPath(out)
+						restorePrm.WithIgnoreErrors(true)
+
+						res, err := sh.Restore(restorePrm)
+						require.NoError(t, err)
+						require.Equal(t, objCount, res.Count())
+						require.Equal(t, 2, res.FailCount())
+					})
+				})
+			})
+
 			fileData, err := os.ReadFile(out)
 			require.NoError(t, err)
 
-			t.Run("incomplete size", func(t *testing.T) {
-				out := out + ".wrongsize"
-				fileData := append(fileData, 1)
-				require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+			t.Run("truncated", func(t *testing.T) {
+				out := out + ".truncated"
+				require.NoError(t, os.WriteFile(out, fileData[:len(fileData)-1], os.ModePerm))
 
 				var restorePrm shard.RestorePrm
 				restorePrm.WithPath(out)
@@ -163,40 +227,83 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 				_, err := sh.Restore(restorePrm)
 				require.ErrorIs(t, err, io.ErrUnexpectedEOF)
 			})
-			t.Run("incomplete object data", func(t *testing.T) {
-				out := out + ".wrongsize"
-				fileData := append(fileData, 1, 0, 0, 0)
+			t.Run("unsupported version", func(t *testing.T) {
+				out := out + ".version"
+				fileData := append([]byte(nil), fileData...)
+				fileData[4] = 3
 				require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
 
 				var restorePrm shard.RestorePrm
 				restorePrm.WithPath(out)
 
 				_, err := sh.Restore(restorePrm)
-				require.ErrorIs(t, err, io.EOF)
+				require.ErrorIs(t, err, shard.ErrDumpUnsupportedVersion)
 			})
-			t.Run("invalid object", func(t *testing.T) {
-				out := out + ".wrongobj"
-				fileData := append(fileData, 1, 0, 0, 0, 0xFF, 4, 0, 0, 0, 1, 2, 3, 4)
+			t.Run("corrupted header", func(t *testing.T) {
+				out := out + ".header"
+				fileData := append([]byte(nil), fileData...)
+				fileData[13] ^= 0xFF
 				require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
 
 				var restorePrm shard.RestorePrm
 				restorePrm.WithPath(out)
 
 				_, err := sh.Restore(restorePrm)
-				require.Error(t, err)
+				require.ErrorIs(t, err, shard.ErrDumpChecksumMismatch)
+			})
+			t.Run("object is too big", func(t *testing.T) {
+				dr, err := shard.NewDumpReader(bytes.NewReader(fileData))
+				require.NoError(t, err)
+				_, offset, err := dr.Next(nil)
+				require.NoError(t, err)
+
+				out := out + ".size"
+				fileData := append([]byte(nil), fileData...)
+				binary.LittleEndian.PutUint32(fileData[offset:], shard.DefaultDumpMaxObjectSize+8<<20)
+				require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+				var restorePrm shard.RestorePrm
+				restorePrm.WithPath(out)
+				restorePrm.WithIgnoreErrors(true)
+
+				_, err = sh.Restore(restorePrm)
+				require.ErrorIs(t, err, shard.ErrDumpCorrupted)
+
+				t.Run("network limit", func(t *testing.T) {
+					// The record fits the limit, so the dump is read until the end.
+					restorePrm.WithMaxObjectSize(2 * shard.DefaultDumpMaxObjectSize)
+
+					_, err = sh.Restore(restorePrm)
+					require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+				})
+			})
+			t.Run("corrupted object", func(t *testing.T) {
+				dr, err := shard.NewDumpReader(bytes.NewReader(fileData))
+				require.NoError(t, err)
+				_, offset, err := dr.Next(nil)
+				require.NoError(t, err)
+
+				out := out + ".record"
+				fileData := append([]byte(nil), fileData...)
+				fileData[offset+8] ^= 0xFF
+				require.NoError(t, os.WriteFile(out, fileData, os.ModePerm))
+
+				var restorePrm shard.RestorePrm
+				restorePrm.WithPath(out)
+
+				_, err = sh.Restore(restorePrm)
+				require.ErrorIs(t, err, shard.ErrDumpChecksumMismatch)
 
 				t.Run("skip errors", func(t *testing.T) {
 					sh := newCustomShard(t, filepath.Join(t.TempDir(), "ignore"), false, nil, nil)
 					t.Cleanup(func() { require.NoError(t, sh.Close()) })
 
-					var restorePrm shard.RestorePrm
-					restorePrm.WithPath(out)
 					restorePrm.WithIgnoreErrors(true)
 
 					res, err := sh.Restore(restorePrm)
 					require.NoError(t, err)
-					require.Equal(t, objCount, res.Count())
-					require.Equal(t, 2, res.FailCount())
+					require.Equal(t, objCount-1, res.Count())
+					require.Equal(t, 1, res.FailCount())
 				})
 			})
 		})
@@ -266,6 +373,97 @@ func TestStream(t *testing.T) {
 	}, time.Second, time.Millisecond)
 }
 
+func TestDumpFilters(t *testing.T) {
+	sh := newCustomShard(t, filepath.Join(t.TempDir(), "shard"), false, nil, nil)
+	defer releaseShard(sh, t)
+
+	cnr1, cnr2 := cidtest.ID(), cidtest.ID()
+
+	var cnr1Objects, regularObjects []*objectSDK.Object
+	for i := 0; i < 6; i++ {
+		cnr := cnr1
+		if i%2 == 0 {
+			cnr = cnr2
+		}
+
+		obj := generateObjectWithCID(t, cnr)
+		if i%3 == 0 {
+			obj.SetType(objectSDK.TypeTombstone)
+		} else {
+			regularObjects = append(regularObjects, obj)
+		}
+		if cnr == cnr1 {
+			cnr1Objects = append(cnr1Objects, obj)
+		}
+
+		var prm shard.PutPrm
+		prm.SetObject(obj)
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	testCases := []struct {
+		name     string
+		prm      func(*shard.DumpPrm)
+		expected []*objectSDK.Object
+	}{
+		{
+			name:     "container",
+			prm:      func(p *shard.DumpPrm) { p.WithContainers([]cid.ID{cnr1}) },
+			expected: cnr1Objects,
+		},
+		{
+			name:     "object type",
+			prm:      func(p *shard.DumpPrm) { p.WithObjectTypes([]objectSDK.Type{objectSDK.TypeRegular}) },
+			expected: regularObjects,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, compress := range []bool{false, true} {
+				buf := bytes.NewBuffer(nil)
+
+				var dumpPrm shard.DumpPrm
+				dumpPrm.WithStream(buf)
+				dumpPrm.WithCompression(compress)
+				tc.prm(&dumpPrm)
+
+				res, err := sh.Dump(dumpPrm)
+				require.NoError(t, err)
+				require.Equal(t, len(tc.expected), res.Count())
+
+				if compress {
+					require.True(t, bytes.HasPrefix(buf.Bytes(), []byte{0x28, 0xb5, 0x2f, 0xfd}))
+				}
+
+				sh := newCustomShard(t, filepath.Join(t.TempDir(), "restore"), false, nil, nil)
+
+				var restorePrm shard.RestorePrm
+				restorePrm.WithStream(buf)
+				checkRestore(t, sh, restorePrm, tc.expected)
+				releaseShard(sh, t)
+			}
+		})
+	}
+}
+
+// writeDumpV1 writes objects to the file in the version 1 dump format.
+func writeDumpV1(t *testing.T, path string, objects []*objectSDK.Object) {
+	data := []byte("NEOF")
+	for i := range objects {
+		raw, err := objects[i].Marshal()
+		require.NoError(t, err)
+
+		var size [4]byte
+		binary.LittleEndian.PutUint32(size[:], uint32(len(raw)))
+		data = append(data, size[:]...)
+		data = append(data, raw...)
+	}
+	require.NoError(t, os.WriteFile(path, data, os.ModePerm))
+}
+
 func checkRestore(t *testing.T, sh *shard.Shard, prm shard.RestorePrm, objects []*objectSDK.Object) {
 	res, err := sh.Restore(prm)
 	require.NoError(t, err)
@@ -0,0 +1,67 @@
+package shard
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"go.uber.org/zap"
+)
+
+// evacuationCheckpointSuffix is appended to the metabase path to get the path
+// of the file with the evacuation progress of the shard.
+const evacuationCheckpointSuffix = ".evacuation"
+
+func (s *Shard) evacuationCheckpointPath() string {
+	return s.metaBase.DumpInfo().Path + evacuationCheckpointSuffix
+}
+
+// ReadEvacuationCheckpoint returns the evacuation progress saved with
+// WriteEvacuationCheckpoint. Returns nil, nil if there is no saved progress.
+func (s *Shard) ReadEvacuationCheckpoint() ([]byte, error) {
+	data, err := os.ReadFile(s.evacuationCheckpointPath())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// WriteEvacuationCheckpoint saves the evacuation progress of the shard.
+//
+// The progress is stored in a file next to the metabase, because the metabase
+// of the evacuated shard is read-only. It is kept between the restarts and
+// dropped when the shard is opened or switched to a mode allowing writes.
+func (s *Shard) WriteEvacuationCheckpoint(data []byte) error {
+	p := s.evacuationCheckpointPath()
+	tmp := p + ".tmp"
+
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("could not write evacuation checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, p); err != nil {
+		return fmt.Errorf("could not write evacuation checkpoint: %w", err)
+	}
+	return nil
+}
+
+// DeleteEvacuationCheckpoint drops the saved evacuation progress of the shard.
+func (s *Shard) DeleteEvacuationCheckpoint() error {
+	err := os.Remove(s.evacuationCheckpointPath())
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("could not remove evacuation checkpoint: %w", err)
+	}
+	return nil
+}
+
+// dropStaleEvacuationCheckpoint removes the evacuation progress if new objects
+// can be put to the shard, so the next evacuation starts over.
+func (s *Shard) dropStaleEvacuationCheckpoint() {
+	if s.info.Mode.ReadOnly() {
+		return
+	}
+
+	if err := s.DeleteEvacuationCheckpoint(); err != nil {
+		s.log.Warn("could not drop evacuation checkpoint", zap.Error(err))
+	}
+}
@@ -71,6 +71,9 @@ type gc struct {
 
 	remover func()
 
+	compactor     func()
+	stopCompactor chan struct{}
+
 	eventChan     chan Event
 	mEventHandler map[eventType]*eventHandlers
 }
@@ -78,6 +81,10 @@ type gc struct {
 type gcCfg struct {
 	removerInterval time.Duration
 
+	// compactorInterval is an interval between blobovnicza compactions,
+	// zero value disables them.
+	compactorInterval time.Duration
+
 	log *logger.Logger
 
 	workerPoolInit func(int) util.WorkerPool
@@ -107,6 +114,11 @@ func (gc *gc) init() {
 	gc.wg.Add(2)
 	go gc.tickRemover()
 	go gc.listenEvents()
+
+	if gc.compactorInterval > 0 {
+		gc.wg.Add(1)
+		go gc.tickCompactor()
+	}
 }
 
 func (gc *gc) listenEvents() {
@@ -174,8 +186,25 @@ func (gc *gc) tickRemover() {
 	}
 }
 
+func (gc *gc) tickCompactor() {
+	defer gc.wg.Done()
+
+	t := time.NewTicker(gc.compactorInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-gc.stopCompactor:
+			return
+		case <-t.C:
+			gc.compactor()
+		}
+	}
+}
+
 func (gc *gc) stop() {
 	gc.onceStop.Do(func() {
+		close(gc.stopCompactor)
 		gc.stopChannel <- struct{}{}
 	})
 
@@ -32,6 +32,7 @@ func (r ListContainersRes) Containers() []cid.ID {
 type ListWithCursorPrm struct {
 	count  uint32
 	cursor *Cursor
+	cnr    *cid.ID
 }
 
 // ListWithCursorRes contains values returned from ListWithCursor operation.
@@ -52,6 +53,12 @@ func (p *ListWithCursorPrm) WithCursor(cursor *Cursor) {
 	p.cursor = cursor
 }
 
+// WithContainerID restricts ListWithCursor operation to the objects
+// of the specified container.
+func (p *ListWithCursorPrm) WithContainerID(cnr cid.ID) {
+	p.cnr = &cnr
+}
+
 // AddressList returns addresses selected by ListWithCursor operation.
 func (r ListWithCursorRes) AddressList() []objectcore.AddressWithType {
 	return r.addrList
@@ -128,6 +135,9 @@ func (s *Shard) ListWithCursor(prm ListWithCursorPrm) (ListWithCursorRes, error)
 	var metaPrm meta.ListPrm
 	metaPrm.SetCount(prm.count)
 	metaPrm.SetCursor(prm.cursor)
+	if prm.cnr != nil {
+		metaPrm.SetContainerID(*prm.cnr)
+	}
 	res, err := s.metaBase.ListWithCursor(metaPrm)
 	if err != nil {
 		return ListWithCursorRes{}, fmt.Errorf("could not get list of objects: %w", err)
@@ -21,6 +21,8 @@ type metricsStore struct {
 	cnrSize     map[string]int64
 	pldSize     int64
 	readOnly    bool
+	ratios      []float64
+	skipped     int
 }
 
 func (m metricsStore) SetShardID(_ string) {}
@@ -66,6 +68,18 @@ func (m *metricsStore) AddToPayloadSize(size int64) {
 	m.pldSize += size
 }
 
+func (m *metricsStore) ObserveCompressionRatio(ratio float64) {
+	m.ratios = append(m.ratios, ratio)
+}
+
+func (m *metricsStore) IncCompressionSkipped() {
+	m.skipped++
+}
+
+func (m *metricsStore) IncSegmentCompactions() {}
+
+func (m *metricsStore) AddSegmentReclaimedBytes(uint64) {}
+
 const physical = "phy"
 const logical = "logic"
 const readonly = "readonly"
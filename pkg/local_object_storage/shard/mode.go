@@ -17,10 +17,15 @@ var ErrDegradedMode = logicerr.New("shard is in degraded mode")
 //
 // Returns any error encountered that did not allow
 // setting shard mode.
+// Returns ErrMetabaseRebuildInProgress if the metabase is being rebuilt.
 func (s *Shard) SetMode(m mode.Mode) error {
 	s.m.Lock()
 	defer s.m.Unlock()
 
+	if s.isMetabaseRebuildRunning() {
+		return ErrMetabaseRebuildInProgress
+	}
+
 	return s.setMode(m)
 }
 
@@ -62,6 +67,8 @@ func (s *Shard) setMode(m mode.Mode) error {
 	}
 
 	s.info.Mode = m
+	s.dropStaleEvacuationCheckpoint()
+
 	if s.metricsWriter != nil {
 		s.metricsWriter.SetReadonly(s.info.Mode != mode.ReadWrite)
 	}
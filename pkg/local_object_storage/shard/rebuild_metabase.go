@@ -0,0 +1,228 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// ErrMetabaseRebuildInProgress is returned when the operation cannot be
+// performed because the metabase is being rebuilt.
+var ErrMetabaseRebuildInProgress = logicerr.New("metabase rebuild is in progress")
+
+// rebuildMetabaseSuffix is appended to the metabase path to get
+// the path of the metabase being rebuilt.
+const rebuildMetabaseSuffix = ".rebuild"
+
+// MetabaseRebuildState represents the state of the metabase rebuild.
+type MetabaseRebuildState uint8
+
+const (
+	// MetabaseRebuildNone means that the rebuild has never been started.
+	MetabaseRebuildNone MetabaseRebuildState = iota
+	// MetabaseRebuildRunning means that the rebuild is in progress.
+	MetabaseRebuildRunning
+	// MetabaseRebuildCompleted means that the rebuild has finished successfully.
+	MetabaseRebuildCompleted
+	// MetabaseRebuildFailed means that the rebuild has finished with an error.
+	MetabaseRebuildFailed
+)
+
+// String implements fmt.Stringer.
+func (s MetabaseRebuildState) String() string {
+	switch s {
+	case MetabaseRebuildNone:
+		return "NONE"
+	case MetabaseRebuildRunning:
+		return "RUNNING"
+	case MetabaseRebuildCompleted:
+		return "COMPLETED"
+	case MetabaseRebuildFailed:
+		return "FAILED"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+// MetabaseRebuildStatus groups the information about the metabase rebuild.
+type MetabaseRebuildStatus struct {
+	State MetabaseRebuildState
+	// ObjectsProcessed is the number of objects put to the new metabase.
+	ObjectsProcessed uint64
+	// StartedAt and FinishedAt are zero if the rebuild has not been started (finished).
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Error is the reason of the failure for the MetabaseRebuildFailed state.
+	Error error
+}
+
+type metabaseRebuild struct {
+	mtx    sync.RWMutex
+	status MetabaseRebuildStatus
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	processed atomic.Uint64
+}
+
+// RebuildMetabase starts rebuilding the metabase from the blobstor content
+// in the background.
+//
+// The shard is moved to the degraded read-only mode until the rebuild is finished,
+// so it continues to serve read requests. On success the new metabase replaces the old one
+// and the shard is moved to the read-write mode. On failure the shard remains in
+// the degraded read-only mode.
+//
+// Returns ErrMetabaseRebuildInProgress if the rebuild is already running.
+func (s *Shard) RebuildMetabase() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	r := s.rebuild
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.status.State == MetabaseRebuildRunning {
+		return ErrMetabaseRebuildInProgress
+	}
+
+	if s.info.Mode != mode.DegradedReadOnly {
+		if err := s.setMode(mode.DegradedReadOnly); err != nil {
+			return fmt.Errorf("could not switch to mode %s: %w", mode.DegradedReadOnly, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r.status = MetabaseRebuildStatus{
+		State:     MetabaseRebuildRunning,
+		StartedAt: time.Now(),
+	}
+	r.processed.Store(0)
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go s.rebuildMetabase(ctx, r.done)
+
+	return nil
+}
+
+// MetabaseRebuildStatus returns the status of the last metabase rebuild.
+func (s *Shard) MetabaseRebuildStatus() MetabaseRebuildStatus {
+	s.rebuild.mtx.RLock()
+	defer s.rebuild.mtx.RUnlock()
+
+	st := s.rebuild.status
+	st.ObjectsProcessed = s.rebuild.processed.Load()
+	return st
+}
+
+func (s *Shard) rebuildMetabase(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	s.log.Info("metabase rebuild started")
+
+	err := s.buildMetabase(ctx)
+	if err == nil {
+		s.log.Info("metabase rebuild completed, trying to restore read-write mode",
+			zap.Uint64("objects", s.rebuild.processed.Load()))
+	} else {
+		s.log.Error("metabase rebuild failed", zap.Error(err))
+	}
+
+	s.rebuild.mtx.Lock()
+	s.rebuild.status.FinishedAt = time.Now()
+	if err == nil {
+		s.rebuild.status.State = MetabaseRebuildCompleted
+	} else {
+		s.rebuild.status.State = MetabaseRebuildFailed
+		s.rebuild.status.Error = err
+	}
+	s.rebuild.mtx.Unlock()
+
+	if err == nil {
+		s.updateMetrics()
+	}
+}
+
+func (s *Shard) buildMetabase(ctx context.Context) error {
+	path := s.metaBase.DumpInfo().Path
+	tmpPath := path + rebuildMetabaseSuffix
+
+	if err := os.Remove(tmpPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("could not remove stale metabase %s: %w", tmpPath, err)
+	}
+
+	db := meta.New(append(s.metaOpts, meta.WithPath(tmpPath), meta.WithLogger(s.log))...)
+	err := s.fillNewMetabase(ctx, db)
+	if cErr := db.Close(); err == nil && cErr != nil {
+		err = fmt.Errorf("could not close new metabase: %w", cErr)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("could not replace metabase: %w", err)
+	}
+
+	return s.setMode(mode.ReadWrite)
+}
+
+func (s *Shard) fillNewMetabase(ctx context.Context, db *meta.DB) error {
+	if err := db.Open(false); err != nil {
+		return fmt.Errorf("could not open new metabase: %w", err)
+	}
+	if err := db.Init(); err != nil {
+		return fmt.Errorf("could not initialize new metabase: %w", err)
+	}
+
+	err := s.fillMetabase(db, func() error {
+		s.rebuild.processed.Inc()
+		return ctx.Err()
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.info.ID != nil {
+		if err := db.WriteShardID(*s.info.ID); err != nil {
+			return fmt.Errorf("could not write shard ID: %w", err)
+		}
+	}
+	return nil
+}
+
+// stopMetabaseRebuild cancels the running metabase rebuild and waits until it is finished.
+func (s *Shard) stopMetabaseRebuild() {
+	s.rebuild.mtx.RLock()
+	cancel, done := s.rebuild.cancel, s.rebuild.done
+	s.rebuild.mtx.RUnlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (s *Shard) isMetabaseRebuildRunning() bool {
+	s.rebuild.mtx.RLock()
+	defer s.rebuild.mtx.RUnlock()
+
+	return s.rebuild.status.State == MetabaseRebuildRunning
+}
@@ -0,0 +1,97 @@
+package shard
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	objecttest "github.com/TrueCloudLab/frostfs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebuildMetabase(t *testing.T) {
+	dir := t.TempDir()
+	metaPath := filepath.Join(dir, "meta")
+
+	sh := New(
+		WithID(NewIDFromBytes([]byte{1, 2, 3})),
+		WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{
+					Storage: fstree.New(
+						fstree.WithPath(filepath.Join(dir, "blob")),
+						fstree.WithDepth(1)),
+				},
+			})),
+		WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))),
+		WithMetaBaseOptions(meta.WithPath(metaPath), meta.WithEpochState(epochState{})))
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	defer func() { require.NoError(t, sh.Close()) }()
+
+	const objCount = 10
+
+	cnr := cidtest.ID()
+	objs := make([]*objectSDK.Object, objCount)
+	for i := range objs {
+		objs[i] = objecttest.Object()
+		objs[i].SetType(objectSDK.TypeRegular)
+		objs[i].SetContainerID(cnr)
+		objs[i].ResetRelations()
+
+		var putPrm PutPrm
+		putPrm.SetObject(objs[i])
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, MetabaseRebuildNone, sh.MetabaseRebuildStatus().State)
+
+	// Corrupt the metabase: the objects are stored in the blobstor only.
+	require.NoError(t, sh.metaBase.Reset())
+
+	var selectPrm SelectPrm
+	selectPrm.SetContainerID(cnr)
+	res, err := sh.Select(selectPrm)
+	require.NoError(t, err)
+	require.Empty(t, res.AddressList())
+
+	require.NoError(t, sh.RebuildMetabase())
+	require.Eventually(t, func() bool {
+		return sh.MetabaseRebuildStatus().State != MetabaseRebuildRunning
+	}, 10*time.Second, 10*time.Millisecond)
+
+	st := sh.MetabaseRebuildStatus()
+	require.Equal(t, MetabaseRebuildCompleted, st.State, st.Error)
+	require.Equal(t, uint64(objCount), st.ObjectsProcessed)
+	require.False(t, st.StartedAt.IsZero())
+	require.False(t, st.FinishedAt.IsZero())
+	require.Equal(t, mode.ReadWrite, sh.GetMode())
+
+	res, err = sh.Select(selectPrm)
+	require.NoError(t, err)
+	require.Len(t, res.AddressList(), objCount)
+
+	for i := range objs {
+		var headPrm HeadPrm
+		headPrm.SetAddress(object.AddressOf(objs[i]))
+		_, err := sh.Head(headPrm)
+		require.NoError(t, err)
+	}
+
+	id, err := sh.metaBase.ReadShardID()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, id)
+
+	_, err = os.Stat(metaPath + rebuildMetabaseSuffix)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -1,24 +1,19 @@
 package shard
 
 import (
-	"bytes"
-	"encoding/binary"
 	"errors"
 	"io"
 	"os"
 
-	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 )
 
-// ErrInvalidMagic is returned when dump format is invalid.
-var ErrInvalidMagic = logicerr.New("invalid magic")
-
 // RestorePrm groups the parameters of Restore operation.
 type RestorePrm struct {
 	path         string
 	stream       io.Reader
 	ignoreErrors bool
+	maxSize      uint64
 }
 
 // WithPath is a Restore option to set the destination path.
@@ -38,6 +33,13 @@ func (p *RestorePrm) WithIgnoreErrors(ignore bool) {
 	p.ignoreErrors = ignore
 }
 
+// WithMaxObjectSize is a Restore option to set the limit of the object payload size
+// in the dump, usually it is the MaxObjectSize network setting.
+// DefaultDumpMaxObjectSize is used by default.
+func (p *RestorePrm) WithMaxObjectSize(sz uint64) {
+	p.maxSize = sz
+}
+
 // RestoreRes groups the result fields of Restore operation.
 type RestoreRes struct {
 	count  int
@@ -55,6 +57,7 @@ func (r RestoreRes) FailCount() int {
 }
 
 // Restore restores objects from the dump prepared by Dump.
+// Dumps of all format versions are supported.
 //
 // Returns any error encountered.
 func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
@@ -77,37 +80,28 @@ func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
 		r = f
 	}
 
-	var m [4]byte
-	_, _ = io.ReadFull(r, m[:])
-	if !bytes.Equal(m[:], dumpMagic) {
-		return RestoreRes{}, ErrInvalidMagic
+	dr, err := NewDumpReader(r)
+	if err != nil {
+		return RestoreRes{}, err
 	}
+	defer dr.Close()
+
+	dr.SetMaxObjectSize(prm.maxSize)
 
 	var putPrm PutPrm
 
 	var count, failCount int
 	var data []byte
-	var size [4]byte
 	for {
-		// If there are less than 4 bytes left, `Read` returns nil error instead of
-		// io.ErrUnexpectedEOF, thus `ReadFull` is used.
-		_, err := io.ReadFull(r, size[:])
+		data, _, err = dr.Next(data)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
-			return RestoreRes{}, err
-		}
-
-		sz := binary.LittleEndian.Uint32(size[:])
-		if uint32(cap(data)) < sz {
-			data = make([]byte, sz)
-		} else {
-			data = data[:sz]
-		}
-
-		_, err = r.Read(data)
-		if err != nil {
+			if prm.ignoreErrors && errors.Is(err, ErrDumpChecksumMismatch) {
+				failCount++
+				continue
+			}
 			return RestoreRes{}, err
 		}
 
@@ -13,11 +13,15 @@ import (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	cursor *oid.ID
+	limit  uint32
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	cursor   *oid.ID
 }
 
 // SetContainerID is a Select option to set the container id to search in.
@@ -30,11 +34,29 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetCursor is a Select option to return only the objects with
+// identifiers greater than the cursor.
+func (p *SelectPrm) SetCursor(cursor *oid.ID) {
+	p.cursor = cursor
+}
+
+// SetLimit is a Select option to set the maximum number of the returned objects.
+// Zero means no limit.
+func (p *SelectPrm) SetLimit(limit uint32) {
+	p.limit = limit
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Cursor returns the cursor for the next Select call.
+// It is nil if there are no more objects to return.
+func (r SelectRes) Cursor() *oid.ID {
+	return r.cursor
+}
+
 // Select selects the objects from shard that match select parameters.
 //
 // Returns any error encountered that
@@ -50,6 +72,8 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 	var selectPrm meta.SelectPrm
 	selectPrm.SetFilters(prm.filters)
 	selectPrm.SetContainerID(prm.cnr)
+	selectPrm.SetCursor(prm.cursor)
+	selectPrm.SetLimit(prm.limit)
 
 	mRes, err := s.metaBase.Select(selectPrm)
 	if err != nil {
@@ -58,5 +82,6 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 
 	return SelectRes{
 		addrList: mRes.AddressList(),
+		cursor:   mRes.Cursor(),
 	}, nil
 }
@@ -31,6 +31,8 @@ type Shard struct {
 	metaBase *meta.DB
 
 	tsSource TombstoneSource
+
+	rebuild *metabaseRebuild
 }
 
 // Option represents Shard's constructor option.
@@ -70,6 +72,16 @@ type MetricsWriter interface {
 	SetShardID(id string)
 	// SetReadonly must set shard readonly state.
 	SetReadonly(readonly bool)
+	// ObserveCompressionRatio must register the compression ratio of the stored object.
+	ObserveCompressionRatio(ratio float64)
+	// IncCompressionSkipped must increment the number of objects stored
+	// uncompressed because of the poor compression ratio.
+	IncCompressionSkipped()
+	// IncSegmentCompactions must increment the number of compacted segments.
+	IncSegmentCompactions()
+	// AddSegmentReclaimedBytes must add the amount of disk space
+	// reclaimed by the segment compaction.
+	AddSegmentReclaimedBytes(size uint64)
 }
 
 type cfg struct {
@@ -105,15 +117,18 @@ type cfg struct {
 
 	metricsWriter MetricsWriter
 
+	compactionThreshold float64
+
 	reportErrorFunc func(selfID string, message string, err error)
 }
 
 func defaultCfg() *cfg {
 	return &cfg{
-		rmBatchSize:     100,
-		log:             &logger.Logger{Logger: zap.L()},
-		gcCfg:           defaultGCCfg(),
-		reportErrorFunc: func(string, string, error) {},
+		rmBatchSize:         100,
+		log:                 &logger.Logger{Logger: zap.L()},
+		gcCfg:               defaultGCCfg(),
+		compactionThreshold: 0.5,
+		reportErrorFunc:     func(string, string, error) {},
 	}
 }
 
@@ -125,6 +140,11 @@ func New(opts ...Option) *Shard {
 		opts[i](c)
 	}
 
+	if c.metricsWriter != nil {
+		c.blobOpts = append(c.blobOpts, blobstor.WithCompressionMetrics(c.metricsWriter),
+			blobstor.WithSegmentMetrics(c.metricsWriter))
+	}
+
 	bs := blobstor.New(c.blobOpts...)
 	mb := meta.New(c.metaOpts...)
 
@@ -133,6 +153,7 @@ func New(opts ...Option) *Shard {
 		blobStor: bs,
 		metaBase: mb,
 		tsSource: c.tsSource,
+		rebuild:  new(metabaseRebuild),
 	}
 
 	reportFunc := func(msg string, err error) {
@@ -242,6 +263,23 @@ func WithGCRemoverSleepInterval(dur time.Duration) Option {
 	}
 }
 
+// WithBlobovniczaCompactionInterval returns option to specify interval
+// between background blobovnicza compactions. Zero value disables them.
+func WithBlobovniczaCompactionInterval(dur time.Duration) Option {
+	return func(c *cfg) {
+		c.gcCfg.compactorInterval = dur
+	}
+}
+
+// WithBlobovniczaCompactionThreshold returns option to specify the ratio of
+// the stored objects size to the blobovnicza size below which the blobovnicza
+// is compacted.
+func WithBlobovniczaCompactionThreshold(v float64) Option {
+	return func(c *cfg) {
+		c.compactionThreshold = v
+	}
+}
+
 // WithExpiredTombstonesCallback returns option to specify callback
 // of the expired tombstones handler.
 func WithExpiredTombstonesCallback(cb ExpiredTombstonesCallback) Option {
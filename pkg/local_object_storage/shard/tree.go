@@ -56,6 +56,36 @@ func (s *Shard) TreeApply(d pilorama.CIDDescriptor, treeID string, m *pilorama.M
 	return s.pilorama.TreeApply(d, treeID, m, backgroundSync)
 }
 
+// TreeBatch implements the pilorama.Forest interface.
+func (s *Shard) TreeBatch(d pilorama.CIDDescriptor, treeID string, ops []pilorama.BatchOperation) ([][]pilorama.Move, error) {
+	if s.pilorama == nil {
+		return nil, ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return nil, ErrReadOnlyMode
+	}
+	return s.pilorama.TreeBatch(d, treeID, ops)
+}
+
+// TreeApplyBatch implements the pilorama.Forest interface.
+func (s *Shard) TreeApplyBatch(d pilorama.CIDDescriptor, treeID string, ms []*pilorama.Move) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeApplyBatch(d, treeID, ms)
+}
+
 // TreeGetByPath implements the pilorama.Forest interface.
 func (s *Shard) TreeGetByPath(cid cidSDK.ID, treeID string, attr string, path []string, latest bool) ([]pilorama.Node, error) {
 	if s.pilorama == nil {
@@ -80,6 +110,14 @@ func (s *Shard) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID pilorama.No
 	return s.pilorama.TreeGetChildren(cid, treeID, nodeID)
 }
 
+// TreeSortedByFilename implements the pilorama.Forest interface.
+func (s *Shard) TreeSortedByFilename(cid cidSDK.ID, treeID string, nodeID pilorama.Node, start string, count int) ([]pilorama.NodeInfo, error) {
+	if s.pilorama == nil {
+		return nil, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeSortedByFilename(cid, treeID, nodeID, start, count)
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (s *Shard) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilorama.Move, error) {
 	if s.pilorama == nil {
@@ -88,6 +126,14 @@ func (s *Shard) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilor
 	return s.pilorama.TreeGetOpLog(cid, treeID, height)
 }
 
+// TreeLogDigest implements the pilorama.Forest interface.
+func (s *Shard) TreeLogDigest(cid cidSDK.ID, treeID string, start, end uint64) (pilorama.LogDigest, error) {
+	if s.pilorama == nil {
+		return pilorama.LogDigest{}, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeLogDigest(cid, treeID, start, end)
+}
+
 // TreeDrop implements the pilorama.Forest interface.
 func (s *Shard) TreeDrop(cid cidSDK.ID, treeID string) error {
 	if s.pilorama == nil {
@@ -111,3 +157,41 @@ func (s *Shard) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	}
 	return s.pilorama.TreeExists(cid, treeID)
 }
+
+// TreeCompact implements the pilorama.Forest interface.
+func (s *Shard) TreeCompact(cid cidSDK.ID, treeID string, height uint64) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeCompact(cid, treeID, height)
+}
+
+// TreeGetSnapshot implements the pilorama.Forest interface.
+func (s *Shard) TreeGetSnapshot(cid cidSDK.ID, treeID string, start pilorama.Node, count int) (uint64, []pilorama.SnapshotNode, error) {
+	if s.pilorama == nil {
+		return 0, nil, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeGetSnapshot(cid, treeID, start, count)
+}
+
+// TreeApplySnapshot implements the pilorama.Forest interface.
+func (s *Shard) TreeApplySnapshot(d pilorama.CIDDescriptor, treeID string, height uint64, nodes []pilorama.SnapshotNode) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeApplySnapshot(d, treeID, height, nodes)
+}
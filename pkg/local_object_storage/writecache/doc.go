@@ -8,4 +8,7 @@
 // To make it possible to serve Read requests after the object was flushed,
 // we maintain an LRU cache containing addresses of all the objects that
 // could be safely deleted. The actual deletion is done during eviction from this cache.
+//
+// Alternatively, all objects can be stored in a single preallocated append-only log (TypeLog).
+// The log is reused as a ring buffer: the space is freed as soon as the object is flushed.
 package writecache
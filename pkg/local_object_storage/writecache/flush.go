@@ -162,7 +162,7 @@ func (c *cache) flushBigObjects() {
 	}
 }
 
-func (c *cache) reportFlushError(msg string, addr string, err error) {
+func (c *options) reportFlushError(msg string, addr string, err error) {
 	if c.reportError != nil {
 		c.reportError(msg, err)
 	} else {
@@ -240,7 +240,8 @@ func (c *cache) flushWorker(_ int) {
 }
 
 // flushObject is used to write object directly to the main storage.
-func (c *cache) flushObject(obj *object.Object, data []byte) error {
+// It is shared by all write-cache implementations.
+func (c *options) flushObject(obj *object.Object, data []byte) error {
 	addr := objectCore.AddressOf(obj)
 
 	var prm common.PutPrm
@@ -15,16 +15,22 @@ import (
 func TestGeneric(t *testing.T) {
 	defer func() { _ = os.RemoveAll(t.Name()) }()
 
-	var n int
-	newCache := func(t *testing.T) storagetest.Component {
-		n++
-		dir := filepath.Join(t.Name(), strconv.Itoa(n))
-		require.NoError(t, os.MkdirAll(dir, os.ModePerm))
-		return New(
-			WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
-			WithFlushWorkersCount(2),
-			WithPath(dir))
-	}
+	for _, typ := range []string{TypeBBolt, TypeLog} {
+		typ := typ
+		t.Run(typ, func(t *testing.T) {
+			var n int
+			newCache := func(t *testing.T) storagetest.Component {
+				n++
+				dir := filepath.Join(t.Name(), strconv.Itoa(n))
+				require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+				return New(
+					WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+					WithType(typ),
+					WithFlushWorkersCount(2),
+					WithPath(dir))
+			}
 
-	storagetest.TestAll(t, newCache)
+			storagetest.TestAll(t, newCache)
+		})
+	}
 }
@@ -104,7 +104,7 @@ func (c *cache) initFlushMarks() {
 // flushStatus returns info about the object state in the main storage.
 // First return value is true iff object exists.
 // Second return value is true iff object can be safely removed.
-func (c *cache) flushStatus(addr oid.Address) (bool, bool) {
+func (c *options) flushStatus(addr oid.Address) (bool, bool) {
 	var existsPrm meta.ExistsPrm
 	existsPrm.SetAddress(addr)
 
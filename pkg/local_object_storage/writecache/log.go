@@ -0,0 +1,167 @@
+package writecache
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard/mode"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/atomic"
+)
+
+// logCache is the write-cache storing all objects in a single append-only log file
+// of the fixed size. The log is used as a ring buffer: the space occupied by
+// the flushed and removed objects is reused after the head of the log passes it.
+//
+// Writes are made durable with group commit: concurrent writers wait for
+// a single fsync of the log file.
+type logCache struct {
+	options
+
+	mode    mode.Mode
+	modeMtx sync.RWMutex
+
+	// closeCh is close channel.
+	closeCh chan struct{}
+	// wg is a wait group for the flush loop.
+	wg sync.WaitGroup
+
+	// file is the log file, nil if the log is closed.
+	file *os.File
+	// fileRO is true iff the log file is opened in read-only mode.
+	fileRO bool
+
+	// mtx protects the log state below.
+	mtx sync.Mutex
+	// index maps object address to the record with the object.
+	index map[oid.Address]*logRecord
+	// records contains put records in the log order.
+	records []*logRecord
+	// size is the size of the log file.
+	size uint64
+	// tail is the offset of the next record.
+	tail uint64
+	// nextSeq is the sequence number of the next record.
+	nextSeq uint64
+	// persisted is the head stored in the log header. Space after it
+	// can't be reused, even if all the objects there are already flushed.
+	persisted logHead
+
+	// syncMtx protects the group commit state below.
+	syncMtx  sync.Mutex
+	syncCond *sync.Cond
+	// syncing is true iff some writer is doing fsync.
+	syncing bool
+	// synced is the sequence number of the first record which is not synced.
+	synced uint64
+	// written is the sequence number of the first record which is not written.
+	written atomic.Uint64
+}
+
+// logRecord describes the object stored in the log.
+type logRecord struct {
+	addr    oid.Address
+	offset  uint64
+	seq     uint64
+	dataLen uint64
+	// dead is true iff the object was flushed or removed.
+	dead bool
+}
+
+func newLogCache(o options) Cache {
+	c := &logCache{
+		options: o,
+		mode:    mode.ReadWrite,
+	}
+	c.syncCond = sync.NewCond(&c.syncMtx)
+	return c
+}
+
+// SetLogger sets logger. It is used after the shard ID was generated to use it in logs.
+func (c *logCache) SetLogger(l *logger.Logger) {
+	c.log = l
+}
+
+func (c *logCache) DumpInfo() Info {
+	return Info{
+		Path: c.path,
+	}
+}
+
+// Open opens the log file and restores the log state from it.
+func (c *logCache) Open(readOnly bool) error {
+	if err := c.openLog(readOnly); err != nil {
+		return err
+	}
+
+	// Opening after Close is done during maintenance mode,
+	// thus we need to create a channel here.
+	c.closeCh = make(chan struct{})
+	return nil
+}
+
+// Init removes already flushed objects from the log and runs the flush loop.
+func (c *logCache) Init() error {
+	c.dropFlushed()
+	c.runFlushLoop()
+	return nil
+}
+
+// Close stops the flush loop and closes the log file.
+func (c *logCache) Close() error {
+	// Finish all in-progress operations.
+	if err := c.SetMode(mode.ReadOnly); err != nil {
+		return err
+	}
+
+	if c.closeCh != nil {
+		close(c.closeCh)
+	}
+	c.wg.Wait()
+	c.closeCh = nil
+
+	c.modeMtx.Lock()
+	defer c.modeMtx.Unlock()
+
+	err := c.closeLog()
+
+	// The log state is restored from the file on the next Open.
+	c.index = nil
+	c.records = nil
+	return err
+}
+
+// SetMode sets write-cache mode of operation.
+// When the mode doesn't allow to use the metabase, all objects are flushed
+// and the log file is closed.
+func (c *logCache) SetMode(m mode.Mode) error {
+	c.modeMtx.Lock()
+	defer c.modeMtx.Unlock()
+
+	if m.NoMetabase() && !c.mode.NoMetabase() {
+		if err := c.flush(true); err != nil {
+			return err
+		}
+	}
+
+	if err := c.closeLog(); err != nil {
+		return fmt.Errorf("can't close write-cache log: %w", err)
+	}
+
+	if !m.NoMetabase() {
+		if err := c.openLog(m.ReadOnly()); err != nil {
+			return err
+		}
+	}
+
+	c.mode = m
+	return nil
+}
+
+// readOnly returns true if current mode is read-only.
+// `c.modeMtx` must be taken.
+func (c *logCache) readOnly() bool {
+	return c.mode.ReadOnly()
+}
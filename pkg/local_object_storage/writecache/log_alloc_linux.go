@@ -0,0 +1,20 @@
+//go:build linux
+
+package writecache
+
+import (
+	"errors"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// preallocate allocates disk space for the file of the specified size.
+// Zeros are written if the file system does not support fallocate.
+func preallocate(f *os.File, size int64) error {
+	err := unix.Fallocate(int(f.Fd()), 0, 0, size)
+	if errors.Is(err, unix.EOPNOTSUPP) || errors.Is(err, unix.ENOSYS) {
+		return writeZeros(f, size)
+	}
+	return err
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+
+package writecache
+
+import "os"
+
+// preallocate allocates disk space for the file of the specified size.
+func preallocate(f *os.File, size int64) error {
+	return writeZeros(f, size)
+}
@@ -0,0 +1,46 @@
+package writecache
+
+import (
+	storagelog "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/internal/log"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Delete removes object from write-cache by appending the delete record to the log.
+//
+// Returns an error of type apistatus.ObjectNotFound if object is missing in write-cache.
+func (c *logCache) Delete(addr oid.Address) error {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+	if c.readOnly() {
+		return ErrReadOnly
+	}
+
+	c.mtx.Lock()
+	rec, ok := c.index[addr]
+	if !ok {
+		c.mtx.Unlock()
+		return logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	del, err := c.appendRecord(recordDelete, addr, nil)
+	if err == nil {
+		c.markDead(rec)
+	}
+	c.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := c.syncLog(del.seq); err != nil {
+		return err
+	}
+
+	storagelog.Write(c.log,
+		storagelog.AddressField(addr),
+		storagelog.StorageTypeField(wcStorageType),
+		storagelog.OpField("log DELETE"),
+	)
+	return nil
+}
@@ -0,0 +1,197 @@
+package writecache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"go.uber.org/zap"
+)
+
+// runFlushLoop starts background worker which periodically flushes objects to the blobstor.
+func (c *logCache) runFlushLoop() {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		tt := time.NewTimer(defaultFlushInterval)
+		defer tt.Stop()
+
+		for {
+			select {
+			case <-tt.C:
+				c.flushLog()
+				tt.Reset(defaultFlushInterval)
+			case <-c.closeCh:
+				return
+			}
+		}
+	}()
+}
+
+// flushLog flushes objects from the log in batches and persists the log head,
+// so that the space of the flushed objects can be reused.
+func (c *logCache) flushLog() {
+	var seq uint64
+	for {
+		select {
+		case <-c.closeCh:
+			return
+		default:
+		}
+
+		c.modeMtx.RLock()
+		if c.readOnly() || c.file == nil {
+			c.modeMtx.RUnlock()
+			return
+		}
+
+		recs := c.liveRecords(seq, flushBatchSize)
+		if len(recs) == 0 {
+			c.modeMtx.RUnlock()
+			return
+		}
+
+		c.flushBatch(recs)
+
+		c.mtx.Lock()
+		err := c.persistHead()
+		c.mtx.Unlock()
+		if err != nil {
+			c.log.Error("can't persist write-cache log head", zap.Error(err))
+		}
+
+		c.modeMtx.RUnlock()
+
+		seq = recs[len(recs)-1].seq + 1
+		c.log.Debug("tried to flush items from write-cache",
+			zap.Int("count", len(recs)))
+	}
+}
+
+// flushBatch flushes objects from the records in parallel.
+func (c *logCache) flushBatch(recs []*logRecord) {
+	ch := make(chan *logRecord)
+
+	var wg sync.WaitGroup
+	for i := 0; i < c.workersCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rec := range ch {
+				_ = c.flushRecord(rec, true)
+			}
+		}()
+	}
+
+loop:
+	for i := range recs {
+		select {
+		case ch <- recs[i]:
+		case <-c.closeCh:
+			break loop
+		}
+	}
+
+	close(ch)
+	wg.Wait()
+}
+
+// flushRecord writes object from the record to the main storage and marks the record as dead.
+func (c *logCache) flushRecord(rec *logRecord, ignoreErrors bool) error {
+	if c.isDead(rec) {
+		return nil
+	}
+
+	sAddr := rec.addr.EncodeToString()
+
+	data, err := c.readRecord(rec)
+	if err != nil {
+		if c.isDead(rec) {
+			return nil
+		}
+
+		c.reportFlushError("can't read an object from the log", sAddr, err)
+		if ignoreErrors {
+			return nil
+		}
+		return err
+	}
+
+	var obj object.Object
+	if err := obj.Unmarshal(data); err != nil {
+		c.reportFlushError("can't unmarshal an object from the log", sAddr, err)
+		if ignoreErrors {
+			return nil
+		}
+		return err
+	}
+
+	if err := c.flushObject(&obj, data); err != nil {
+		return err
+	}
+
+	c.mtx.Lock()
+	c.markDead(rec)
+	c.mtx.Unlock()
+	return nil
+}
+
+// Flush flushes all objects from the write-cache to the main storage.
+// Write-cache must be in readonly mode to ensure correctness of an operation and
+// to prevent interference with background flush workers.
+func (c *logCache) Flush(ignoreErrors bool) error {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	return c.flush(ignoreErrors)
+}
+
+func (c *logCache) flush(ignoreErrors bool) error {
+	if c.file == nil {
+		return nil
+	}
+
+	for _, rec := range c.liveRecords(0, 0) {
+		if err := c.flushRecord(rec, ignoreErrors); err != nil {
+			return err
+		}
+	}
+
+	if c.fileRO {
+		return nil
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.persistHead()
+}
+
+// dropFlushed marks objects which are already in the main storage as flushed.
+func (c *logCache) dropFlushed() {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	if c.file == nil || c.fileRO {
+		return
+	}
+
+	c.log.Info("filling flush marks for objects in the log")
+
+	for _, rec := range c.liveRecords(0, 0) {
+		if flushed, _ := c.flushStatus(rec.addr); flushed {
+			c.mtx.Lock()
+			c.markDead(rec)
+			c.mtx.Unlock()
+		}
+	}
+
+	c.mtx.Lock()
+	err := c.persistHead()
+	c.mtx.Unlock()
+	if err != nil {
+		c.log.Error("can't persist write-cache log head", zap.Error(err))
+	}
+
+	c.log.Info("finished updating flush marks")
+}
@@ -0,0 +1,48 @@
+package writecache
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Get returns object from write-cache.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
+func (c *logCache) Get(addr oid.Address) (*objectSDK.Object, error) {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	if c.file == nil {
+		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	c.mtx.Lock()
+	rec, ok := c.index[addr]
+	c.mtx.Unlock()
+	if !ok {
+		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	data, err := c.readRecord(rec)
+	if err != nil {
+		// The object was flushed and the record was overwritten concurrently.
+		return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+	}
+
+	obj := objectSDK.New()
+	return obj, obj.Unmarshal(data)
+}
+
+// Head returns object header from write-cache.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
+func (c *logCache) Head(addr oid.Address) (*objectSDK.Object, error) {
+	obj, err := c.Get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.CutPayload(), nil
+}
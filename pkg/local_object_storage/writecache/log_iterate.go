@@ -0,0 +1,27 @@
+package writecache
+
+// Iterate iterates over all objects present in write cache.
+// This is very difficult to do correctly unless write-cache is put in read-only mode.
+// Thus we silently fail if shard is not in read-only mode to avoid reporting misleading results.
+func (c *logCache) Iterate(prm IterationPrm) error {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+	if !c.readOnly() || c.file == nil {
+		return nil
+	}
+
+	for _, rec := range c.liveRecords(0, 0) {
+		data, err := c.readRecord(rec)
+		if err != nil {
+			if prm.ignoreErrors {
+				continue
+			}
+			return err
+		}
+
+		if err := prm.handler(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package writecache
+
+import (
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	storagelog "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/internal/log"
+)
+
+// Put appends object to the write-cache log and waits until it is persisted.
+func (c *logCache) Put(prm common.PutPrm) (common.PutRes, error) {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+	if c.readOnly() {
+		return common.PutRes{}, ErrReadOnly
+	}
+
+	sz := uint64(len(prm.RawData))
+	if sz > c.maxObjectSize {
+		return common.PutRes{}, ErrBigObject
+	}
+
+	c.mtx.Lock()
+	rec, err := c.appendRecord(recordPut, prm.Address, prm.RawData)
+	if err == nil {
+		if old, ok := c.index[prm.Address]; ok {
+			old.dead = true
+		}
+		c.index[prm.Address] = rec
+		c.records = append(c.records, rec)
+	}
+	c.mtx.Unlock()
+	if err != nil {
+		return common.PutRes{}, err
+	}
+
+	if err := c.syncLog(rec.seq); err != nil {
+		return common.PutRes{}, err
+	}
+
+	storagelog.Write(c.log,
+		storagelog.AddressField(prm.Address),
+		storagelog.StorageTypeField(wcStorageType),
+		storagelog.OpField("log PUT"),
+	)
+	return common.PutRes{}, nil
+}
@@ -0,0 +1,155 @@
+package writecache
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Log file layout:
+//
+//	header (logHeaderSize bytes) | record | record | ... | record
+//
+// The header contains the offset and the sequence number of the first record
+// which must be replayed on startup. Every record has the following format:
+//
+//	checksum (4 bytes) | seq (8 bytes) | kind (1 byte) | data length (8 bytes) | address (64 bytes) | data
+//
+// Records are written one after another with increasing sequence numbers.
+// If a record doesn't fit in the end of the file, it is written to the beginning
+// and the wrap record is written in place of it, if there is a space for its header.
+const (
+	logName = "wc.log"
+	// logHeaderSize is the size of the log header. Records start right after it.
+	logHeaderSize = 4096
+	// logHeaderLen is the length of the meaningful part of the log header.
+	logHeaderLen = 4 + len(logMagic) + 8 + 8 + 8
+	logMagic     = "FSWCLOG1"
+
+	// recHeaderSize is the size of the record header.
+	recHeaderSize = 4 + 8 + 1 + 8 + 64
+)
+
+const (
+	recordPut byte = iota + 1
+	recordDelete
+	recordWrap
+)
+
+var (
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	errInvalidChecksum = errors.New("invalid checksum")
+	errInvalidRecord   = errors.New("invalid record")
+)
+
+// logHead is the position of the first record to replay on startup.
+type logHead struct {
+	offset uint64
+	seq    uint64
+}
+
+// recordHeader represents the header of the log record.
+type recordHeader struct {
+	seq     uint64
+	kind    byte
+	dataLen uint64
+	addr    oid.Address
+}
+
+// encodeLogHeader returns the log header with the specified file size and head.
+func encodeLogHeader(size uint64, head logHead) []byte {
+	buf := make([]byte, logHeaderLen)
+	copy(buf[4:], logMagic)
+	binary.LittleEndian.PutUint64(buf[12:], size)
+	binary.LittleEndian.PutUint64(buf[20:], head.offset)
+	binary.LittleEndian.PutUint64(buf[28:], head.seq)
+	binary.LittleEndian.PutUint32(buf, crc32.Checksum(buf[4:], crcTable))
+	return buf
+}
+
+// decodeLogHeader returns the file size and the head from the log header.
+func decodeLogHeader(buf []byte) (uint64, logHead, error) {
+	if len(buf) < logHeaderLen || string(buf[4:12]) != logMagic {
+		return 0, logHead{}, errors.New("invalid magic")
+	}
+	if binary.LittleEndian.Uint32(buf) != crc32.Checksum(buf[4:logHeaderLen], crcTable) {
+		return 0, logHead{}, errInvalidChecksum
+	}
+
+	size := binary.LittleEndian.Uint64(buf[12:])
+	head := logHead{
+		offset: binary.LittleEndian.Uint64(buf[20:]),
+		seq:    binary.LittleEndian.Uint64(buf[28:]),
+	}
+	if size < logHeaderSize || head.offset < logHeaderSize || head.offset > size {
+		return 0, logHead{}, fmt.Errorf("invalid head offset %d for the log of size %d", head.offset, size)
+	}
+	return size, head, nil
+}
+
+// encodeRecordHeader returns the header of the record with the specified data.
+func encodeRecordHeader(h recordHeader, data []byte) []byte {
+	buf := make([]byte, recHeaderSize)
+	binary.LittleEndian.PutUint64(buf[4:], h.seq)
+	buf[12] = h.kind
+	binary.LittleEndian.PutUint64(buf[13:], uint64(len(data)))
+	h.addr.Container().Encode(buf[21:])
+	h.addr.Object().Encode(buf[53:])
+
+	sum := crc32.Update(crc32.Checksum(buf[4:], crcTable), crcTable, data)
+	binary.LittleEndian.PutUint32(buf, sum)
+	return buf
+}
+
+// decodeRecordHeader decodes the record header without checksum verification.
+func decodeRecordHeader(buf []byte) (recordHeader, error) {
+	if len(buf) < recHeaderSize {
+		return recordHeader{}, errInvalidRecord
+	}
+
+	h := recordHeader{
+		seq:     binary.LittleEndian.Uint64(buf[4:]),
+		kind:    buf[12],
+		dataLen: binary.LittleEndian.Uint64(buf[13:]),
+	}
+	switch h.kind {
+	case recordPut, recordDelete:
+		var cnr cid.ID
+		if err := cnr.Decode(buf[21:53]); err != nil {
+			return recordHeader{}, fmt.Errorf("invalid container ID: %w", err)
+		}
+
+		var obj oid.ID
+		if err := obj.Decode(buf[53:85]); err != nil {
+			return recordHeader{}, fmt.Errorf("invalid object ID: %w", err)
+		}
+
+		h.addr.SetContainer(cnr)
+		h.addr.SetObject(obj)
+	case recordWrap:
+	default:
+		return recordHeader{}, errInvalidRecord
+	}
+	return h, nil
+}
+
+// decodeRecord decodes the whole record and verifies its checksum.
+// The returned data shares the memory with buf.
+func decodeRecord(buf []byte) (recordHeader, []byte, error) {
+	h, err := decodeRecordHeader(buf)
+	if err != nil {
+		return recordHeader{}, nil, err
+	}
+	if h.dataLen != uint64(len(buf)-recHeaderSize) {
+		return recordHeader{}, nil, errInvalidRecord
+	}
+	if binary.LittleEndian.Uint32(buf) != crc32.Checksum(buf[4:], crcTable) {
+		return recordHeader{}, nil, errInvalidChecksum
+	}
+	return h, buf[recHeaderSize:], nil
+}
@@ -0,0 +1,414 @@
+package writecache
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// errRecordOverwritten is returned when the record was overwritten after the object was flushed.
+var errRecordOverwritten = errors.New("record was overwritten")
+
+// openLog opens the log file creating it if needed. The log state is restored
+// from the file if it was not restored before.
+func (c *logCache) openLog(readOnly bool) error {
+	p := filepath.Join(c.path, logName)
+
+	flag := os.O_RDONLY
+	if !readOnly {
+		if err := util.MkdirAllX(c.path, os.ModePerm); err != nil {
+			return err
+		}
+		flag = os.O_RDWR | os.O_CREATE
+	}
+
+	f, err := os.OpenFile(p, flag, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("could not open write-cache log: %w", err)
+	}
+
+	st, err := f.Stat()
+	if err == nil && st.Size() == 0 && !readOnly {
+		err = createLog(f, logHeaderSize+c.maxCacheSize, c.noSync)
+	}
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("could not create write-cache log: %w", err)
+	}
+
+	c.file = f
+	c.fileRO = readOnly
+
+	if c.index == nil {
+		if err := c.recover(); err != nil {
+			_ = f.Close()
+			c.file = nil
+			return fmt.Errorf("could not read write-cache log: %w", err)
+		}
+	}
+	return nil
+}
+
+// createLog preallocates the log file of the specified size and writes an empty header.
+// Disk space is allocated in advance, so that writes to the log do not fail with ENOSPC.
+func createLog(f *os.File, size uint64, noSync bool) error {
+	if err := preallocate(f, int64(size)); err != nil {
+		return err
+	}
+
+	hdr := encodeLogHeader(size, logHead{offset: logHeaderSize})
+	if _, err := f.WriteAt(hdr, 0); err != nil {
+		return err
+	}
+	if noSync {
+		return nil
+	}
+	return f.Sync()
+}
+
+// writeZeros allocates disk space for the file by filling it with zeros.
+func writeZeros(f *os.File, size int64) error {
+	buf := make([]byte, 1<<20)
+	for off := int64(0); off < size; off += int64(len(buf)) {
+		if rem := size - off; rem < int64(len(buf)) {
+			buf = buf[:rem]
+		}
+		if _, err := f.WriteAt(buf, off); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closeLog persists the log head and closes the log file.
+// `c.modeMtx` must be taken.
+func (c *logCache) closeLog() error {
+	if c.file == nil {
+		return nil
+	}
+
+	var err error
+	if !c.fileRO {
+		c.mtx.Lock()
+		err = c.persistHead()
+		c.mtx.Unlock()
+	}
+
+	if cErr := c.file.Close(); err == nil {
+		err = cErr
+	}
+	c.file = nil
+	return err
+}
+
+// recover restores the log state by replaying the records starting from the persisted head.
+func (c *logCache) recover() error {
+	buf := make([]byte, logHeaderLen)
+	if _, err := c.file.ReadAt(buf, 0); err != nil {
+		return err
+	}
+
+	size, head, err := decodeLogHeader(buf)
+	if err != nil {
+		return fmt.Errorf("invalid log header: %w", err)
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.index = make(map[oid.Address]*logRecord)
+	c.records = nil
+	c.size = size
+	c.persisted = head
+
+	pos, seq := head.offset, head.seq
+	for {
+		if c.size-pos < recHeaderSize {
+			pos = logHeaderSize
+		}
+
+		h, err := c.readRecordAt(pos)
+		if err != nil || h.seq != seq {
+			// The end of the log.
+			break
+		}
+
+		switch h.kind {
+		case recordWrap:
+			seq++
+			pos = logHeaderSize
+			continue
+		case recordPut:
+			rec := &logRecord{
+				addr:    h.addr,
+				offset:  pos,
+				seq:     h.seq,
+				dataLen: h.dataLen,
+			}
+			if old, ok := c.index[h.addr]; ok {
+				old.dead = true
+			}
+			c.index[h.addr] = rec
+			c.records = append(c.records, rec)
+		case recordDelete:
+			if rec, ok := c.index[h.addr]; ok {
+				rec.dead = true
+				delete(c.index, h.addr)
+			}
+		}
+
+		seq++
+		pos += recHeaderSize + h.dataLen
+	}
+
+	c.tail = pos
+	c.nextSeq = seq
+	c.written.Store(seq)
+
+	c.syncMtx.Lock()
+	c.synced = seq
+	c.syncMtx.Unlock()
+
+	c.log.Debug("write-cache log is restored",
+		zap.Int("objects", len(c.index)),
+		zap.Uint64("head", head.offset),
+		zap.Uint64("tail", c.tail))
+	return nil
+}
+
+// readRecordAt reads the whole record at the specified offset and verifies its checksum.
+func (c *logCache) readRecordAt(offset uint64) (recordHeader, error) {
+	buf := make([]byte, recHeaderSize)
+	if _, err := c.file.ReadAt(buf, int64(offset)); err != nil {
+		return recordHeader{}, err
+	}
+
+	h, err := decodeRecordHeader(buf)
+	if err != nil {
+		return recordHeader{}, err
+	}
+	if h.dataLen > c.size-offset-recHeaderSize {
+		return recordHeader{}, errInvalidRecord
+	}
+
+	buf = make([]byte, recHeaderSize+h.dataLen)
+	if _, err := c.file.ReadAt(buf, int64(offset)); err != nil {
+		return recordHeader{}, err
+	}
+
+	h, _, err = decodeRecord(buf)
+	return h, err
+}
+
+// readRecord returns the object data stored in the record.
+// errRecordOverwritten is returned if the record space was reused.
+func (c *logCache) readRecord(rec *logRecord) ([]byte, error) {
+	buf := make([]byte, recHeaderSize+rec.dataLen)
+	if _, err := c.file.ReadAt(buf, int64(rec.offset)); err != nil {
+		return nil, err
+	}
+
+	h, data, err := decodeRecord(buf)
+	if err != nil || h.seq != rec.seq || h.addr != rec.addr {
+		return nil, errRecordOverwritten
+	}
+	return data, nil
+}
+
+// appendRecord writes the record to the tail of the log.
+// `c.mtx` must be taken.
+func (c *logCache) appendRecord(kind byte, addr oid.Address, data []byte) (*logRecord, error) {
+	n := recHeaderSize + uint64(len(data))
+	pos, err := c.allocate(n)
+	if err != nil {
+		return nil, err
+	}
+
+	seq := c.nextSeq
+	if pos != c.tail && c.size-c.tail >= recHeaderSize {
+		hdr := encodeRecordHeader(recordHeader{seq: seq, kind: recordWrap}, nil)
+		if _, err := c.file.WriteAt(hdr, int64(c.tail)); err != nil {
+			return nil, err
+		}
+		seq++
+	}
+
+	hdr := encodeRecordHeader(recordHeader{seq: seq, kind: kind, addr: addr}, data)
+	if _, err := c.file.WriteAt(hdr, int64(pos)); err != nil {
+		return nil, err
+	}
+	if _, err := c.file.WriteAt(data, int64(pos+recHeaderSize)); err != nil {
+		return nil, err
+	}
+
+	c.tail = pos + n
+	c.nextSeq = seq + 1
+	c.written.Store(c.nextSeq)
+
+	return &logRecord{
+		addr:    addr,
+		offset:  pos,
+		seq:     seq,
+		dataLen: uint64(len(data)),
+	}, nil
+}
+
+// allocate returns the offset of the free space of the specified size.
+// `c.mtx` must be taken.
+func (c *logCache) allocate(n uint64) (uint64, error) {
+	if pos, ok := c.findSpace(n); ok {
+		return pos, nil
+	}
+
+	// The head could have been moved after it was persisted.
+	if err := c.persistHead(); err != nil {
+		return 0, err
+	}
+	if pos, ok := c.findSpace(n); ok {
+		return pos, nil
+	}
+	return 0, ErrOutOfSpace
+}
+
+// findSpace returns the offset of the free space of the specified size.
+// The space after the persisted head is never reused.
+// `c.mtx` must be taken.
+func (c *logCache) findSpace(n uint64) (uint64, bool) {
+	empty := c.persisted.seq == c.nextSeq
+	head := c.persisted.offset
+
+	switch {
+	case empty || c.tail > head:
+		if c.tail+n <= c.size {
+			return c.tail, true
+		}
+
+		end := head
+		if empty {
+			end = c.size
+		}
+		return logHeaderSize, logHeaderSize+n <= end
+	case c.tail < head:
+		return c.tail, c.tail+n <= head
+	default:
+		// The log is full.
+		return 0, false
+	}
+}
+
+// head returns the position of the first object which is not flushed or removed.
+// `c.mtx` must be taken.
+func (c *logCache) head() logHead {
+	for len(c.records) != 0 && c.records[0].dead {
+		c.records[0] = nil
+		c.records = c.records[1:]
+	}
+	if len(c.records) == 0 {
+		return logHead{offset: c.tail, seq: c.nextSeq}
+	}
+	return logHead{offset: c.records[0].offset, seq: c.records[0].seq}
+}
+
+// persistHead writes the current head to the log header,
+// so that the space before it can be reused.
+// `c.mtx` must be taken.
+func (c *logCache) persistHead() error {
+	head := c.head()
+	if head == c.persisted {
+		return nil
+	}
+
+	if _, err := c.file.WriteAt(encodeLogHeader(c.size, head), 0); err != nil {
+		return fmt.Errorf("could not write log header: %w", err)
+	}
+	if !c.noSync {
+		if err := c.file.Sync(); err != nil {
+			return fmt.Errorf("could not sync log header: %w", err)
+		}
+	}
+
+	c.persisted = head
+	return nil
+}
+
+// syncLog waits until the record with the specified sequence number is persisted.
+// Concurrent writers share a single fsync.
+// `c.modeMtx` must be taken.
+func (c *logCache) syncLog(seq uint64) error {
+	if c.noSync {
+		return nil
+	}
+
+	c.syncMtx.Lock()
+	defer c.syncMtx.Unlock()
+
+	for c.synced <= seq {
+		if c.syncing {
+			c.syncCond.Wait()
+			continue
+		}
+
+		c.syncing = true
+		target := c.written.Load()
+
+		c.syncMtx.Unlock()
+		err := c.file.Sync()
+		c.syncMtx.Lock()
+
+		c.syncing = false
+		c.syncCond.Broadcast()
+		if err != nil {
+			return fmt.Errorf("could not sync write-cache log: %w", err)
+		}
+		if c.synced < target {
+			c.synced = target
+		}
+	}
+	return nil
+}
+
+// liveRecords returns at most limit records which are not flushed or removed
+// starting from the specified sequence number. All records are returned if limit is 0.
+func (c *logCache) liveRecords(seq uint64, limit int) []*logRecord {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	i := sort.Search(len(c.records), func(i int) bool {
+		return c.records[i].seq >= seq
+	})
+
+	var res []*logRecord
+	for ; i < len(c.records) && (limit == 0 || len(res) < limit); i++ {
+		if !c.records[i].dead {
+			res = append(res, c.records[i])
+		}
+	}
+	return res
+}
+
+// isDead returns true iff the object from the record was flushed or removed.
+func (c *logCache) isDead(rec *logRecord) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return rec.dead
+}
+
+// markDead marks the object from the record as flushed or removed,
+// so that the record space can be reused.
+// `c.mtx` must be taken.
+func (c *logCache) markDead(rec *logRecord) {
+	if rec.dead {
+		return
+	}
+
+	rec.dead = true
+	if c.index[rec.addr] == rec {
+		delete(c.index, rec.addr)
+	}
+}
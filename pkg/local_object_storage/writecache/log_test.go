@@ -0,0 +1,196 @@
+package writecache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/metabase"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestLogCache(t *testing.T) {
+	const objSize = 1024
+
+	newCache := func(t *testing.T, capacity uint64) (Cache, *blobstor.BlobStor) {
+		dir := t.TempDir()
+		mb := meta.New(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(dummyEpoch{}))
+		require.NoError(t, mb.Open(false))
+		require.NoError(t, mb.Init())
+		t.Cleanup(func() { require.NoError(t, mb.Close()) })
+
+		bs := blobstor.New(blobstor.WithStorages([]blobstor.SubStorage{
+			{Storage: fstree.New(
+				fstree.WithPath(filepath.Join(dir, "blob")),
+				fstree.WithDepth(0),
+				fstree.WithDirNameLen(1))},
+		}))
+		require.NoError(t, bs.Open(false))
+		require.NoError(t, bs.Init())
+		t.Cleanup(func() { require.NoError(t, bs.Close()) })
+
+		wc := New(
+			WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+			WithType(TypeLog),
+			WithPath(filepath.Join(dir, "writecache")),
+			WithMaxCacheSize(capacity),
+			WithMetabase(mb),
+			WithBlobstor(bs))
+		require.NoError(t, wc.Open(false))
+		return wc, bs
+	}
+
+	checkInCache := func(t *testing.T, wc Cache, objects []objectPair) {
+		for i := range objects {
+			obj, err := wc.Get(objects[i].addr)
+			require.NoError(t, err, i)
+			require.Equal(t, objects[i].obj, obj, i)
+		}
+	}
+	checkFlushed := func(t *testing.T, wc Cache, bs *blobstor.BlobStor, objects []objectPair) {
+		for i := range objects {
+			_, err := wc.Get(objects[i].addr)
+			require.ErrorAs(t, err, new(apistatus.ObjectNotFound), i)
+
+			res, err := bs.Get(common.GetPrm{Address: objects[i].addr})
+			require.NoError(t, err, i)
+			require.Equal(t, objects[i].obj, res.Object, i)
+		}
+	}
+
+	t.Run("reopen", func(t *testing.T) {
+		wc, _ := newCache(t, 1<<20)
+
+		objects := make([]objectPair, 8)
+		for i := range objects {
+			objects[i] = putObject(t, wc, objSize)
+		}
+		require.NoError(t, wc.Delete(objects[0].addr))
+		require.ErrorAs(t, wc.Delete(objects[0].addr), new(apistatus.ObjectNotFound))
+
+		require.NoError(t, wc.Close())
+		require.NoError(t, wc.Open(true))
+
+		_, err := wc.Get(objects[0].addr)
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+		checkInCache(t, wc, objects[1:])
+
+		var count int
+		var prm IterationPrm
+		prm.WithHandler(func([]byte) error {
+			count++
+			return nil
+		})
+		require.NoError(t, wc.Iterate(prm))
+		require.Equal(t, len(objects)-1, count)
+
+		require.NoError(t, wc.Close())
+	})
+
+	t.Run("flush", func(t *testing.T) {
+		wc, bs := newCache(t, 1<<20)
+
+		objects := make([]objectPair, 8)
+		for i := range objects {
+			objects[i] = putObject(t, wc, objSize)
+		}
+
+		require.NoError(t, wc.Flush(false))
+		checkFlushed(t, wc, bs, objects)
+
+		require.NoError(t, wc.Close())
+		require.NoError(t, wc.Open(false))
+		require.NoError(t, wc.Init())
+		checkFlushed(t, wc, bs, objects)
+		require.NoError(t, wc.Close())
+	})
+
+	t.Run("wrap around", func(t *testing.T) {
+		// Fits 7 objects.
+		wc, bs := newCache(t, 8*objSize)
+
+		var flushed, cached []objectPair
+		for i := 0; i < 30; i++ {
+			cached = append(cached, putObject(t, wc, objSize))
+			if len(cached) == 4 {
+				require.NoError(t, wc.Flush(false))
+				flushed = append(flushed, cached...)
+				cached = cached[:0]
+			}
+		}
+
+		checkInCache(t, wc, cached)
+		checkFlushed(t, wc, bs, flushed)
+
+		require.NoError(t, wc.Close())
+		require.NoError(t, wc.Open(false))
+		require.NoError(t, wc.Init())
+
+		checkInCache(t, wc, cached)
+		checkFlushed(t, wc, bs, flushed)
+		require.NoError(t, wc.Close())
+	})
+
+	t.Run("out of space", func(t *testing.T) {
+		wc, _ := newCache(t, 8*objSize)
+
+		var objects []objectPair
+		for {
+			obj, data := newObject(t, objSize)
+
+			var prm common.PutPrm
+			prm.Address = objectCore.AddressOf(obj)
+			prm.Object = obj
+			prm.RawData = data
+
+			_, err := wc.Put(prm)
+			if err != nil {
+				require.ErrorIs(t, err, ErrOutOfSpace)
+				break
+			}
+			objects = append(objects, objectPair{prm.Address, obj})
+		}
+		require.NotEmpty(t, objects)
+
+		// Space of the removed object is reused.
+		require.NoError(t, wc.Delete(objects[0].addr))
+		objects = append(objects[1:], putObject(t, wc, objSize))
+
+		require.NoError(t, wc.Close())
+		require.NoError(t, wc.Open(true))
+		checkInCache(t, wc, objects)
+		require.NoError(t, wc.Close())
+	})
+}
+
+func TestCreateLog(t *testing.T) {
+	const size = 3<<20 + 1
+
+	check := func(t *testing.T, alloc func(*os.File, int64) error) {
+		f, err := os.Create(filepath.Join(t.TempDir(), logName))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		require.NoError(t, alloc(f, size))
+
+		data, err := os.ReadFile(f.Name())
+		require.NoError(t, err)
+		require.Equal(t, make([]byte, size), data)
+	}
+
+	t.Run("preallocate", func(t *testing.T) {
+		check(t, preallocate)
+	})
+	t.Run("write zeros", func(t *testing.T) {
+		check(t, writeZeros)
+	})
+}
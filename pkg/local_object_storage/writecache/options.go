@@ -30,6 +30,8 @@ type blob interface {
 
 type options struct {
 	log *logger.Logger
+	// typ is the write-cache implementation type.
+	typ string
 	// path is a path to a directory for write-cache.
 	path string
 	// blobstor is the main persistent storage.
@@ -64,6 +66,14 @@ func WithLogger(log *logger.Logger) Option {
 	}
 }
 
+// WithType sets write-cache implementation type.
+// TypeBBolt is used if the type is empty.
+func WithType(typ string) Option {
+	return func(o *options) {
+		o.typ = typ
+	}
+}
+
 // WithPath sets path to writecache db.
 func WithPath(path string) Option {
 	return func(o *options) {
@@ -67,6 +67,15 @@ type cache struct {
 	fsTree *fstree.FSTree
 }
 
+const (
+	// TypeBBolt is the write-cache storing small objects in a bbolt database
+	// and big objects in a file-system tree.
+	TypeBBolt = "bbolt"
+	// TypeLog is the write-cache storing all objects in a single preallocated
+	// append-only log file.
+	TypeLog = "log"
+)
+
 // wcStorageType is used for write-cache operations logging.
 const wcStorageType = "write-cache"
 
@@ -108,6 +117,10 @@ func New(opts ...Option) Cache {
 		opts[i](&c.options)
 	}
 
+	if c.typ == TypeLog {
+		return newLogCache(c.options)
+	}
+
 	// Make the LRU cache contain which take approximately 3/4 of the maximum space.
 	// Assume small and big objects are stored in 50-50 proportion.
 	c.maxFlushedMarksCount = int(c.maxCacheSize/c.maxObjectSize+c.maxCacheSize/c.smallObjectSize) / 2 * 3 / 4
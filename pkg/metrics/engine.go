@@ -21,6 +21,10 @@ type (
 		listObjectsDuration           prometheus.Counter
 		containerSize                 prometheus.GaugeVec
 		payloadSize                   prometheus.GaugeVec
+		compressionRatio              prometheus.HistogramVec
+		compressionSkipped            prometheus.CounterVec
+		segmentCompactions            prometheus.CounterVec
+		segmentReclaimedBytes         prometheus.CounterVec
 	}
 )
 
@@ -118,6 +122,35 @@ func newEngineMetrics() engineMetrics {
 			Name:      "payload_size",
 			Help:      "Accumulated size of all objects in a shard",
 		}, []string{shardIDLabelKey})
+
+		compressionRatio = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "compression_ratio",
+			Help:      "Ratio of the original size of compressed objects to the stored one",
+			Buckets:   []float64{1, 1.1, 1.25, 1.5, 2, 3, 5, 10},
+		}, []string{shardIDLabelKey})
+
+		compressionSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "compression_skipped",
+			Help:      "Number of objects stored uncompressed because of poor compression ratio",
+		}, []string{shardIDLabelKey})
+
+		segmentCompactions = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "segment_compactions",
+			Help:      "Number of compacted segments of the segment sub-storage",
+		}, []string{shardIDLabelKey})
+
+		segmentReclaimedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "segment_reclaimed_bytes",
+			Help:      "Amount of disk space reclaimed by the segment compaction",
+		}, []string{shardIDLabelKey})
 	)
 
 	return engineMetrics{
@@ -134,6 +167,10 @@ func newEngineMetrics() engineMetrics {
 		listObjectsDuration:           listObjectsDuration,
 		containerSize:                 *containerSize,
 		payloadSize:                   *payloadSize,
+		compressionRatio:              *compressionRatio,
+		compressionSkipped:            *compressionSkipped,
+		segmentCompactions:            *segmentCompactions,
+		segmentReclaimedBytes:         *segmentReclaimedBytes,
 	}
 }
 
@@ -151,6 +188,10 @@ func (m engineMetrics) register() {
 	prometheus.MustRegister(m.listObjectsDuration)
 	prometheus.MustRegister(m.containerSize)
 	prometheus.MustRegister(m.payloadSize)
+	prometheus.MustRegister(m.compressionRatio)
+	prometheus.MustRegister(m.compressionSkipped)
+	prometheus.MustRegister(m.segmentCompactions)
+	prometheus.MustRegister(m.segmentReclaimedBytes)
 }
 
 func (m engineMetrics) AddListContainersDuration(d time.Duration) {
@@ -204,3 +245,19 @@ func (m engineMetrics) AddToContainerSize(cnrID string, size int64) {
 func (m engineMetrics) AddToPayloadCounter(shardID string, size int64) {
 	m.payloadSize.With(prometheus.Labels{shardIDLabelKey: shardID}).Add(float64(size))
 }
+
+func (m engineMetrics) ObserveCompressionRatio(shardID string, ratio float64) {
+	m.compressionRatio.With(prometheus.Labels{shardIDLabelKey: shardID}).Observe(ratio)
+}
+
+func (m engineMetrics) IncCompressionSkipped(shardID string) {
+	m.compressionSkipped.With(prometheus.Labels{shardIDLabelKey: shardID}).Inc()
+}
+
+func (m engineMetrics) IncSegmentCompactions(shardID string) {
+	m.segmentCompactions.With(prometheus.Labels{shardIDLabelKey: shardID}).Inc()
+}
+
+func (m engineMetrics) AddSegmentReclaimedBytes(shardID string, size uint64) {
+	m.segmentReclaimedBytes.With(prometheus.Labels{shardIDLabelKey: shardID}).Add(float64(size))
+}
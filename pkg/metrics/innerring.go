@@ -6,7 +6,8 @@ const innerRingSubsystem = "object"
 
 // InnerRingServiceMetrics contains metrics collected by inner ring.
 type InnerRingServiceMetrics struct {
-	epoch prometheus.Gauge
+	epoch         prometheus.Gauge
+	rejectedNodes *prometheus.CounterVec
 }
 
 // NewInnerRingMetrics returns new instance of metrics collectors for inner ring.
@@ -18,12 +19,21 @@ func NewInnerRingMetrics() InnerRingServiceMetrics {
 			Name:      "epoch",
 			Help:      "Current epoch as seen by inner-ring node.",
 		})
+
+		rejectedNodes = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: innerRingSubsystem,
+			Name:      "rejected_nodes",
+			Help:      "Number of network map candidates rejected by node validation rules.",
+		}, []string{"reason"})
 	)
 
 	prometheus.MustRegister(epoch)
+	prometheus.MustRegister(rejectedNodes)
 
 	return InnerRingServiceMetrics{
-		epoch: epoch,
+		epoch:         epoch,
+		rejectedNodes: rejectedNodes,
 	}
 }
 
@@ -31,3 +41,9 @@ func NewInnerRingMetrics() InnerRingServiceMetrics {
 func (m InnerRingServiceMetrics) SetEpoch(epoch uint64) {
 	m.epoch.Set(float64(epoch))
 }
+
+// IncRejectedNodes increments the number of network map candidates
+// rejected by node validation rules with the reason.
+func (m InnerRingServiceMetrics) IncRejectedNodes(reason string) {
+	m.rejectedNodes.WithLabelValues(reason).Inc()
+}
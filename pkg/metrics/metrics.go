@@ -8,6 +8,7 @@ type NodeMetrics struct {
 	objectServiceMetrics
 	engineMetrics
 	stateMetrics
+	treeServiceMetrics
 	epoch prometheus.Gauge
 }
 
@@ -21,6 +22,9 @@ func NewNodeMetrics() *NodeMetrics {
 	state := newStateMetrics()
 	state.register()
 
+	treeService := newTreeServiceMetrics()
+	treeService.register()
+
 	epoch := prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: namespace,
 		Subsystem: innerRingSubsystem,
@@ -33,6 +37,7 @@ func NewNodeMetrics() *NodeMetrics {
 		objectServiceMetrics: objectService,
 		engineMetrics:        engine,
 		stateMetrics:         state,
+		treeServiceMetrics:   treeService,
 		epoch:                epoch,
 	}
 }
@@ -0,0 +1,40 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const treeServiceSubsystem = "treeservice"
+
+type treeServiceMetrics struct {
+	syncFetchedBytes prometheus.Counter
+	syncSavedBytes   prometheus.Counter
+}
+
+func newTreeServiceMetrics() treeServiceMetrics {
+	return treeServiceMetrics{
+		syncFetchedBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: treeServiceSubsystem,
+			Name:      "sync_fetched_bytes",
+			Help:      "Size of the operations fetched during the digest-based tree synchronization",
+		}),
+		syncSavedBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: treeServiceSubsystem,
+			Name:      "sync_saved_bytes",
+			Help:      "Size of the operations not fetched during the digest-based tree synchronization",
+		}),
+	}
+}
+
+func (m treeServiceMetrics) register() {
+	prometheus.MustRegister(m.syncFetchedBytes)
+	prometheus.MustRegister(m.syncSavedBytes)
+}
+
+func (m treeServiceMetrics) AddTreeSyncFetchedBytes(n uint64) {
+	m.syncFetchedBytes.Add(float64(n))
+}
+
+func (m treeServiceMetrics) AddTreeSyncSavedBytes(n uint64) {
+	m.syncSavedBytes.Add(float64(n))
+}
@@ -6,12 +6,25 @@ import (
 	"github.com/TrueCloudLab/frostfs-node/pkg/morph/client"
 )
 
+// NewEpochPrm groups parameters of NewEpoch operation.
+type NewEpochPrm struct {
+	epoch uint64
+
+	client.InvokePrmOptional
+}
+
+// SetEpoch sets the number of the new epoch.
+func (p *NewEpochPrm) SetEpoch(epoch uint64) {
+	p.epoch = epoch
+}
+
 // NewEpoch updates FrostFS epoch number through
 // Netmap contract call.
-func (c *Client) NewEpoch(epoch uint64) error {
+func (c *Client) NewEpoch(p NewEpochPrm) error {
 	prm := client.InvokePrm{}
 	prm.SetMethod(newEpochMethod)
-	prm.SetArgs(epoch)
+	prm.SetArgs(p.epoch)
+	prm.InvokePrmOptional = p.InvokePrmOptional
 
 	if err := c.client.Invoke(prm); err != nil {
 		return fmt.Errorf("could not invoke method (%s): %w", newEpochMethod, err)
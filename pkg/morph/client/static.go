@@ -94,6 +94,12 @@ type InvokePrmOptional struct {
 	// `validUntilBlock` values by all notification
 	// receivers.
 	hash *util.Uint256
+
+	// nonce and vub are optional values of the alphabet
+	// notary request used instead of the ones calculated
+	// from the hash.
+	nonce uint32
+	vub   *uint32
 }
 
 // SetHash sets optional hash of the transaction.
@@ -104,6 +110,16 @@ func (i *InvokePrmOptional) SetHash(hash util.Uint256) {
 	i.hash = &hash
 }
 
+// SetNonceAndVUB sets nonce and `validUntilBlock` values of the notary
+// request. They allow all the alphabet nodes to create the same request
+// when there is no transaction to calculate them from (see SetHash).
+// If set and notary is enabled, StaticClient uses them instead of
+// the hash.
+func (i *InvokePrmOptional) SetNonceAndVUB(nonce, vub uint32) {
+	i.nonce = nonce
+	i.vub = &vub
+}
+
 // Invoke calls Invoke method of Client with static internal script hash and fee.
 // Supported args types are the same as in Client.
 //
@@ -125,7 +141,9 @@ func (s StaticClient) Invoke(prm InvokePrm) error {
 				err   error
 			)
 
-			if prm.hash != nil {
+			if prm.vub != nil {
+				nonce, vubP = prm.nonce, prm.vub
+			} else if prm.hash != nil {
 				nonce, vub, err = s.client.CalculateNonceAndVUB(*prm.hash)
 				if err != nil {
 					return fmt.Errorf("could not calculate nonce and VUB for notary alphabet invoke: %w", err)
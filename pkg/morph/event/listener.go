@@ -71,6 +71,12 @@ type Listener interface {
 	// Has no effect if EnableNotarySupport was not called before Listen or ListenWithError.
 	RegisterNotaryHandler(NotaryHandlerInfo)
 
+	// NotaryRequests must return notary requests which were added
+	// to the notary pool and were not removed from it yet.
+	//
+	// Must return nil if EnableNotarySupport was not called.
+	NotaryRequests() ([]NotaryRequestInfo, error)
+
 	// RegisterBlockHandler must register chain block handler.
 	//
 	// The specified handler must be called after each capture and parsing of the new block from chain.
@@ -107,6 +113,8 @@ type listener struct {
 	notaryParsers          map[notaryRequestTypes]NotaryParser
 	notaryHandlers         map[notaryRequestTypes]Handler
 	notaryMainTXSigner     util.Uint160 // filter for notary subscription
+	notaryBlockCounter     BlockCounter
+	notaryPending          pendingNotaryRequests
 
 	log *logger.Logger
 
@@ -270,6 +278,8 @@ loop:
 				continue loop
 			}
 
+			l.notaryPending.update(notaryEvent)
+
 			if err = l.pool.Submit(func() {
 				l.parseAndHandleNotary(notaryEvent)
 			}); err != nil {
@@ -503,6 +513,7 @@ func (l *listener) EnableNotarySupport(mainTXSigner util.Uint160, alphaKeys clie
 
 	l.listenNotary = true
 	l.notaryMainTXSigner = mainTXSigner
+	l.notaryBlockCounter = bc
 	l.notaryHandlers = make(map[notaryRequestTypes]Handler)
 	l.notaryParsers = make(map[notaryRequestTypes]NotaryParser)
 	l.notaryEventsPreparator = notaryPreparator(
@@ -513,6 +524,26 @@ func (l *listener) EnableNotarySupport(mainTXSigner util.Uint160, alphaKeys clie
 	)
 }
 
+// NotaryRequests returns notary requests which were added to the notary
+// pool and were not removed from it yet. Requests with expired main
+// transaction are not returned.
+func (l *listener) NotaryRequests() ([]NotaryRequestInfo, error) {
+	l.mtx.RLock()
+	listenNotary, bc := l.listenNotary, l.notaryBlockCounter
+	l.mtx.RUnlock()
+
+	if !listenNotary {
+		return nil, nil
+	}
+
+	blockCount, err := bc.BlockCount()
+	if err != nil {
+		return nil, fmt.Errorf("could not get block count: %w", err)
+	}
+
+	return l.notaryPending.list(blockCount), nil
+}
+
 // SetNotaryParser sets the parser of particular notary request event.
 //
 // Ignores nil and already set parsers.
@@ -0,0 +1,82 @@
+package event
+
+import (
+	"sync"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/mempoolevent"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+)
+
+// NotaryRequestInfo describes notary request pending in the notary pool.
+type NotaryRequestInfo struct {
+	// MainHash is the hash of the main transaction.
+	MainHash util.Uint256
+	// FallbackHash is the hash of the fallback transaction.
+	FallbackHash util.Uint256
+	// Sender is the account which pays for the fallback transaction.
+	Sender util.Uint160
+	// ValidUntilBlock is the height until which the main transaction is valid.
+	ValidUntilBlock uint32
+}
+
+// pendingNotaryRequests tracks notary requests added to the notary pool
+// and not yet removed from it. Requests are identified by the fallback
+// transaction hash since all the requests of the same main transaction
+// share its hash.
+type pendingNotaryRequests struct {
+	mtx      sync.Mutex
+	requests map[util.Uint256]NotaryRequestInfo
+}
+
+func (p *pendingNotaryRequests) update(nr *result.NotaryRequestEvent) {
+	if nr.NotaryRequest == nil || nr.NotaryRequest.MainTransaction == nil || nr.NotaryRequest.FallbackTransaction == nil {
+		return
+	}
+
+	fallbackHash := nr.NotaryRequest.FallbackTransaction.Hash()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	switch nr.Type {
+	case mempoolevent.TransactionAdded:
+		if p.requests == nil {
+			p.requests = make(map[util.Uint256]NotaryRequestInfo)
+		}
+
+		info := NotaryRequestInfo{
+			MainHash:        nr.NotaryRequest.MainTransaction.Hash(),
+			FallbackHash:    fallbackHash,
+			ValidUntilBlock: nr.NotaryRequest.MainTransaction.ValidUntilBlock,
+		}
+
+		// fallback transaction is signed by Notary contract and the sender
+		if signers := nr.NotaryRequest.FallbackTransaction.Signers; len(signers) > 1 {
+			info.Sender = signers[1].Account
+		}
+
+		p.requests[fallbackHash] = info
+	case mempoolevent.TransactionRemoved:
+		delete(p.requests, fallbackHash)
+	}
+}
+
+// list returns the pending requests and forgets the ones which main
+// transaction can't be included in the next block anymore.
+func (p *pendingNotaryRequests) list(blockCount uint32) []NotaryRequestInfo {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	res := make([]NotaryRequestInfo, 0, len(p.requests))
+	for h, info := range p.requests {
+		if info.ValidUntilBlock < blockCount {
+			delete(p.requests, h)
+			continue
+		}
+
+		res = append(res, info)
+	}
+
+	return res
+}
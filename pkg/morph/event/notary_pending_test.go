@@ -0,0 +1,57 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/mempoolevent"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/network/payload"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingNotaryRequests(t *testing.T) {
+	sender := util.Uint160{1, 2, 3}
+
+	newRequest := func(nonce, vub uint32) *payload.P2PNotaryRequest {
+		main := transaction.New([]byte{1}, 0)
+		main.Nonce = nonce
+		main.ValidUntilBlock = vub
+
+		fb := transaction.New([]byte{2}, 0)
+		fb.Nonce = nonce
+		fb.Signers = []transaction.Signer{{}, {Account: sender}}
+
+		return &payload.P2PNotaryRequest{MainTransaction: main, FallbackTransaction: fb}
+	}
+
+	var p pendingNotaryRequests
+
+	nr1, nr2, nr3 := newRequest(1, 10), newRequest(2, 20), newRequest(3, 30)
+	p.update(&result.NotaryRequestEvent{Type: mempoolevent.TransactionAdded, NotaryRequest: nr1})
+	p.update(&result.NotaryRequestEvent{Type: mempoolevent.TransactionAdded, NotaryRequest: nr2})
+	p.update(&result.NotaryRequestEvent{Type: mempoolevent.TransactionAdded, NotaryRequest: nr3})
+	p.update(&result.NotaryRequestEvent{Type: mempoolevent.TransactionRemoved, NotaryRequest: nr2})
+
+	require.ElementsMatch(t, []NotaryRequestInfo{
+		{
+			MainHash:        nr1.MainTransaction.Hash(),
+			FallbackHash:    nr1.FallbackTransaction.Hash(),
+			Sender:          sender,
+			ValidUntilBlock: 10,
+		},
+		{
+			MainHash:        nr3.MainTransaction.Hash(),
+			FallbackHash:    nr3.FallbackTransaction.Hash(),
+			Sender:          sender,
+			ValidUntilBlock: 30,
+		},
+	}, p.list(10))
+
+	// Expired request is forgotten.
+	res := p.list(11)
+	require.Len(t, res, 1)
+	require.Equal(t, nr3.FallbackTransaction.Hash(), res[0].FallbackHash)
+	require.Len(t, p.requests, 1)
+}
@@ -57,6 +57,18 @@ func (a Address) URIAddr() string {
 	}).String()
 }
 
+// IP returns IP address of the Address host.
+//
+// Returns nil if the host is a DNS name.
+func (a Address) IP() net.IP {
+	ip, err := manet.ToIP(a.ma)
+	if err != nil {
+		return nil
+	}
+
+	return ip
+}
+
 // FromString restores Address from a string representation.
 //
 // Supports URIAddr, MultiAddr and HostAddr strings.
@@ -147,6 +147,42 @@ func (w *restoreShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	return nil
 }
 
+type dumpShardStreamResponseWrapper struct {
+	*DumpShardStreamResponse
+}
+
+func (w *dumpShardStreamResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.DumpShardStreamResponse
+}
+
+func (w *dumpShardStreamResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*DumpShardStreamResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*DumpShardStreamResponse)(nil))
+	}
+
+	w.DumpShardStreamResponse = r
+	return nil
+}
+
+type restoreShardStreamResponseWrapper struct {
+	*RestoreShardStreamResponse
+}
+
+func (w *restoreShardStreamResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.RestoreShardStreamResponse
+}
+
+func (w *restoreShardStreamResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*RestoreShardStreamResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*RestoreShardStreamResponse)(nil))
+	}
+
+	w.RestoreShardStreamResponse = r
+	return nil
+}
+
 type synchronizeTreeResponseWrapper struct {
 	*SynchronizeTreeResponse
 }
@@ -200,3 +236,93 @@ func (w *flushCacheResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	w.FlushCacheResponse = r
 	return nil
 }
+
+type rebuildMetabaseResponseWrapper struct {
+	*RebuildMetabaseResponse
+}
+
+func (w *rebuildMetabaseResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.RebuildMetabaseResponse
+}
+
+func (w *rebuildMetabaseResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*RebuildMetabaseResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*RebuildMetabaseResponse)(nil))
+	}
+
+	w.RebuildMetabaseResponse = r
+	return nil
+}
+
+type getMetabaseRebuildStatusResponseWrapper struct {
+	*GetMetabaseRebuildStatusResponse
+}
+
+func (w *getMetabaseRebuildStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetMetabaseRebuildStatusResponse
+}
+
+func (w *getMetabaseRebuildStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetMetabaseRebuildStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetMetabaseRebuildStatusResponse)(nil))
+	}
+
+	w.GetMetabaseRebuildStatusResponse = r
+	return nil
+}
+
+type compactBlobovniczasResponseWrapper struct {
+	*CompactBlobovniczasResponse
+}
+
+func (w *compactBlobovniczasResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.CompactBlobovniczasResponse
+}
+
+func (w *compactBlobovniczasResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*CompactBlobovniczasResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*CompactBlobovniczasResponse)(nil))
+	}
+
+	w.CompactBlobovniczasResponse = r
+	return nil
+}
+
+type getEvacuationStatusResponseWrapper struct {
+	*GetEvacuationStatusResponse
+}
+
+func (w *getEvacuationStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetEvacuationStatusResponse
+}
+
+func (w *getEvacuationStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetEvacuationStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetEvacuationStatusResponse)(nil))
+	}
+
+	w.GetEvacuationStatusResponse = r
+	return nil
+}
+
+type stopEvacuationResponseWrapper struct {
+	*StopEvacuationResponse
+}
+
+func (w *stopEvacuationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StopEvacuationResponse
+}
+
+func (w *stopEvacuationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StopEvacuationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StopEvacuationResponse)(nil))
+	}
+
+	w.StopEvacuationResponse = r
+	return nil
+}
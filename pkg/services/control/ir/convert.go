@@ -32,3 +32,79 @@ func (w *healthCheckResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 
 	return nil
 }
+
+type tickEpochResponseWrapper struct {
+	m *TickEpochResponse
+}
+
+func (w *tickEpochResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *tickEpochResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*TickEpochResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
+
+type removeNodeResponseWrapper struct {
+	m *RemoveNodeResponse
+}
+
+func (w *removeNodeResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *removeNodeResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*RemoveNodeResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
+
+type listNotaryRequestsResponseWrapper struct {
+	m *ListNotaryRequestsResponse
+}
+
+func (w *listNotaryRequestsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *listNotaryRequestsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*ListNotaryRequestsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
+
+type listProcessorQueuesResponseWrapper struct {
+	m *ListProcessorQueuesResponse
+}
+
+func (w *listProcessorQueuesResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *listProcessorQueuesResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*ListProcessorQueuesResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
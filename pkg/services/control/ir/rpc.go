@@ -8,7 +8,11 @@ import (
 const serviceName = "ircontrol.ControlService"
 
 const (
-	rpcHealthCheck = "HealthCheck"
+	rpcHealthCheck         = "HealthCheck"
+	rpcTickEpoch           = "TickEpoch"
+	rpcRemoveNode          = "RemoveNode"
+	rpcListNotaryRequests  = "ListNotaryRequests"
+	rpcListProcessorQueues = "ListProcessorQueues"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -32,3 +36,91 @@ func HealthCheck(
 
 	return wResp.m, nil
 }
+
+// TickEpoch executes ControlService.TickEpoch RPC.
+func TickEpoch(
+	cli *client.Client,
+	req *TickEpochRequest,
+	opts ...client.CallOption,
+) (*TickEpochResponse, error) {
+	wResp := &tickEpochResponseWrapper{
+		m: new(TickEpochResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcTickEpoch), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// RemoveNode executes ControlService.RemoveNode RPC.
+func RemoveNode(
+	cli *client.Client,
+	req *RemoveNodeRequest,
+	opts ...client.CallOption,
+) (*RemoveNodeResponse, error) {
+	wResp := &removeNodeResponseWrapper{
+		m: new(RemoveNodeResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcRemoveNode), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// ListNotaryRequests executes ControlService.ListNotaryRequests RPC.
+func ListNotaryRequests(
+	cli *client.Client,
+	req *ListNotaryRequestsRequest,
+	opts ...client.CallOption,
+) (*ListNotaryRequestsResponse, error) {
+	wResp := &listNotaryRequestsResponseWrapper{
+		m: new(ListNotaryRequestsResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListNotaryRequests), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// ListProcessorQueues executes ControlService.ListProcessorQueues RPC.
+func ListProcessorQueues(
+	cli *client.Client,
+	req *ListProcessorQueuesRequest,
+	opts ...client.CallOption,
+) (*ListProcessorQueuesResponse, error) {
+	wResp := &listProcessorQueuesResponseWrapper{
+		m: new(ListProcessorQueuesResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListProcessorQueues), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
@@ -32,3 +32,101 @@ func (s *Server) HealthCheck(_ context.Context, req *control.HealthCheckRequest)
 
 	return resp, nil
 }
+
+// TickEpoch forces a new epoch.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) TickEpoch(_ context.Context, req *control.TickEpochRequest) (*control.TickEpochResponse, error) {
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if err := s.prm.epochTicker.TickEpoch(); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := new(control.TickEpochResponse)
+	resp.SetBody(new(control.TickEpochResponse_Body))
+
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// RemoveNode forces a storage node removal from the network map.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) RemoveNode(_ context.Context, req *control.RemoveNodeRequest) (*control.RemoveNodeResponse, error) {
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	key := req.GetBody().GetKey()
+	if len(key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing node public key")
+	}
+
+	if err := s.prm.nodeRemover.RemoveNode(key); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := new(control.RemoveNodeResponse)
+	resp.SetBody(new(control.RemoveNodeResponse_Body))
+
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// ListNotaryRequests returns notary requests pending in the sidechain notary pool.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) ListNotaryRequests(_ context.Context, req *control.ListNotaryRequestsRequest) (*control.ListNotaryRequestsResponse, error) {
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	requests, err := s.prm.notaryRequests.NotaryRequests()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := new(control.ListNotaryRequestsResponse)
+
+	body := new(control.ListNotaryRequestsResponse_Body)
+	resp.SetBody(body)
+
+	body.SetRequests(requests)
+
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// ListProcessorQueues returns worker pool states of the IR event processors.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) ListProcessorQueues(_ context.Context, req *control.ListProcessorQueuesRequest) (*control.ListProcessorQueuesResponse, error) {
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	resp := new(control.ListProcessorQueuesResponse)
+
+	body := new(control.ListProcessorQueuesResponse_Body)
+	resp.SetBody(body)
+
+	body.SetQueues(s.prm.processorQueues.ProcessorQueues())
+
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
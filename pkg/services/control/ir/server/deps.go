@@ -11,3 +11,36 @@ type HealthChecker interface {
 	// control.HealthStatus_HEALTH_STATUS_UNDEFINED should be returned.
 	HealthStatus() control.HealthStatus
 }
+
+// EpochTicker is component interface for forcing
+// a new epoch.
+type EpochTicker interface {
+	// Must signal the Netmap contract to switch
+	// to the next epoch.
+	TickEpoch() error
+}
+
+// NodeRemover is component interface for forcing
+// a storage node removal from the network map.
+type NodeRemover interface {
+	// Must signal the Netmap contract to switch the storage
+	// node with the given public key to offline state.
+	//
+	// Must return an error if the node is not in the network map.
+	RemoveNode(key []byte) error
+}
+
+// NotaryRequestLister is component interface for listing
+// notary requests pending in the sidechain notary pool.
+type NotaryRequestLister interface {
+	// Must return notary requests which were received
+	// by the IR node and are not yet completed or expired.
+	NotaryRequests() ([]*control.NotaryRequestInfo, error)
+}
+
+// ProcessorQueueLister is component interface for listing
+// worker pool states of the IR event processors.
+type ProcessorQueueLister interface {
+	// Must return worker pool state of every event processor.
+	ProcessorQueues() []*control.ProcessorQueue
+}
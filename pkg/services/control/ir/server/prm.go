@@ -10,6 +10,14 @@ type Prm struct {
 	key keys.PrivateKey
 
 	healthChecker HealthChecker
+
+	epochTicker EpochTicker
+
+	nodeRemover NodeRemover
+
+	notaryRequests NotaryRequestLister
+
+	processorQueues ProcessorQueueLister
 }
 
 // SetPrivateKey sets private key to sign responses.
@@ -22,3 +30,25 @@ func (x *Prm) SetPrivateKey(key keys.PrivateKey) {
 func (x *Prm) SetHealthChecker(hc HealthChecker) {
 	x.healthChecker = hc
 }
+
+// SetEpochTicker sets EpochTicker to force a new epoch.
+func (x *Prm) SetEpochTicker(v EpochTicker) {
+	x.epochTicker = v
+}
+
+// SetNodeRemover sets NodeRemover to force a node removal.
+func (x *Prm) SetNodeRemover(v NodeRemover) {
+	x.nodeRemover = v
+}
+
+// SetNotaryRequestLister sets NotaryRequestLister to list
+// pending notary requests.
+func (x *Prm) SetNotaryRequestLister(v NotaryRequestLister) {
+	x.notaryRequests = v
+}
+
+// SetProcessorQueueLister sets ProcessorQueueLister to list
+// worker pool states of the event processors.
+func (x *Prm) SetProcessorQueueLister(v ProcessorQueueLister) {
+	x.processorQueues = v
+}
@@ -24,7 +24,11 @@ func panicOnPrmValue(n string, v any) {
 //
 // Panics if:
 //   - parameterized private key is nil;
-//   - parameterized HealthChecker is nil.
+//   - parameterized HealthChecker is nil;
+//   - parameterized EpochTicker is nil;
+//   - parameterized NodeRemover is nil;
+//   - parameterized NotaryRequestLister is nil;
+//   - parameterized ProcessorQueueLister is nil.
 //
 // Forms white list from all keys specified via
 // WithAllowedKeys option and a public key of
@@ -34,6 +38,14 @@ func New(prm Prm, opts ...Option) *Server {
 	switch {
 	case prm.healthChecker == nil:
 		panicOnPrmValue("health checker", prm.healthChecker)
+	case prm.epochTicker == nil:
+		panicOnPrmValue("epoch ticker", prm.epochTicker)
+	case prm.nodeRemover == nil:
+		panicOnPrmValue("node remover", prm.nodeRemover)
+	case prm.notaryRequests == nil:
+		panicOnPrmValue("notary request lister", prm.notaryRequests)
+	case prm.processorQueues == nil:
+		panicOnPrmValue("processor queue lister", prm.processorQueues)
 	}
 
 	// compute optional parameters
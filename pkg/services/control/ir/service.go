@@ -20,3 +20,80 @@ func (x *HealthCheckResponse) SetBody(v *HealthCheckResponse_Body) {
 		x.Body = v
 	}
 }
+
+// SetBody sets tick epoch request body.
+func (x *TickEpochRequest) SetBody(v *TickEpochRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets tick epoch response body.
+func (x *TickEpochResponse) SetBody(v *TickEpochResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetKey sets public key of the storage node to remove.
+func (x *RemoveNodeRequest_Body) SetKey(v []byte) {
+	if x != nil {
+		x.Key = v
+	}
+}
+
+// SetBody sets remove node request body.
+func (x *RemoveNodeRequest) SetBody(v *RemoveNodeRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets remove node response body.
+func (x *RemoveNodeResponse) SetBody(v *RemoveNodeResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets list notary requests request body.
+func (x *ListNotaryRequestsRequest) SetBody(v *ListNotaryRequestsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetRequests sets pending notary requests.
+func (x *ListNotaryRequestsResponse_Body) SetRequests(v []*NotaryRequestInfo) {
+	if x != nil {
+		x.Requests = v
+	}
+}
+
+// SetBody sets list notary requests response body.
+func (x *ListNotaryRequestsResponse) SetBody(v *ListNotaryRequestsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets list processor queues request body.
+func (x *ListProcessorQueuesRequest) SetBody(v *ListProcessorQueuesRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetQueues sets worker pool states of the event processors.
+func (x *ListProcessorQueuesResponse_Body) SetQueues(v []*ProcessorQueue) {
+	if x != nil {
+		x.Queues = v
+	}
+}
+
+// SetBody sets list processor queues response body.
+func (x *ListProcessorQueuesResponse) SetBody(v *ListProcessorQueuesResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
@@ -42,3 +42,52 @@ func generateHealthCheckResponseBody() *control.HealthCheckResponse_Body {
 func equalHealthCheckResponseBodies(b1, b2 *control.HealthCheckResponse_Body) bool {
 	return b1.GetHealthStatus() == b2.GetHealthStatus()
 }
+
+func TestListNotaryRequestsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateListNotaryRequestsResponseBody(),
+		new(control.ListNotaryRequestsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func TestListProcessorQueuesResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateListProcessorQueuesResponseBody(),
+		new(control.ListProcessorQueuesResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func generateListNotaryRequestsResponseBody() *control.ListNotaryRequestsResponse_Body {
+	r1, r2 := new(control.NotaryRequestInfo), new(control.NotaryRequestInfo)
+	r1.SetMainHash([]byte{1, 2, 3})
+	r1.SetFallbackHash([]byte{4, 5, 6})
+	r1.SetSender([]byte{7, 8})
+	r1.SetValidUntilBlock(100)
+	r2.SetMainHash([]byte{9})
+	r2.SetValidUntilBlock(200)
+
+	body := new(control.ListNotaryRequestsResponse_Body)
+	body.SetRequests([]*control.NotaryRequestInfo{r1, r2})
+
+	return body
+}
+
+func generateListProcessorQueuesResponseBody() *control.ListProcessorQueuesResponse_Body {
+	q1, q2 := new(control.ProcessorQueue), new(control.ProcessorQueue)
+	q1.SetName("netmap")
+	q1.SetRunning(3)
+	q1.SetCapacity(10)
+	q2.SetName("container")
+	q2.SetCapacity(10)
+
+	body := new(control.ListProcessorQueuesResponse_Body)
+	body.SetQueues([]*control.ProcessorQueue{q1, q2})
+
+	return body
+}
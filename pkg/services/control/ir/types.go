@@ -13,3 +13,52 @@ func (x *Signature) SetSign(v []byte) {
 		x.Sign = v
 	}
 }
+
+// SetMainHash sets hash of the main transaction.
+func (x *NotaryRequestInfo) SetMainHash(v []byte) {
+	if x != nil {
+		x.MainHash = v
+	}
+}
+
+// SetFallbackHash sets hash of the fallback transaction.
+func (x *NotaryRequestInfo) SetFallbackHash(v []byte) {
+	if x != nil {
+		x.FallbackHash = v
+	}
+}
+
+// SetSender sets account which pays for the fallback transaction.
+func (x *NotaryRequestInfo) SetSender(v []byte) {
+	if x != nil {
+		x.Sender = v
+	}
+}
+
+// SetValidUntilBlock sets height until which the main transaction is valid.
+func (x *NotaryRequestInfo) SetValidUntilBlock(v uint32) {
+	if x != nil {
+		x.ValidUntilBlock = v
+	}
+}
+
+// SetName sets name of the processor.
+func (x *ProcessorQueue) SetName(v string) {
+	if x != nil {
+		x.Name = v
+	}
+}
+
+// SetRunning sets number of busy workers.
+func (x *ProcessorQueue) SetRunning(v uint32) {
+	if x != nil {
+		x.Running = v
+	}
+}
+
+// SetCapacity sets maximum number of workers.
+func (x *ProcessorQueue) SetCapacity(v uint32) {
+	if x != nil {
+		x.Capacity = v
+	}
+}
@@ -8,16 +8,23 @@ import (
 const serviceName = "control.ControlService"
 
 const (
-	rpcHealthCheck     = "HealthCheck"
-	rpcSetNetmapStatus = "SetNetmapStatus"
-	rpcDropObjects     = "DropObjects"
-	rpcListShards      = "ListShards"
-	rpcSetShardMode    = "SetShardMode"
-	rpcDumpShard       = "DumpShard"
-	rpcRestoreShard    = "RestoreShard"
-	rpcSynchronizeTree = "SynchronizeTree"
-	rpcEvacuateShard   = "EvacuateShard"
-	rpcFlushCache      = "FlushCache"
+	rpcHealthCheck              = "HealthCheck"
+	rpcSetNetmapStatus          = "SetNetmapStatus"
+	rpcDropObjects              = "DropObjects"
+	rpcListShards               = "ListShards"
+	rpcSetShardMode             = "SetShardMode"
+	rpcDumpShard                = "DumpShard"
+	rpcRestoreShard             = "RestoreShard"
+	rpcSynchronizeTree          = "SynchronizeTree"
+	rpcEvacuateShard            = "EvacuateShard"
+	rpcFlushCache               = "FlushCache"
+	rpcRebuildMetabase          = "RebuildMetabase"
+	rpcGetMetabaseRebuildStatus = "GetMetabaseRebuildStatus"
+	rpcGetEvacuationStatus      = "GetEvacuationStatus"
+	rpcStopEvacuation           = "StopEvacuation"
+	rpcDumpShardStream          = "DumpShardStream"
+	rpcRestoreShardStream       = "RestoreShardStream"
+	rpcCompactBlobovniczas      = "CompactBlobovniczas"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -153,6 +160,70 @@ func RestoreShard(cli *client.Client, req *RestoreShardRequest, opts ...client.C
 	return wResp.RestoreShardResponse, nil
 }
 
+// DumpShardStreamReader is a DumpShardStreamResponse stream reader.
+type DumpShardStreamReader struct {
+	r client.MessageReader
+}
+
+// Read reads the next response from the stream.
+//
+// Returns io.EOF if streaming is finished.
+func (r *DumpShardStreamReader) Read() (*DumpShardStreamResponse, error) {
+	wResp := &dumpShardStreamResponseWrapper{new(DumpShardStreamResponse)}
+
+	err := r.r.ReadMessage(wResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.DumpShardStreamResponse, nil
+}
+
+// DumpShardStream executes ControlService.DumpShardStream RPC.
+func DumpShardStream(cli *client.Client, req *DumpShardStreamRequest, opts ...client.CallOption) (*DumpShardStreamReader, error) {
+	wReq := &requestWrapper{m: req}
+
+	r, err := client.OpenServerStream(cli, common.CallMethodInfoServerStream(serviceName, rpcDumpShardStream), wReq, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DumpShardStreamReader{r: r}, nil
+}
+
+// RestoreShardStreamWriter is a RestoreShardStreamRequest stream writer.
+type RestoreShardStreamWriter struct {
+	wc    client.MessageWriterCloser
+	wResp *restoreShardStreamResponseWrapper
+}
+
+// Write writes req to the stream.
+func (w *RestoreShardStreamWriter) Write(req *RestoreShardStreamRequest) error {
+	return w.wc.WriteMessage(&requestWrapper{m: req})
+}
+
+// Close closes the stream and returns the server response.
+func (w *RestoreShardStreamWriter) Close() (*RestoreShardStreamResponse, error) {
+	err := w.wc.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return w.wResp.RestoreShardStreamResponse, nil
+}
+
+// RestoreShardStream executes ControlService.RestoreShardStream RPC.
+func RestoreShardStream(cli *client.Client, opts ...client.CallOption) (*RestoreShardStreamWriter, error) {
+	wResp := &restoreShardStreamResponseWrapper{new(RestoreShardStreamResponse)}
+
+	wc, err := client.OpenClientStream(cli, common.CallMethodInfoClientStream(serviceName, rpcRestoreShardStream), wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RestoreShardStreamWriter{wc: wc, wResp: wResp}, nil
+}
+
 // SynchronizeTree executes ControlService.SynchronizeTree RPC.
 func SynchronizeTree(cli *client.Client, req *SynchronizeTreeRequest, opts ...client.CallOption) (*SynchronizeTreeResponse, error) {
 	wResp := &synchronizeTreeResponseWrapper{new(SynchronizeTreeResponse)}
@@ -191,3 +262,68 @@ func FlushCache(cli *client.Client, req *FlushCacheRequest, opts ...client.CallO
 
 	return wResp.FlushCacheResponse, nil
 }
+
+// RebuildMetabase executes ControlService.RebuildMetabase RPC.
+func RebuildMetabase(cli *client.Client, req *RebuildMetabaseRequest, opts ...client.CallOption) (*RebuildMetabaseResponse, error) {
+	wResp := &rebuildMetabaseResponseWrapper{new(RebuildMetabaseResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcRebuildMetabase), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.RebuildMetabaseResponse, nil
+}
+
+// GetMetabaseRebuildStatus executes ControlService.GetMetabaseRebuildStatus RPC.
+func GetMetabaseRebuildStatus(cli *client.Client, req *GetMetabaseRebuildStatusRequest, opts ...client.CallOption) (*GetMetabaseRebuildStatusResponse, error) {
+	wResp := &getMetabaseRebuildStatusResponseWrapper{new(GetMetabaseRebuildStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetMetabaseRebuildStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetMetabaseRebuildStatusResponse, nil
+}
+
+// CompactBlobovniczas executes ControlService.CompactBlobovniczas RPC.
+func CompactBlobovniczas(cli *client.Client, req *CompactBlobovniczasRequest, opts ...client.CallOption) (*CompactBlobovniczasResponse, error) {
+	wResp := &compactBlobovniczasResponseWrapper{new(CompactBlobovniczasResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcCompactBlobovniczas), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.CompactBlobovniczasResponse, nil
+}
+
+// GetEvacuationStatus executes ControlService.GetEvacuationStatus RPC.
+func GetEvacuationStatus(cli *client.Client, req *GetEvacuationStatusRequest, opts ...client.CallOption) (*GetEvacuationStatusResponse, error) {
+	wResp := &getEvacuationStatusResponseWrapper{new(GetEvacuationStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetEvacuationStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetEvacuationStatusResponse, nil
+}
+
+// StopEvacuation executes ControlService.StopEvacuation RPC.
+func StopEvacuation(cli *client.Client, req *StopEvacuationRequest, opts ...client.CallOption) (*StopEvacuationResponse, error) {
+	wResp := &stopEvacuationResponseWrapper{new(StopEvacuationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStopEvacuation), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StopEvacuationResponse, nil
+}
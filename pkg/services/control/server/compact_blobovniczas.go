@@ -0,0 +1,54 @@
+package control
+
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) CompactBlobovniczas(_ context.Context, req *control.CompactBlobovniczasRequest) (*control.CompactBlobovniczasResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	threshold := req.GetBody().GetThreshold()
+	if threshold < 0 || threshold > 1 {
+		return nil, status.Error(codes.InvalidArgument, "threshold must be in [0, 1] range")
+	}
+
+	shardIDs := s.getShardIDList(req.GetBody().GetShard_ID())
+	results := make([]*control.BlobovniczaCompactionResult, 0, len(shardIDs))
+	for _, shardID := range shardIDs {
+		var prm engine.CompactBlobovniczasPrm
+		prm.SetShardID(shardID)
+		prm.SetThreshold(threshold)
+
+		res, err := s.s.CompactBlobovniczas(prm)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		results = append(results, &control.BlobovniczaCompactionResult{
+			Shard_ID:  *shardID,
+			Compacted: res.Compacted(),
+			Moved:     res.Moved(),
+			Reclaimed: res.Reclaimed(),
+		})
+	}
+
+	resp := &control.CompactBlobovniczasResponse{
+		Body: &control.CompactBlobovniczasResponse_Body{
+			Results: results,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
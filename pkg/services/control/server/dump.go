@@ -5,6 +5,7 @@ import (
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -20,6 +21,7 @@ func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*c
 	var prm shard.DumpPrm
 	prm.WithPath(req.GetBody().GetFilepath())
 	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	s.setDumpHeader(&prm)
 
 	err = s.s.DumpShard(shardID, prm)
 	if err != nil {
@@ -35,3 +37,13 @@ func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*c
 	}
 	return resp, nil
 }
+
+// setDumpHeader sets the node information written to the dump header.
+func (s *Server) setDumpHeader(prm *shard.DumpPrm) {
+	prm.WithNodeKey((*keys.PublicKey)(&s.key.PublicKey).Bytes())
+
+	// Epoch is informational only, so the dump is not failed if it is unavailable.
+	if epoch, err := s.netMapSrc.Epoch(); err == nil {
+		prm.WithEpoch(epoch)
+	}
+}
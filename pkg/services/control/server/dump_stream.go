@@ -0,0 +1,138 @@
+package control
+
+import (
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dumpStreamChunkSize is the maximum size of the dump chunk sent in a single response.
+// It must be less than the gRPC message size limit.
+const dumpStreamChunkSize = 1 << 20
+
+func (s *Server) DumpShardStream(req *control.DumpShardStreamRequest, srv control.ControlService_DumpShardStreamServer) error {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	shardID := shard.NewIDFromBytes(req.GetBody().GetShard_ID())
+
+	cnrs, err := parseContainerIDs(req.GetBody().GetContainer_ID())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	types, err := parseObjectTypes(req.GetBody().GetObjectType())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	w := &dumpStreamWriter{
+		s:   s,
+		srv: srv,
+		buf: make([]byte, 0, dumpStreamChunkSize),
+	}
+
+	var prm shard.DumpPrm
+	prm.WithStream(w)
+	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	prm.WithCompression(req.GetBody().GetCompress())
+	prm.WithContainers(cnrs)
+	prm.WithObjectTypes(types)
+	s.setDumpHeader(&prm)
+
+	err = s.s.DumpShard(shardID, prm)
+	if err == nil {
+		err = w.flush()
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// dumpStreamWriter splits the dump into chunks and sends
+// each chunk in a separate signed response.
+type dumpStreamWriter struct {
+	s   *Server
+	srv control.ControlService_DumpShardStreamServer
+	buf []byte
+}
+
+func (w *dumpStreamWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		sz := cap(w.buf) - len(w.buf)
+		if sz > len(p) {
+			sz = len(p)
+		}
+
+		w.buf = append(w.buf, p[:sz]...)
+		p = p[sz:]
+
+		if len(w.buf) == cap(w.buf) {
+			if err := w.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (w *dumpStreamWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	resp := new(control.DumpShardStreamResponse)
+	resp.SetBody(&control.DumpShardStreamResponse_Body{
+		Chunk: w.buf,
+	})
+
+	err := SignMessage(w.s.key, resp)
+	if err != nil {
+		return err
+	}
+
+	err = w.srv.Send(resp)
+	if err != nil {
+		return fmt.Errorf("could not send dump chunk: %w", err)
+	}
+
+	w.buf = make([]byte, 0, dumpStreamChunkSize)
+	return nil
+}
+
+func parseContainerIDs(rawIDs [][]byte) ([]cid.ID, error) {
+	if len(rawIDs) == 0 {
+		return nil, nil
+	}
+
+	cnrs := make([]cid.ID, len(rawIDs))
+	for i := range rawIDs {
+		if err := cnrs[i].Decode(rawIDs[i]); err != nil {
+			return nil, fmt.Errorf("invalid container ID: %w", err)
+		}
+	}
+	return cnrs, nil
+}
+
+func parseObjectTypes(strTypes []string) ([]objectSDK.Type, error) {
+	if len(strTypes) == 0 {
+		return nil, nil
+	}
+
+	types := make([]objectSDK.Type, len(strTypes))
+	for i := range strTypes {
+		if !types[i].FromString(strTypes[i]) {
+			return nil, fmt.Errorf("invalid object type: %s", strTypes[i])
+		}
+	}
+	return types, nil
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
-	"errors"
 	"fmt"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
@@ -28,16 +27,26 @@ func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequ
 	var prm engine.EvacuateShardPrm
 	prm.WithShardIDList(s.getShardIDList(req.GetBody().GetShard_ID()))
 	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
-	prm.WithFaultHandler(s.replicate)
+	if !req.GetBody().GetLocalOnly() {
+		// Objects which can't be put to other local shards
+		// are replicated to other container nodes.
+		prm.WithFaultHandler(s.replicate)
+	}
 
-	res, err := s.s.Evacuate(prm)
+	var res engine.EvacuateShardRes
+	if req.GetBody().GetAsync() {
+		res, err = s.s.StartEvacuation(prm)
+	} else {
+		res, err = s.s.Evacuate(prm)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	resp := &control.EvacuateShardResponse{
 		Body: &control.EvacuateShardResponse_Body{
-			Count: uint32(res.Count()),
+			Count:  uint32(res.Count()),
+			Job_ID: res.JobID(),
 		},
 	}
 
@@ -58,12 +67,12 @@ func (s *Server) replicate(addr oid.Address, obj *objectSDK.Object) error {
 
 	nm, err := s.netMapSrc.GetNetMap(0)
 	if err != nil {
-		return err
+		return fmt.Errorf("can't get network map: %w", err)
 	}
 
 	c, err := s.cnrSrc.Get(cid)
 	if err != nil {
-		return err
+		return fmt.Errorf("can't get container %s: %w", cid, err)
 	}
 
 	binCnr := make([]byte, sha256.Size)
@@ -71,7 +80,7 @@ func (s *Server) replicate(addr oid.Address, obj *objectSDK.Object) error {
 
 	ns, err := nm.ContainerNodes(c.Value.PlacementPolicy(), binCnr)
 	if err != nil {
-		return fmt.Errorf("can't build a list of container nodes")
+		return fmt.Errorf("can't build a list of container nodes: %w", err)
 	}
 
 	nodes := placement.FlattenNodes(ns)
@@ -80,9 +89,14 @@ func (s *Server) replicate(addr oid.Address, obj *objectSDK.Object) error {
 		if bytes.Equal(nodes[i].PublicKey(), bs) {
 			copy(nodes[i:], nodes[i+1:])
 			nodes = nodes[:len(nodes)-1]
+			i--
 		}
 	}
 
+	if len(nodes) == 0 {
+		return fmt.Errorf("no remote container nodes to replicate object %s to", addr)
+	}
+
 	var res replicatorResult
 	var task replicator.Task
 	task.SetObject(obj)
@@ -92,7 +106,7 @@ func (s *Server) replicate(addr oid.Address, obj *objectSDK.Object) error {
 	s.replicator.HandleTask(context.TODO(), task, &res)
 
 	if res.count == 0 {
-		return errors.New("object was not replicated")
+		return fmt.Errorf("object %s was not replicated", addr)
 	}
 	return nil
 }
@@ -0,0 +1,87 @@
+package control
+
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetEvacuationStatus(_ context.Context, req *control.GetEvacuationStatusRequest) (*control.GetEvacuationStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	st, err := s.s.EvacuationStatus()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	body := &control.GetEvacuationStatusResponse_Body{
+		Job_ID:    st.JobID,
+		Status:    evacuationStatusToProto(st.Status),
+		Shards:    make([]*control.ShardEvacuationStatus, 0, len(st.Shards)),
+		StartedAt: st.StartedAt.Unix(),
+	}
+	if !st.FinishedAt.IsZero() {
+		body.FinishedAt = st.FinishedAt.Unix()
+	}
+	if st.Error != nil {
+		body.Error = st.Error.Error()
+	}
+	for i := range st.Shards {
+		body.Shards = append(body.Shards, &control.ShardEvacuationStatus{
+			Shard_ID:   *st.Shards[i].ID,
+			Evacuated:  st.Shards[i].Evacuated,
+			Failed:     st.Shards[i].Failed,
+			Skipped:    st.Shards[i].Skipped,
+			Replicated: st.Shards[i].Replicated,
+		})
+	}
+
+	resp := &control.GetEvacuationStatusResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) StopEvacuation(_ context.Context, req *control.StopEvacuationRequest) (*control.StopEvacuationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.StopEvacuation()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.StopEvacuationResponse{Body: &control.StopEvacuationResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func evacuationStatusToProto(st engine.EvacuationStatus) control.EvacuationStatus {
+	switch st {
+	case engine.EvacuationRunning:
+		return control.EvacuationStatus_EVACUATION_RUNNING
+	case engine.EvacuationCompleted:
+		return control.EvacuationStatus_EVACUATION_COMPLETED
+	case engine.EvacuationFailed:
+		return control.EvacuationStatus_EVACUATION_FAILED
+	case engine.EvacuationStopped:
+		return control.EvacuationStatus_EVACUATION_STOPPED
+	default:
+		return control.EvacuationStatus_EVACUATION_STATUS_UNDEFINED
+	}
+}
@@ -0,0 +1,98 @@
+package control
+
+import (
+	"context"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/engine"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) RebuildMetabase(_ context.Context, req *control.RebuildMetabaseRequest) (*control.RebuildMetabaseResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	for _, shardID := range s.getShardIDList(req.GetBody().GetShard_ID()) {
+		var prm engine.RebuildMetabasePrm
+		prm.SetShardID(shardID)
+
+		_, err = s.s.RebuildMetabase(prm)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	resp := &control.RebuildMetabaseResponse{Body: &control.RebuildMetabaseResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) GetMetabaseRebuildStatus(_ context.Context, req *control.GetMetabaseRebuildStatusRequest) (*control.GetMetabaseRebuildStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	shardIDs := s.getShardIDList(req.GetBody().GetShard_ID())
+	statuses := make([]*control.MetabaseRebuildStatus, 0, len(shardIDs))
+	for _, shardID := range shardIDs {
+		var prm engine.MetabaseRebuildStatusPrm
+		prm.SetShardID(shardID)
+
+		res, err := s.s.MetabaseRebuildStatus(prm)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		statuses = append(statuses, metabaseRebuildStatusToProto(*shardID, res.Status()))
+	}
+
+	resp := &control.GetMetabaseRebuildStatusResponse{
+		Body: &control.GetMetabaseRebuildStatusResponse_Body{
+			Statuses: statuses,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func metabaseRebuildStatusToProto(id shard.ID, st shard.MetabaseRebuildStatus) *control.MetabaseRebuildStatus {
+	res := &control.MetabaseRebuildStatus{
+		Shard_ID:         id,
+		ObjectsProcessed: st.ObjectsProcessed,
+	}
+
+	switch st.State {
+	case shard.MetabaseRebuildRunning:
+		res.State = control.MetabaseRebuildState_METABASE_REBUILD_RUNNING
+	case shard.MetabaseRebuildCompleted:
+		res.State = control.MetabaseRebuildState_METABASE_REBUILD_COMPLETED
+	case shard.MetabaseRebuildFailed:
+		res.State = control.MetabaseRebuildState_METABASE_REBUILD_FAILED
+	default:
+		res.State = control.MetabaseRebuildState_METABASE_REBUILD_NONE
+	}
+
+	if !st.StartedAt.IsZero() {
+		res.StartedAt = st.StartedAt.Unix()
+	}
+	if !st.FinishedAt.IsZero() {
+		res.FinishedAt = st.FinishedAt.Unix()
+	}
+	if st.Error != nil {
+		res.Error = st.Error.Error()
+	}
+	return res
+}
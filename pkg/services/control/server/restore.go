@@ -20,6 +20,7 @@ func (s *Server) RestoreShard(_ context.Context, req *control.RestoreShardReques
 	var prm shard.RestorePrm
 	prm.WithPath(req.GetBody().GetFilepath())
 	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	prm.WithMaxObjectSize(s.maxObjectSize())
 
 	err = s.s.RestoreShard(shardID, prm)
 	if err != nil {
@@ -35,3 +36,11 @@ func (s *Server) RestoreShard(_ context.Context, req *control.RestoreShardReques
 	}
 	return resp, nil
 }
+
+// maxObjectSize returns the MaxObjectSize network setting or 0 if it is unknown.
+func (s *Server) maxObjectSize() uint64 {
+	if s.maxObjSizeSrc == nil {
+		return 0
+	}
+	return s.maxObjSizeSrc.MaxObjectSize()
+}
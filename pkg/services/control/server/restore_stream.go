@@ -0,0 +1,79 @@
+package control
+
+import (
+	"errors"
+	"io"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/shard"
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) RestoreShardStream(srv control.ControlService_RestoreShardStreamServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.isValidRequest(req)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	shardID := shard.NewIDFromBytes(req.GetBody().GetShard_ID())
+
+	pr, pw := io.Pipe()
+
+	var prm shard.RestorePrm
+	prm.WithStream(pr)
+	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	prm.WithMaxObjectSize(s.maxObjectSize())
+
+	done := make(chan error, 1)
+	go func() {
+		err := s.s.RestoreShard(shardID, prm)
+		// Unblock the writer if the restoration has finished before the stream.
+		_ = pr.CloseWithError(io.ErrClosedPipe)
+		done <- err
+	}()
+
+	code := codes.Internal
+	for err == nil {
+		if _, err = pw.Write(req.GetBody().GetChunk()); err != nil {
+			break
+		}
+
+		req, err = srv.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+				break
+			}
+			code = codes.InvalidArgument
+			break
+		}
+
+		if err = s.isValidRequest(req); err != nil {
+			code = codes.PermissionDenied
+		}
+	}
+	_ = pw.CloseWithError(err)
+
+	rErr := <-done
+	if rErr != nil {
+		return status.Error(codes.Internal, rErr.Error())
+	}
+	if err != nil {
+		return status.Error(code, err.Error())
+	}
+
+	resp := new(control.RestoreShardStreamResponse)
+	resp.SetBody(new(control.RestoreShardStreamResponse_Body))
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return srv.SendAndClose(resp)
+}
@@ -45,6 +45,14 @@ type NodeState interface {
 	ForceMaintenance() error
 }
 
+// MaxObjectSizeSource is an interface of the source of the
+// MaxObjectSize network setting.
+type MaxObjectSizeSource interface {
+	// MaxObjectSize must return the maximum payload size of the object
+	// in the network. Zero value means the value is unknown.
+	MaxObjectSize() uint64
+}
+
 // Option of the Server's constructor.
 type Option func(*cfg)
 
@@ -65,6 +73,8 @@ type cfg struct {
 
 	treeService TreeService
 
+	maxObjSizeSrc MaxObjectSizeSource
+
 	s *engine.StorageEngine
 }
 
@@ -151,3 +161,11 @@ func WithTreeService(s TreeService) Option {
 		c.treeService = s
 	}
 }
+
+// WithMaxObjectSizeSource returns option to set the source of the
+// maximum object size used to validate the restored dumps.
+func WithMaxObjectSizeSource(src MaxObjectSizeSource) Option {
+	return func(c *cfg) {
+		c.maxObjSizeSrc = src
+	}
+}
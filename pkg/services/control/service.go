@@ -185,6 +185,34 @@ func (x *RestoreShardResponse) SetBody(v *RestoreShardResponse_Body) {
 	}
 }
 
+// SetBody sets request body.
+func (x *DumpShardStreamRequest) SetBody(v *DumpShardStreamRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets response body.
+func (x *DumpShardStreamResponse) SetBody(v *DumpShardStreamResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets request body.
+func (x *RestoreShardStreamRequest) SetBody(v *RestoreShardStreamRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets response body.
+func (x *RestoreShardStreamResponse) SetBody(v *RestoreShardStreamResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
 // SetBody sets list shards request body.
 func (x *SynchronizeTreeRequest) SetBody(v *SynchronizeTreeRequest_Body) {
 	if x != nil {
@@ -0,0 +1,112 @@
+package notificator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+)
+
+// EventType is a type of the object event.
+type EventType uint8
+
+const (
+	_ EventType = iota
+	// EventPut is emitted when the object is stored on the node.
+	EventPut
+	// EventDelete is emitted when the object is deleted by the tombstone.
+	EventDelete
+	// EventLock is emitted when the object is locked by the lock object.
+	EventLock
+)
+
+// String returns string representation of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EventPut:
+		return "put"
+	case EventDelete:
+		return "delete"
+	case EventLock:
+		return "lock"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// EventTypeFromString parses the event type from its string representation.
+func EventTypeFromString(s string) (EventType, error) {
+	switch s {
+	case "put":
+		return EventPut, nil
+	case "delete":
+		return EventDelete, nil
+	case "lock":
+		return EventLock, nil
+	default:
+		return 0, fmt.Errorf("unknown object event type: %s", s)
+	}
+}
+
+// ObjectEvent describes an operation with the object stored on the node.
+type ObjectEvent struct {
+	// Type is the type of the event.
+	Type EventType
+	// Address is the address of the object.
+	Address oid.Address
+	// Epoch is the epoch the event happened in.
+	Epoch uint64
+
+	// ObjectType is the type of the stored object. Set for EventPut only.
+	ObjectType objectSDK.Type
+	// PayloadSize is the payload size of the stored object. Set for EventPut only.
+	PayloadSize uint64
+	// Owner is the owner of the stored object. Set for EventPut only.
+	Owner user.ID
+
+	// Cause is the ID of the tombstone or the lock object.
+	// Set for EventDelete and EventLock only.
+	Cause oid.ID
+}
+
+type objectEventJSON struct {
+	Event       string `json:"event"`
+	Container   string `json:"container"`
+	Object      string `json:"object"`
+	Epoch       uint64 `json:"epoch"`
+	ObjectType  string `json:"objectType,omitempty"`
+	PayloadSize uint64 `json:"payloadSize,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	Cause       string `json:"cause,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e ObjectEvent) MarshalJSON() ([]byte, error) {
+	v := objectEventJSON{
+		Event:     e.Type.String(),
+		Container: e.Address.Container().EncodeToString(),
+		Object:    e.Address.Object().EncodeToString(),
+		Epoch:     e.Epoch,
+	}
+
+	switch e.Type {
+	case EventPut:
+		v.ObjectType = e.ObjectType.String()
+		v.PayloadSize = e.PayloadSize
+		v.Owner = e.Owner.EncodeToString()
+	case EventDelete, EventLock:
+		v.Cause = e.Cause.EncodeToString()
+	}
+
+	return json.Marshal(v)
+}
+
+// EventWriter notifies all the subscribers
+// about object events.
+type EventWriter interface {
+	// NotifyEvent must notify about an object
+	// event with a specific topic.
+	NotifyEvent(topic string, ev ObjectEvent) error
+}
@@ -0,0 +1,187 @@
+package notificator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	"go.uber.org/zap"
+)
+
+// DefaultEventQueueSize is the default amount of object events
+// which can wait to be written.
+const DefaultEventQueueSize = 1024
+
+// Subscription describes the object events of the container
+// which are passed to the EventWriter.
+type Subscription struct {
+	// Container is the container to pass events of.
+	Container cid.ID
+	// Types contains the event types to pass. All events are passed if empty.
+	Types []EventType
+	// Topic is the topic of the events. Default topic is used if empty.
+	Topic string
+}
+
+// EventPrm groups EventNotificator constructor's
+// parameters. Writer and logger are required.
+type EventPrm struct {
+	writer        EventWriter
+	logger        *logger.Logger
+	defaultTopic  string
+	subscriptions []Subscription
+	queueSize     int
+}
+
+// SetLogger sets a logger.
+func (prm *EventPrm) SetLogger(v *logger.Logger) *EventPrm {
+	prm.logger = v
+	return prm
+}
+
+// SetWriter sets object event writer.
+func (prm *EventPrm) SetWriter(v EventWriter) *EventPrm {
+	prm.writer = v
+	return prm
+}
+
+// SetDefaultTopic sets the topic of the events
+// for subscriptions without topic.
+func (prm *EventPrm) SetDefaultTopic(v string) *EventPrm {
+	prm.defaultTopic = v
+	return prm
+}
+
+// SetSubscriptions sets per-container subscriptions.
+// Events of all containers are passed to the default topic if empty.
+func (prm *EventPrm) SetSubscriptions(v []Subscription) *EventPrm {
+	prm.subscriptions = v
+	return prm
+}
+
+// SetQueueSize sets the amount of events which can wait to be written.
+// DefaultEventQueueSize is used if the value is not positive.
+func (prm *EventPrm) SetQueueSize(v int) *EventPrm {
+	prm.queueSize = v
+	return prm
+}
+
+type queuedEvent struct {
+	topic string
+	ev    ObjectEvent
+}
+
+// EventNotificator is an object event producer which passes
+// events matching the subscriptions to the EventWriter.
+//
+// Working EventNotificator must be created via constructor NewEventNotificator.
+type EventNotificator struct {
+	w EventWriter
+	l *logger.Logger
+
+	defaultTopic string
+	// subs maps container to its subscriptions, nil if all containers are subscribed.
+	subs map[cid.ID][]Subscription
+
+	queue chan queuedEvent
+}
+
+// NewEventNotificator creates, initializes and returns the EventNotificator instance.
+//
+// Panics if writer or logger is not set.
+func NewEventNotificator(prm *EventPrm) *EventNotificator {
+	panicOnNil := func(v any, name string) {
+		if v == nil {
+			panic(fmt.Sprintf("EventNotificator constructor: %s is nil\n", name))
+		}
+	}
+
+	panicOnNil(prm.writer, "EventWriter")
+	panicOnNil(prm.logger, "Logger")
+
+	queueSize := prm.queueSize
+	if queueSize <= 0 {
+		queueSize = DefaultEventQueueSize
+	}
+
+	n := &EventNotificator{
+		w:            prm.writer,
+		l:            prm.logger,
+		defaultTopic: prm.defaultTopic,
+		queue:        make(chan queuedEvent, queueSize),
+	}
+
+	if len(prm.subscriptions) != 0 {
+		n.subs = make(map[cid.ID][]Subscription, len(prm.subscriptions))
+		for _, s := range prm.subscriptions {
+			n.subs[s.Container] = append(n.subs[s.Container], s)
+		}
+	}
+
+	return n
+}
+
+// Notify queues the event for every subscription matching it.
+// Events are dropped if the queue is full, so that object
+// operations are never blocked by a slow writer.
+func (n *EventNotificator) Notify(ev ObjectEvent) {
+	if n.subs == nil {
+		n.enqueue(n.defaultTopic, ev)
+		return
+	}
+
+	for _, s := range n.subs[ev.Address.Container()] {
+		if !s.matches(ev.Type) {
+			continue
+		}
+
+		topic := s.Topic
+		if topic == "" {
+			topic = n.defaultTopic
+		}
+		n.enqueue(topic, ev)
+	}
+}
+
+func (n *EventNotificator) enqueue(topic string, ev ObjectEvent) {
+	select {
+	case n.queue <- queuedEvent{topic: topic, ev: ev}:
+	default:
+		n.l.Warn("notificator: event queue is full, object event is dropped",
+			zap.Stringer("type", ev.Type),
+			zap.Stringer("address", ev.Address),
+		)
+	}
+}
+
+// Run passes the queued events to the EventWriter until ctx is done.
+func (n *EventNotificator) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case qe := <-n.queue:
+			if err := n.w.NotifyEvent(qe.topic, qe.ev); err != nil {
+				n.l.Warn("could not write object event",
+					zap.Stringer("type", qe.ev.Type),
+					zap.Stringer("address", qe.ev.Address),
+					zap.String("topic", qe.topic),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+func (s Subscription) matches(t EventType) bool {
+	if len(s.Types) == 0 {
+		return true
+	}
+	for i := range s.Types {
+		if s.Types[i] == t {
+			return true
+		}
+	}
+	return false
+}
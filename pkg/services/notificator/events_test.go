@@ -0,0 +1,108 @@
+package notificator
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type testEventWriter struct {
+	mtx    sync.Mutex
+	events map[string][]ObjectEvent
+}
+
+func (w *testEventWriter) NotifyEvent(topic string, ev ObjectEvent) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	w.events[topic] = append(w.events[topic], ev)
+	return nil
+}
+
+func (w *testEventWriter) count() int {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	var n int
+	for _, evs := range w.events {
+		n += len(evs)
+	}
+	return n
+}
+
+func TestEventNotificator(t *testing.T) {
+	cnr1, cnr2, cnr3 := cidtest.ID(), cidtest.ID(), cidtest.ID()
+
+	w := &testEventWriter{events: make(map[string][]ObjectEvent)}
+	n := NewEventNotificator(new(EventPrm).
+		SetLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}).
+		SetWriter(w).
+		SetDefaultTopic("default").
+		SetSubscriptions([]Subscription{
+			{Container: cnr1},
+			{Container: cnr2, Types: []EventType{EventDelete}, Topic: "deleted"},
+			{Container: cnr2, Types: []EventType{EventPut, EventDelete}, Topic: "all"},
+		}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go n.Run(ctx)
+
+	newEvent := func(typ EventType, cnr cid.ID) ObjectEvent {
+		ev := ObjectEvent{Type: typ}
+		ev.Address.SetContainer(cnr)
+		ev.Address.SetObject(oidtest.ID())
+		return ev
+	}
+
+	n.Notify(newEvent(EventPut, cnr1))
+	n.Notify(newEvent(EventLock, cnr1))
+	n.Notify(newEvent(EventPut, cnr2))
+	n.Notify(newEvent(EventDelete, cnr2))
+	n.Notify(newEvent(EventLock, cnr2))
+	n.Notify(newEvent(EventPut, cnr3))
+
+	require.Eventually(t, func() bool { return w.count() == 5 }, time.Second, 10*time.Millisecond)
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	require.Len(t, w.events["default"], 2)
+	require.Len(t, w.events["deleted"], 1)
+	require.Len(t, w.events["all"], 2)
+	require.Equal(t, EventDelete, w.events["deleted"][0].Type)
+}
+
+func TestObjectEvent_MarshalJSON(t *testing.T) {
+	ev := ObjectEvent{
+		Type:        EventPut,
+		Address:     oidtest.Address(),
+		Epoch:       10,
+		ObjectType:  objectSDK.TypeRegular,
+		PayloadSize: 123,
+		Owner:       *usertest.ID(),
+	}
+
+	data, err := json.Marshal(ev)
+	require.NoError(t, err)
+
+	var m map[string]any
+	require.NoError(t, json.Unmarshal(data, &m))
+	require.Equal(t, "put", m["event"])
+	require.Equal(t, ev.Address.Container().EncodeToString(), m["container"])
+	require.Equal(t, ev.Address.Object().EncodeToString(), m["object"])
+	require.Equal(t, "REGULAR", m["objectType"])
+	require.Equal(t, float64(123), m["payloadSize"])
+	require.Equal(t, ev.Owner.EncodeToString(), m["owner"])
+	require.Equal(t, float64(10), m["epoch"])
+	require.NotContains(t, m, "cause")
+}
@@ -0,0 +1,73 @@
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Writer is an object notification writer which appends
+// notifications to the local file in JSON lines format.
+//
+// For correct operation must be created via New function.
+type Writer struct {
+	mtx sync.Mutex
+	f   *os.File
+}
+
+var _ notificator.EventWriter = (*Writer)(nil)
+
+// New opens the file for appending, creating it if necessary,
+// and returns the Writer.
+func New(path string) (*Writer, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("could not create notification file directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("could not open notification file: %w", err)
+	}
+
+	return &Writer{f: f}, nil
+}
+
+// Notify appends the JSON line with the object address and the provided topic.
+func (w *Writer) Notify(topic string, address oid.Address) error {
+	return w.write(notificator.NotificationMessage(topic, address))
+}
+
+// NotifyEvent appends the JSON line with the object event and the provided topic.
+func (w *Writer) NotifyEvent(topic string, ev notificator.ObjectEvent) error {
+	return w.write(notificator.EventMessage(topic, ev))
+}
+
+func (w *Writer) write(msg notificator.Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("could not encode message: %w", err)
+	}
+	data = append(data, '\n')
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	// Single write call keeps lines whole for concurrent readers.
+	if _, err := w.f.Write(data); err != nil {
+		return fmt.Errorf("could not write message: %w", err)
+	}
+	return nil
+}
+
+// Close closes the underlying file.
+func (w *Writer) Close() error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return w.f.Close()
+}
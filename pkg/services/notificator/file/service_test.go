@@ -0,0 +1,52 @@
+package file
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "notifications.jsonl")
+
+	addr := oidtest.Address()
+	ev := notificator.ObjectEvent{Type: notificator.EventDelete, Address: oidtest.Address()}
+
+	w, err := New(path)
+	require.NoError(t, err)
+	require.NoError(t, w.Notify("topic", addr))
+	require.NoError(t, w.Close())
+
+	// Reopened file is appended.
+	w, err = New(path)
+	require.NoError(t, err)
+	require.NoError(t, w.NotifyEvent("events", ev))
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []map[string]any
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var m map[string]any
+		require.NoError(t, json.Unmarshal(s.Bytes(), &m))
+		lines = append(lines, m)
+	}
+	require.NoError(t, s.Err())
+
+	require.Len(t, lines, 2)
+	require.Equal(t, "topic", lines[0]["topic"])
+	require.Equal(t, addr.EncodeToString(), lines[0]["address"])
+	require.NotContains(t, lines[0], "event")
+	require.Equal(t, "events", lines[1]["topic"])
+	require.Equal(t, "delete", lines[1]["event"].(map[string]any)["event"])
+	require.NotContains(t, lines[1], "address")
+}
@@ -0,0 +1,35 @@
+package notificator
+
+import (
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+)
+
+// Message is the JSON representation of the object notification or the
+// object event used by the writers which have no native notion of topics.
+//
+// Exactly one of Address and Event is set.
+type Message struct {
+	// Topic is the topic of the notification or the event.
+	Topic string `json:"topic"`
+	// Address is the string representation of the object address.
+	// Set for object notifications only.
+	Address string `json:"address,omitempty"`
+	// Event is the object event. Set for object events only.
+	Event *ObjectEvent `json:"event,omitempty"`
+}
+
+// NotificationMessage returns Message of the object notification.
+func NotificationMessage(topic string, address oid.Address) Message {
+	return Message{
+		Topic:   topic,
+		Address: address.EncodeToString(),
+	}
+}
+
+// EventMessage returns Message of the object event.
+func EventMessage(topic string, ev ObjectEvent) Message {
+	return Message{
+		Topic: topic,
+		Event: &ev,
+	}
+}
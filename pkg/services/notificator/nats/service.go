@@ -2,10 +2,12 @@ package nats
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
 
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator"
 	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 	"github.com/nats-io/nats.go"
@@ -29,6 +31,8 @@ type Writer struct {
 	opts
 }
 
+var _ notificator.EventWriter = (*Writer)(nil)
+
 type opts struct {
 	log   *logger.Logger
 	nOpts []nats.Option
@@ -54,6 +58,47 @@ func (n *Writer) Notify(topic string, address oid.Address) error {
 	// message ID for the 'exactly once' delivery
 	messageID := address.Object().EncodeToString()[:4]
 
+	if err := n.addStream(topic); err != nil {
+		return err
+	}
+
+	_, err := n.js.Publish(topic, []byte(address.EncodeToString()), nats.MsgId(messageID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NotifyEvent sends JSON representation of the object event to the provided topic.
+// Uses event type and object address as a message ID to support 'exactly once'
+// message delivery.
+//
+// Returns error only if:
+// 1. underlying connection was closed and has not been established again;
+// 2. NATS server could not respond that it has saved the message.
+func (n *Writer) NotifyEvent(topic string, ev notificator.ObjectEvent) error {
+	if !n.nc.IsConnected() {
+		return errConnIsClosed
+	}
+
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("could not encode object event: %w", err)
+	}
+
+	if err := n.addStream(topic); err != nil {
+		return err
+	}
+
+	messageID := ev.Type.String() + "/" + ev.Address.EncodeToString()
+
+	_, err = n.js.Publish(topic, data, nats.MsgId(messageID))
+	return err
+}
+
+// addStream creates the stream for the topic if it was not created before.
+func (n *Writer) addStream(topic string) error {
 	// check if the stream was previously created
 	n.m.RLock()
 	_, created := n.createdStreams[topic]
@@ -71,12 +116,6 @@ func (n *Writer) Notify(topic string, address oid.Address) error {
 		n.createdStreams[topic] = struct{}{}
 		n.m.Unlock()
 	}
-
-	_, err := n.js.Publish(topic, []byte(address.EncodeToString()), nats.MsgId(messageID))
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a single delivery attempt.
+	DefaultTimeout = 5 * time.Second
+	// DefaultQueueSize is the default maximum amount of undelivered messages.
+	DefaultQueueSize = 10000
+	// DefaultMinBackoff is the default delay before the first retry.
+	DefaultMinBackoff = time.Second
+	// DefaultMaxBackoff is the default maximum delay between retries.
+	DefaultMaxBackoff = time.Minute
+)
+
+// WithLogger returns option to specify Writer's logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(o *opts) {
+		o.log = l
+	}
+}
+
+// WithSecret returns option to specify the key of the HMAC-SHA256
+// signature of the request body. Requests are not signed if the key is empty.
+func WithSecret(secret []byte) Option {
+	return func(o *opts) {
+		o.secret = secret
+	}
+}
+
+// WithTimeout returns option to specify the timeout of a single delivery attempt.
+// Non-positive values are ignored.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *opts) {
+		if timeout > 0 {
+			o.timeout = timeout
+		}
+	}
+}
+
+// WithQueueSize returns option to specify the maximum amount of undelivered
+// messages stored on disk. Non-positive values are ignored.
+func WithQueueSize(size int) Option {
+	return func(o *opts) {
+		if size > 0 {
+			o.queueSize = size
+		}
+	}
+}
+
+// WithBackoff returns option to specify the minimum and the maximum delay
+// between delivery attempts. The delay doubles after every failed attempt.
+// Non-positive values are ignored.
+func WithBackoff(min, max time.Duration) Option {
+	return func(o *opts) {
+		if min > 0 {
+			o.minBackoff = min
+		}
+		if max > 0 {
+			o.maxBackoff = max
+		}
+	}
+}
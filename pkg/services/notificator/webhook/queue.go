@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const tmpSuffix = ".tmp"
+
+// ErrQueueFull is returned when the amount of undelivered messages
+// reaches the queue size.
+var ErrQueueFull = errors.New("webhook queue is full")
+
+// queue is a bounded FIFO of messages stored on disk, one file per message.
+// File names are sequence numbers, so that the order survives restarts.
+type queue struct {
+	path     string
+	capacity uint64
+
+	mtx sync.Mutex
+	// first and next are the bounds of the [first, next) range
+	// of sequence numbers of the stored messages.
+	first, next uint64
+}
+
+func openQueue(path string, capacity int) (*queue, error) {
+	if err := os.MkdirAll(path, 0o700); err != nil {
+		return nil, fmt.Errorf("could not create queue directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read queue directory: %w", err)
+	}
+
+	q := &queue{
+		path:     path,
+		capacity: uint64(capacity),
+	}
+
+	var found bool
+	for _, e := range entries {
+		name := e.Name()
+		if strings.HasSuffix(name, tmpSuffix) {
+			// Message was not completely written.
+			_ = os.Remove(filepath.Join(path, name))
+			continue
+		}
+
+		seq, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if !found || seq < q.first {
+			q.first = seq
+		}
+		if !found || seq >= q.next {
+			q.next = seq + 1
+		}
+		found = true
+	}
+
+	return q, nil
+}
+
+func (q *queue) fileName(seq uint64) string {
+	return filepath.Join(q.path, fmt.Sprintf("%020d", seq))
+}
+
+// len returns the amount of stored messages.
+func (q *queue) len() int {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	return int(q.next - q.first)
+}
+
+// push stores the message at the end of the queue.
+func (q *queue) push(data []byte) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if q.next-q.first >= q.capacity {
+		return ErrQueueFull
+	}
+
+	name := q.fileName(q.next)
+	if err := os.WriteFile(name+tmpSuffix, data, 0o600); err != nil {
+		return fmt.Errorf("could not write message: %w", err)
+	}
+	if err := os.Rename(name+tmpSuffix, name); err != nil {
+		_ = os.Remove(name + tmpSuffix)
+		return fmt.Errorf("could not write message: %w", err)
+	}
+
+	q.next++
+	return nil
+}
+
+// peek returns the first message of the queue and its sequence number.
+// Returns false if the queue is empty.
+func (q *queue) peek() (uint64, []byte, bool, error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	for q.first < q.next {
+		data, err := os.ReadFile(q.fileName(q.first))
+		if err == nil {
+			return q.first, data, true, nil
+		}
+		if !os.IsNotExist(err) {
+			return q.first, nil, true, fmt.Errorf("could not read message: %w", err)
+		}
+		q.first++
+	}
+	return 0, nil, false, nil
+}
+
+// pop removes the message with the sequence number returned by peek.
+// The message is skipped even if its file can't be removed, so it is
+// delivered again only after the restart.
+func (q *queue) pop(seq uint64) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if seq != q.first || q.first == q.next {
+		return nil
+	}
+
+	q.first++
+
+	if err := os.Remove(q.fileName(seq)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not remove message: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// SignatureHeader is the HTTP header containing hex-encoded HMAC-SHA256
+// signature of the request body prefixed with "sha256=".
+const SignatureHeader = "X-FrostFS-Signature"
+
+// Writer is a webhook object notification writer.
+// It stores notifications in the bounded on-disk queue
+// and POSTs them as JSON to the configured URL in order,
+// retrying failed deliveries with exponential backoff.
+//
+// For correct operation must be created via New function
+// and run via Run method.
+type Writer struct {
+	url    string
+	client *http.Client
+	q      *queue
+
+	// notifyCh wakes up the delivery routine on new messages.
+	notifyCh chan struct{}
+	opts
+}
+
+var _ notificator.EventWriter = (*Writer)(nil)
+
+type opts struct {
+	log        *logger.Logger
+	secret     []byte
+	timeout    time.Duration
+	queueSize  int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+type Option func(*opts)
+
+// permanentError is a delivery error which is not fixed by retries.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+
+func (e permanentError) Unwrap() error { return e.err }
+
+// New creates new Writer which sends messages to the url. Undelivered
+// messages are stored in the queuePath directory and are sent after restart.
+func New(url, queuePath string, oo ...Option) (*Writer, error) {
+	w := &Writer{
+		url:      url,
+		notifyCh: make(chan struct{}, 1),
+		opts: opts{
+			log:        &logger.Logger{Logger: zap.L()},
+			timeout:    DefaultTimeout,
+			queueSize:  DefaultQueueSize,
+			minBackoff: DefaultMinBackoff,
+			maxBackoff: DefaultMaxBackoff,
+		},
+	}
+
+	for _, o := range oo {
+		o(&w.opts)
+	}
+
+	if w.maxBackoff < w.minBackoff {
+		w.maxBackoff = w.minBackoff
+	}
+
+	w.client = &http.Client{Timeout: w.timeout}
+
+	q, err := openQueue(queuePath, w.queueSize)
+	if err != nil {
+		return nil, err
+	}
+	w.q = q
+
+	return w, nil
+}
+
+// Notify queues the JSON message with the object address to be sent with the provided topic.
+//
+// Returns ErrQueueFull if there are too many undelivered messages.
+func (w *Writer) Notify(topic string, address oid.Address) error {
+	return w.enqueue(notificator.NotificationMessage(topic, address))
+}
+
+// NotifyEvent queues the JSON message with the object event to be sent with the provided topic.
+//
+// Returns ErrQueueFull if there are too many undelivered messages.
+func (w *Writer) NotifyEvent(topic string, ev notificator.ObjectEvent) error {
+	return w.enqueue(notificator.EventMessage(topic, ev))
+}
+
+func (w *Writer) enqueue(msg notificator.Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("could not encode message: %w", err)
+	}
+
+	if err := w.q.push(data); err != nil {
+		return err
+	}
+
+	select {
+	case w.notifyCh <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run delivers the queued messages until ctx is done.
+//
+// Messages rejected by the receiver with 4xx status (except 408 and 429)
+// are dropped, other failures are retried until the message is delivered.
+func (w *Writer) Run(ctx context.Context) {
+	backoff := w.minBackoff
+	for {
+		seq, data, ok, err := w.q.peek()
+		if err != nil {
+			w.log.Error("webhook: could not read queued message, skip it", zap.Error(err))
+			w.pop(seq)
+			continue
+		}
+
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.notifyCh:
+				continue
+			}
+		}
+
+		err = w.send(ctx, data)
+		if err == nil || errors.As(err, new(permanentError)) {
+			if err != nil {
+				w.log.Error("webhook: message was rejected, drop it", zap.Error(err))
+			}
+
+			w.pop(seq)
+			backoff = w.minBackoff
+			continue
+		}
+
+		w.log.Warn("webhook: could not deliver message",
+			zap.Duration("retry_in", backoff),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > w.maxBackoff {
+			backoff = w.maxBackoff
+		}
+	}
+}
+
+func (w *Writer) pop(seq uint64) {
+	if err := w.q.pop(seq); err != nil {
+		w.log.Warn("webhook: could not remove message from the queue", zap.Error(err))
+	}
+}
+
+func (w *Writer) send(ctx context.Context, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return permanentError{err: fmt.Errorf("could not create request: %w", err)}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.secret) != 0 {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(w.secret, data))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusRequestTimeout,
+		resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode >= 500:
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	default:
+		return permanentError{err: fmt.Errorf("unexpected status: %s", resp.Status)}
+	}
+}
+
+// Sign returns hex-encoded HMAC-SHA256 signature of the data.
+func Sign(secret, data []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
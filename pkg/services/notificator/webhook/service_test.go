@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/notificator"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	oidtest "github.com/TrueCloudLab/frostfs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type testReceiver struct {
+	t      *testing.T
+	secret []byte
+
+	mtx      sync.Mutex
+	statuses []int // statuses to respond with before accepting the messages
+	messages []map[string]any
+}
+
+func (r *testReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	data, err := io.ReadAll(req.Body)
+	assert.NoError(r.t, err)
+	assert.Equal(r.t, "sha256="+Sign(r.secret, data), req.Header.Get(SignatureHeader))
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if len(r.statuses) != 0 {
+		status := r.statuses[0]
+		r.statuses = r.statuses[1:]
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+	}
+
+	var m map[string]any
+	assert.NoError(r.t, json.Unmarshal(data, &m))
+	r.messages = append(r.messages, m)
+}
+
+func (r *testReceiver) received() []map[string]any {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return append([]map[string]any(nil), r.messages...)
+}
+
+func TestWriter(t *testing.T) {
+	secret := []byte("secret")
+
+	newWriter := func(t *testing.T, url, path string, queueSize int) *Writer {
+		w, err := New(url, path,
+			WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+			WithSecret(secret),
+			WithQueueSize(queueSize),
+			WithBackoff(time.Millisecond, 10*time.Millisecond))
+		require.NoError(t, err)
+		return w
+	}
+
+	run := func(t *testing.T, w *Writer) {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			w.Run(ctx)
+			close(done)
+		}()
+		t.Cleanup(func() {
+			cancel()
+			<-done
+		})
+	}
+
+	t.Run("retry", func(t *testing.T) {
+		r := &testReceiver{t: t, secret: secret, statuses: []int{
+			http.StatusInternalServerError,
+			http.StatusTooManyRequests,
+			http.StatusBadRequest, // drops the first message
+		}}
+		srv := httptest.NewServer(r)
+		defer srv.Close()
+
+		w := newWriter(t, srv.URL, t.TempDir(), 10)
+		run(t, w)
+
+		addr1, addr2 := oidtest.Address(), oidtest.Address()
+		require.NoError(t, w.Notify("topic", addr1))
+		require.NoError(t, w.Notify("topic", addr2))
+
+		ev := notificator.ObjectEvent{Type: notificator.EventLock, Address: oidtest.Address()}
+		require.NoError(t, w.NotifyEvent("events", ev))
+
+		require.Eventually(t, func() bool { return len(r.received()) == 2 }, time.Second, 10*time.Millisecond)
+
+		msgs := r.received()
+		require.Equal(t, "topic", msgs[0]["topic"])
+		require.Equal(t, addr2.EncodeToString(), msgs[0]["address"])
+		require.Equal(t, "events", msgs[1]["topic"])
+		require.Equal(t, "lock", msgs[1]["event"].(map[string]any)["event"])
+		require.Equal(t, 0, w.q.len())
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		path := t.TempDir()
+
+		w := newWriter(t, "http://localhost", path, 2)
+		require.NoError(t, w.Notify("topic", oidtest.Address()))
+		require.NoError(t, w.Notify("topic", oidtest.Address()))
+		require.ErrorIs(t, w.Notify("topic", oidtest.Address()), ErrQueueFull)
+
+		// Queued messages are delivered after restart.
+		r := &testReceiver{t: t, secret: secret}
+		srv := httptest.NewServer(r)
+		defer srv.Close()
+
+		w = newWriter(t, srv.URL, path, 2)
+		require.Equal(t, 2, w.q.len())
+		run(t, w)
+
+		require.Eventually(t, func() bool { return len(r.received()) == 2 }, time.Second, 10*time.Millisecond)
+		require.Eventually(t, func() bool { return w.q.len() == 0 }, time.Second, 10*time.Millisecond)
+		require.NoError(t, w.Notify("topic", oidtest.Address()))
+		require.Eventually(t, func() bool { return len(r.received()) == 3 }, time.Second, 10*time.Millisecond)
+	})
+}
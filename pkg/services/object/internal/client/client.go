@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 
 	coreclient "github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-sdk-go/bearer"
 	"github.com/TrueCloudLab/frostfs-sdk-go/client"
 	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
@@ -459,6 +461,11 @@ type SearchObjectsPrm struct {
 	readPrmCommon
 
 	cliPrm client.PrmObjectSearch
+
+	numeric bool
+
+	cursor *oid.ID
+	limit  uint32
 }
 
 // SetContainerID sets identifier of the container to search the objects.
@@ -471,6 +478,26 @@ func (x *SearchObjectsPrm) SetContainerID(id cid.ID) {
 // SetFilters sets search filters.
 func (x *SearchObjectsPrm) SetFilters(fs object.SearchFilters) {
 	x.cliPrm.SetFilters(fs)
+
+	x.numeric = false
+	for i := range fs {
+		if objectcore.IsNumericMatch(fs[i].Operation()) {
+			x.numeric = true
+			break
+		}
+	}
+}
+
+// SetCursor sets the identifier of the last object from the previous page.
+// It is sent in the objectcore.XHeaderSearchCursor X-header.
+func (x *SearchObjectsPrm) SetCursor(cursor *oid.ID) {
+	x.cursor = cursor
+}
+
+// SetLimit sets the maximum number of the returned objects. Zero means no limit.
+// It is sent in the objectcore.XHeaderSearchLimit X-header.
+func (x *SearchObjectsPrm) SetLimit(limit uint32) {
+	x.limit = limit
 }
 
 // SearchObjectsRes groups the resulting values of SearchObjects operation.
@@ -483,10 +510,18 @@ func (x SearchObjectsRes) IDList() []oid.ID {
 	return x.ids
 }
 
+var errNumericSearchNotSupported = errors.New("numeric search filters are not supported by the client")
+
 // SearchObjects selects objects from container which match the filters.
 //
 // Returns any error which prevented the operation from completing correctly in error return.
 func SearchObjects(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
+	if prm.numeric {
+		// SDK client converts numeric match types to the unknown ones,
+		// so the request can be served only by the raw request forwarding.
+		return nil, errNumericSearchNotSupported
+	}
+
 	if prm.local {
 		prm.cliPrm.MarkLocal()
 	}
@@ -499,7 +534,7 @@ func SearchObjects(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
 		prm.cliPrm.WithBearerToken(*prm.tokenBearer)
 	}
 
-	prm.cliPrm.WithXHeaders(prm.xHeaders...)
+	prm.cliPrm.WithXHeaders(searchXHeaders(prm.xHeaders, prm.cursor, prm.limit)...)
 
 	if prm.key != nil {
 		prm.cliPrm.UseKey(*prm.key)
@@ -543,3 +578,26 @@ func SearchObjects(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
 		ids: ids,
 	}, nil
 }
+
+// searchXHeaders returns the X-headers with the search cursor and limit
+// replacing the ones from the original request.
+func searchXHeaders(xHeaders []string, cursor *oid.ID, limit uint32) []string {
+	if cursor == nil && limit == 0 {
+		return xHeaders
+	}
+
+	res := make([]string, 0, len(xHeaders)+4)
+	for i := 0; i+1 < len(xHeaders); i += 2 {
+		if xHeaders[i] != objectcore.XHeaderSearchCursor && xHeaders[i] != objectcore.XHeaderSearchLimit {
+			res = append(res, xHeaders[i], xHeaders[i+1])
+		}
+	}
+
+	if cursor != nil {
+		res = append(res, objectcore.XHeaderSearchCursor, cursor.EncodeToString())
+	}
+	if limit != 0 {
+		res = append(res, objectcore.XHeaderSearchLimit, strconv.FormatUint(uint64(limit), 10))
+	}
+	return res
+}
@@ -0,0 +1,279 @@
+package putsvc
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/TrueCloudLab/frostfs-api-go/v2/container"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/object"
+	"github.com/TrueCloudLab/frostfs-api-go/v2/status"
+	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	objectSDK "github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"go.uber.org/zap"
+)
+
+const (
+	// AttributeQuotaSoftSize is a container attribute with the payload size
+	// in bytes which the container can exceed with warnings only.
+	AttributeQuotaSoftSize = container.SysAttributePrefix + "QUOTA_SOFT_SIZE"
+
+	// AttributeQuotaHardSize is a container attribute with the payload size
+	// in bytes after which new objects are not accepted to the container.
+	AttributeQuotaHardSize = container.SysAttributePrefix + "QUOTA_HARD_SIZE"
+
+	// AttributeQuotaSoftObjects is a container attribute with the number
+	// of objects which the container can exceed with warnings only.
+	AttributeQuotaSoftObjects = container.SysAttributePrefix + "QUOTA_SOFT_OBJECTS"
+
+	// AttributeQuotaHardObjects is a container attribute with the number
+	// of objects after which new objects are not accepted to the container.
+	AttributeQuotaHardObjects = container.SysAttributePrefix + "QUOTA_HARD_OBJECTS"
+)
+
+// Quota is a limit of the stored payload size in bytes and of the number
+// of stored objects. Zero values mean no limit.
+type Quota struct {
+	Soft, Hard uint64
+
+	SoftObjects, HardObjects uint64
+}
+
+// UsageSource provides the payload size stored in containers.
+type UsageSource interface {
+	// ContainerUsage returns the payload size stored in the container.
+	ContainerUsage(cid.ID) (uint64, error)
+
+	// OwnerUsage returns the payload size stored in all containers
+	// of the owner.
+	OwnerUsage(user.ID) (uint64, error)
+}
+
+// ObjectCountSource provides the number of regular objects (including parts of
+// the split objects) stored in containers. Unlike the payload size, the numbers
+// are expected to describe the local storage of the node, so the object quotas
+// are checked on each container node saving the object.
+type ObjectCountSource interface {
+	// ContainerObjects returns the number of objects stored in the container.
+	ContainerObjects(cid.ID) (uint64, error)
+
+	// OwnerObjects returns the number of objects stored in all containers
+	// of the owner.
+	OwnerObjects(user.ID) (uint64, error)
+}
+
+// OwnerQuotaSource provides quotas of the payload size and of the number
+// of objects stored in all containers of the owner.
+type OwnerQuotaSource interface {
+	// OwnerQuota returns the quota of the owner.
+	OwnerQuota(user.ID) Quota
+}
+
+// StatusQuotaExceeded is a local object status code of the failure returned
+// when the object doesn't fit into the quota of the container or its owner.
+// It follows the object failure codes defined by the API.
+const StatusQuotaExceeded = object.StatusOutOfRange + 1
+
+// QuotaExceeded describes the status of the object PUT failure because of
+// the exceeded storage quota. Implements apistatus.StatusV2.
+type QuotaExceeded struct {
+	v2 status.Status
+}
+
+// Error implements the error interface.
+func (x QuotaExceeded) Error() string {
+	return fmt.Sprintf("status: code = %d message = %s", x.code(), x.v2.Message())
+}
+
+func (x QuotaExceeded) code() status.Code {
+	code := StatusQuotaExceeded
+	object.GlobalizeFail(&code)
+
+	return code
+}
+
+// ToStatusV2 converts QuotaExceeded to v2's Status.
+// Implements apistatus.StatusV2.
+func (x QuotaExceeded) ToStatusV2() *status.Status {
+	x.v2.SetCode(x.code())
+	return &x.v2
+}
+
+// Reason returns the description of the exceeded quota.
+func (x QuotaExceeded) Reason() string {
+	return x.v2.Message()
+}
+
+const quotaExceededReasonFmt = "%s quota exceeded: used %d, limit %d"
+
+func quotaExceededError(subject string, used, limit uint64) error {
+	var st QuotaExceeded
+	st.v2.SetMessage(fmt.Sprintf(quotaExceededReasonFmt, subject, used, limit))
+
+	return st
+}
+
+// ContainerQuota returns the quota set in the container attributes.
+func ContainerQuota(cnr containerSDK.Container) (Quota, error) {
+	var q Quota
+
+	for _, a := range []struct {
+		key string
+		dst *uint64
+	}{
+		{AttributeQuotaSoftSize, &q.Soft},
+		{AttributeQuotaHardSize, &q.Hard},
+		{AttributeQuotaSoftObjects, &q.SoftObjects},
+		{AttributeQuotaHardObjects, &q.HardObjects},
+	} {
+		var err error
+
+		*a.dst, err = parseQuotaAttribute(cnr, a.key)
+		if err != nil {
+			return q, err
+		}
+	}
+
+	return q, nil
+}
+
+func parseQuotaAttribute(cnr containerSDK.Container, key string) (uint64, error) {
+	v := cnr.Attribute(key)
+	if v == "" {
+		return 0, nil
+	}
+
+	res, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s attribute: %w", key, err)
+	}
+
+	return res, nil
+}
+
+// checkQuotas checks that the object with the given payload size
+// fits into the quotas of the container and its owner.
+//
+// Only regular objects are checked, so that the objects can
+// still be removed or locked in the container over the quota.
+func (p *Streamer) checkQuotas(prm *PutInitPrm, idCnr cid.ID) error {
+	// local-only requests are sent by the container nodes
+	// for the object which has already passed the size checks
+	checkSize := p.usageSrc != nil && !prm.common.LocalOnly()
+	checkCount := p.countSrc != nil
+
+	if !checkSize && !checkCount || prm.hdr.Type() != objectSDK.TypeRegular {
+		return nil
+	}
+
+	cnrQuota, err := ContainerQuota(prm.cnr)
+	if err != nil {
+		p.log.Warn("ignore container quota", zap.Stringer("cid", idCnr), zap.Error(err))
+		cnrQuota = Quota{}
+	}
+
+	owner := prm.cnr.Owner()
+
+	var ownerQuota Quota
+	if p.ownerQuotaSrc != nil {
+		ownerQuota = p.ownerQuotaSrc.OwnerQuota(owner)
+	}
+
+	cnrField := zap.Stringer("cid", idCnr)
+	ownerField := zap.Stringer("owner", owner)
+
+	if checkSize {
+		// payload size in the header can be unknown (0) or not match
+		// the actual payload, so it is checked while the payload is streamed too
+		size := prm.hdr.PayloadSize()
+
+		used, err := p.checkQuota(cnrQuota.Soft, cnrQuota.Hard, size, "container storage", cnrField, func() (uint64, error) {
+			return p.usageSrc.ContainerUsage(idCnr)
+		})
+		if err != nil {
+			return err
+		}
+		p.addPayloadQuota("container storage", used, cnrQuota.Hard)
+
+		used, err = p.checkQuota(ownerQuota.Soft, ownerQuota.Hard, size, "owner storage", ownerField, func() (uint64, error) {
+			return p.usageSrc.OwnerUsage(owner)
+		})
+		if err != nil {
+			return err
+		}
+		p.addPayloadQuota("owner storage", used, ownerQuota.Hard)
+	}
+
+	if checkCount {
+		_, err = p.checkQuota(cnrQuota.SoftObjects, cnrQuota.HardObjects, 1, "container object", cnrField, func() (uint64, error) {
+			return p.countSrc.ContainerObjects(idCnr)
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = p.checkQuota(ownerQuota.SoftObjects, ownerQuota.HardObjects, 1, "owner object", ownerField, func() (uint64, error) {
+			return p.countSrc.OwnerObjects(owner)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkQuota checks that delta can be added to the usage returned by used
+// without exceeding the hard limit and returns the usage. The usage is not
+// requested if there are no limits.
+func (p *Streamer) checkQuota(soft, hard, delta uint64, subject string, field zap.Field, used func() (uint64, error)) (uint64, error) {
+	if soft == 0 && hard == 0 {
+		return 0, nil
+	}
+
+	u, err := used()
+	if err != nil {
+		return 0, fmt.Errorf("could not get %s usage: %w", subject, err)
+	}
+
+	if hard != 0 && u+delta > hard {
+		return 0, quotaExceededError(subject, u, hard)
+	}
+
+	if soft != 0 && u+delta > soft {
+		p.log.Warn("quota soft limit exceeded",
+			zap.String("subject", subject),
+			field,
+			zap.Uint64("used", u),
+			zap.Uint64("limit", soft),
+		)
+	}
+
+	return u, nil
+}
+
+// payloadQuota is a hard limit of the payload size checked
+// while the object payload is streamed.
+type payloadQuota struct {
+	subject     string
+	used, limit uint64
+}
+
+func (p *Streamer) addPayloadQuota(subject string, used, limit uint64) {
+	if limit != 0 {
+		p.payloadQuotas = append(p.payloadQuotas, payloadQuota{subject: subject, used: used, limit: limit})
+	}
+}
+
+// checkPayloadQuotas checks that n more bytes of the payload
+// fit into the payload size quotas.
+func (p *Streamer) checkPayloadQuotas(n int) error {
+	p.written += uint64(n)
+	for _, q := range p.payloadQuotas {
+		if q.used+p.written > q.limit {
+			return quotaExceededError(q.subject, q.used, q.limit)
+		}
+	}
+	return nil
+}
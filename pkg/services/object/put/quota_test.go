@@ -0,0 +1,219 @@
+package putsvc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	apistatus "github.com/TrueCloudLab/frostfs-sdk-go/client/status"
+	containerSDK "github.com/TrueCloudLab/frostfs-sdk-go/container"
+	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/object"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	usertest "github.com/TrueCloudLab/frostfs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type testUsage struct {
+	containers map[cid.ID]uint64
+	owners     map[string]uint64
+}
+
+func (u testUsage) ContainerUsage(id cid.ID) (uint64, error) {
+	return u.containers[id], nil
+}
+
+func (u testUsage) OwnerUsage(id user.ID) (uint64, error) {
+	return u.owners[id.EncodeToString()], nil
+}
+
+type testCounts struct {
+	containers map[cid.ID]uint64
+	owners     map[string]uint64
+}
+
+func (c testCounts) ContainerObjects(id cid.ID) (uint64, error) {
+	return c.containers[id], nil
+}
+
+func (c testCounts) OwnerObjects(id user.ID) (uint64, error) {
+	return c.owners[id.EncodeToString()], nil
+}
+
+type testOwnerQuotas map[string]Quota
+
+func (q testOwnerQuotas) OwnerQuota(id user.ID) Quota {
+	return q[id.EncodeToString()]
+}
+
+func TestStreamer_checkQuotas(t *testing.T) {
+	idCnr := cidtest.ID()
+	owner := *usertest.ID()
+
+	newPrmWithAttributes := func(size uint64, attrs ...string) *PutInitPrm {
+		var cnr containerSDK.Container
+		cnr.Init()
+		cnr.SetOwner(owner)
+		for i := 0; i < len(attrs); i += 2 {
+			if attrs[i+1] != "" {
+				cnr.SetAttribute(attrs[i], attrs[i+1])
+			}
+		}
+
+		hdr := object.New()
+		hdr.SetPayloadSize(size)
+
+		return &PutInitPrm{hdr: hdr, cnr: cnr}
+	}
+
+	newPrm := func(soft, hard string, size uint64) *PutInitPrm {
+		return newPrmWithAttributes(size, AttributeQuotaSoftSize, soft, AttributeQuotaHardSize, hard)
+	}
+
+	newStreamer := func(ownerQuota Quota) *Streamer {
+		return &Streamer{cfg: &cfg{
+			log: &logger.Logger{Logger: zaptest.NewLogger(t)},
+			usageSrc: testUsage{
+				containers: map[cid.ID]uint64{idCnr: 100},
+				owners:     map[string]uint64{owner.EncodeToString(): 1000},
+			},
+			countSrc: testCounts{
+				containers: map[cid.ID]uint64{idCnr: 10},
+				owners:     map[string]uint64{owner.EncodeToString(): 20},
+			},
+			ownerQuotaSrc: testOwnerQuotas{owner.EncodeToString(): ownerQuota},
+		}}
+	}
+
+	requireQuotaExceeded := func(t *testing.T, err error) {
+		var st QuotaExceeded
+		require.True(t, errors.As(err, &st), err)
+		require.Contains(t, st.Reason(), "quota exceeded")
+	}
+
+	t.Run("container", func(t *testing.T) {
+		s := newStreamer(Quota{})
+
+		require.NoError(t, s.checkQuotas(newPrm("", "", 1<<30), idCnr))
+		require.NoError(t, s.checkQuotas(newPrm("50", "", 1), idCnr))
+		require.NoError(t, s.checkQuotas(newPrm("", "150", 50), idCnr))
+		requireQuotaExceeded(t, s.checkQuotas(newPrm("", "150", 51), idCnr))
+		requireQuotaExceeded(t, s.checkQuotas(newPrm("", "100", 1), idCnr))
+
+		// invalid attribute is ignored
+		require.NoError(t, s.checkQuotas(newPrm("", "-1", 1), idCnr))
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		require.NoError(t, newStreamer(Quota{Soft: 500}).checkQuotas(newPrm("", "", 10), idCnr))
+		require.NoError(t, newStreamer(Quota{Hard: 1010}).checkQuotas(newPrm("", "", 10), idCnr))
+		requireQuotaExceeded(t, newStreamer(Quota{Hard: 1010}).checkQuotas(newPrm("", "", 11), idCnr))
+
+		// container quota is checked first
+		err := newStreamer(Quota{Hard: 1}).checkQuotas(newPrm("", "100", 1), idCnr)
+		requireQuotaExceeded(t, err)
+		require.Contains(t, err.(QuotaExceeded).Reason(), "container")
+	})
+
+	t.Run("streamed payload", func(t *testing.T) {
+		// payload size is unknown in the header
+		s := newStreamer(Quota{Hard: 1100})
+		require.NoError(t, s.checkQuotas(newPrm("", "150", 0), idCnr))
+
+		require.NoError(t, s.checkPayloadQuotas(30))
+		require.NoError(t, s.checkPayloadQuotas(20))
+		err := s.checkPayloadQuotas(1)
+		requireQuotaExceeded(t, err)
+		require.Contains(t, err.(QuotaExceeded).Reason(), "container storage")
+
+		s = newStreamer(Quota{Hard: 1040})
+		require.NoError(t, s.checkQuotas(newPrm("", "", 0), idCnr))
+		require.NoError(t, s.checkPayloadQuotas(40))
+		err = s.checkPayloadQuotas(1)
+		requireQuotaExceeded(t, err)
+		require.Contains(t, err.(QuotaExceeded).Reason(), "owner storage")
+
+		// no hard limits
+		s = newStreamer(Quota{Soft: 1})
+		require.NoError(t, s.checkQuotas(newPrm("1", "", 0), idCnr))
+		require.NoError(t, s.checkPayloadQuotas(1<<30))
+	})
+
+	t.Run("objects", func(t *testing.T) {
+		newObjectsPrm := func(soft, hard string) *PutInitPrm {
+			return newPrmWithAttributes(1, AttributeQuotaSoftObjects, soft, AttributeQuotaHardObjects, hard)
+		}
+
+		s := newStreamer(Quota{})
+		require.NoError(t, s.checkQuotas(newObjectsPrm("5", ""), idCnr))
+		require.NoError(t, s.checkQuotas(newObjectsPrm("", "11"), idCnr))
+		requireQuotaExceeded(t, s.checkQuotas(newObjectsPrm("", "10"), idCnr))
+
+		require.NoError(t, newStreamer(Quota{HardObjects: 21}).checkQuotas(newObjectsPrm("", ""), idCnr))
+		err := newStreamer(Quota{HardObjects: 20}).checkQuotas(newObjectsPrm("", ""), idCnr)
+		requireQuotaExceeded(t, err)
+		require.Contains(t, err.(QuotaExceeded).Reason(), "owner object")
+	})
+
+	t.Run("local only", func(t *testing.T) {
+		prm := newPrm("", "1", 1).WithCommonPrm(new(util.CommonPrm).WithLocalOnly(true))
+		require.NoError(t, newStreamer(Quota{Hard: 1}).checkQuotas(prm, idCnr))
+
+		// object numbers are local, so they are checked on each container node
+		prm = newPrmWithAttributes(1, AttributeQuotaHardObjects, "10").
+			WithCommonPrm(new(util.CommonPrm).WithLocalOnly(true))
+		requireQuotaExceeded(t, newStreamer(Quota{}).checkQuotas(prm, idCnr))
+	})
+
+	t.Run("non-regular", func(t *testing.T) {
+		prm := newPrm("", "1", 1)
+		prm.hdr.SetType(object.TypeTombstone)
+		require.NoError(t, newStreamer(Quota{Hard: 1}).checkQuotas(prm, idCnr))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		s := &Streamer{cfg: &cfg{}}
+		require.NoError(t, s.checkQuotas(newPrm("", "1", 1), idCnr))
+	})
+}
+
+func TestContainerQuota(t *testing.T) {
+	var cnr containerSDK.Container
+	cnr.Init()
+
+	q, err := ContainerQuota(cnr)
+	require.NoError(t, err)
+	require.Equal(t, Quota{}, q)
+
+	cnr.SetAttribute(AttributeQuotaSoftSize, strconv.Itoa(1<<20))
+	cnr.SetAttribute(AttributeQuotaHardSize, strconv.Itoa(1<<30))
+
+	q, err = ContainerQuota(cnr)
+	require.NoError(t, err)
+	require.Equal(t, Quota{Soft: 1 << 20, Hard: 1 << 30}, q)
+
+	cnr.SetAttribute(AttributeQuotaSoftObjects, "100")
+	cnr.SetAttribute(AttributeQuotaHardObjects, "1000")
+
+	q, err = ContainerQuota(cnr)
+	require.NoError(t, err)
+	require.Equal(t, Quota{Soft: 1 << 20, Hard: 1 << 30, SoftObjects: 100, HardObjects: 1000}, q)
+
+	cnr.SetAttribute(AttributeQuotaHardSize, "1GB")
+
+	_, err = ContainerQuota(cnr)
+	require.Error(t, err)
+}
+
+func TestQuotaExceeded(t *testing.T) {
+	err := fmt.Errorf("could not init object put stream: %w", quotaExceededError("container storage", 1, 2))
+
+	st := apistatus.ToStatusV2(apistatus.ErrToStatus(errors.Unwrap(err)))
+	require.EqualValues(t, 2054, st.Code())
+	require.Equal(t, "container storage quota exceeded: used 1, limit 2", st.Message())
+}
@@ -54,6 +54,12 @@ type cfg struct {
 
 	clientConstructor ClientConstructor
 
+	usageSrc UsageSource
+
+	ownerQuotaSrc OwnerQuotaSource
+
+	countSrc ObjectCountSource
+
 	log *logger.Logger
 }
 
@@ -141,6 +147,32 @@ func WithClientConstructor(v ClientConstructor) Option {
 	}
 }
 
+// WithUsageSource returns option to check storage quotas of the containers
+// against the usage provided by v. Quotas are not checked if v is nil.
+func WithUsageSource(v UsageSource) Option {
+	return func(c *cfg) {
+		c.usageSrc = v
+	}
+}
+
+// WithOwnerQuotaSource returns option to check storage quotas of the
+// container owners. Has effect only with WithUsageSource or
+// WithObjectCountSource.
+func WithOwnerQuotaSource(v OwnerQuotaSource) Option {
+	return func(c *cfg) {
+		c.ownerQuotaSrc = v
+	}
+}
+
+// WithObjectCountSource returns option to check object quotas of the
+// containers against the number of objects provided by v. Object quotas
+// are not checked if v is nil.
+func WithObjectCountSource(v ObjectCountSource) Option {
+	return func(c *cfg) {
+		c.countSrc = v
+	}
+}
+
 func WithLogger(l *logger.Logger) Option {
 	return func(c *cfg) {
 		c.log = l
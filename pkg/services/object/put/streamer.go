@@ -25,6 +25,9 @@ type Streamer struct {
 	relay func(client.NodeInfo, client.MultiAddressClient) error
 
 	maxPayloadSz uint64 // network config
+
+	payloadQuotas []payloadQuota
+	written       uint64 // payload bytes sent to the target
 }
 
 var errNotInit = errors.New("stream not initialized")
@@ -61,6 +64,11 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 		return fmt.Errorf("(%T) could not prepare put parameters: %w", p, err)
 	}
 
+	idCnr, _ := prm.hdr.ContainerID() // checked in preparePrm
+	if err := p.checkQuotas(prm, idCnr); err != nil {
+		return err
+	}
+
 	p.maxPayloadSz = p.maxSizeSrc.MaxObjectSize()
 	if p.maxPayloadSz == 0 {
 		return fmt.Errorf("(%T) could not obtain max object size parameter", p)
@@ -249,6 +257,10 @@ func (p *Streamer) SendChunk(prm *PutChunkPrm) error {
 		return errNotInit
 	}
 
+	if err := p.checkPayloadQuotas(len(prm.chunk)); err != nil {
+		return err
+	}
+
 	if _, err := p.target.Write(prm.chunk); err != nil {
 		return fmt.Errorf("(%T) could not write payload chunk to target: %w", p, err)
 	}
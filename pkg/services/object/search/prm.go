@@ -18,6 +18,9 @@ type Prm struct {
 
 	filters object.SearchFilters
 
+	cursor *oid.ID
+	limit  uint32
+
 	forwarder RequestForwarder
 }
 
@@ -56,3 +59,17 @@ func (p *Prm) WithContainerID(id cid.ID) {
 func (p *Prm) WithSearchFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
+
+// WithCursor sets the identifier of the last object from the previous page.
+// Only the objects with greater identifiers are returned.
+func (p *Prm) WithCursor(cursor *oid.ID) {
+	p.cursor = cursor
+}
+
+// WithLimit sets the maximum number of the returned objects. Zero means no limit.
+//
+// If the limit or the cursor is set, the objects are written
+// at once in the ascending order of their identifiers.
+func (p *Prm) WithLimit(limit uint32) {
+	p.limit = limit
+}
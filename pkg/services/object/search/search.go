@@ -8,6 +8,12 @@ import (
 
 // Search serves a request to select the objects.
 func (s *Service) Search(ctx context.Context, prm Prm) error {
+	var page *pageWriter
+	if prm.cursor != nil || prm.limit != 0 {
+		page = newPageWriter(prm.writer, prm.cursor, prm.limit)
+		prm.writer = page
+	}
+
 	exec := &execCtx{
 		svc: s,
 		ctx: ctx,
@@ -20,6 +26,10 @@ func (s *Service) Search(ctx context.Context, prm Prm) error {
 
 	exec.execute()
 
+	if exec.statusError.err == nil && page != nil {
+		return page.flush()
+	}
+
 	return exec.statusError.err
 }
 
@@ -1,11 +1,13 @@
 package searchsvc
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -117,7 +119,8 @@ func (c *testStorage) searchObjects(exec *execCtx, _ clientcore.NodeInfo) ([]oid
 		return nil, nil
 	}
 
-	return v.ids, v.err
+	// the list is modified by the writer, copy it like a real client does
+	return append([]oid.ID(nil), v.ids...), v.err
 }
 
 func (c *testStorage) addResult(addr cid.ID, ids []oid.ID, err error) {
@@ -314,6 +317,64 @@ func TestGetRemoteSmall(t *testing.T) {
 			require.Contains(t, w.ids, id)
 		}
 	})
+
+	t.Run("paginated", func(t *testing.T) {
+		var addr oid.Address
+		addr.SetContainer(id)
+
+		ns, as := testNodeMatrix(t, placementDim)
+
+		builder := &testPlacementBuilder{
+			vectors: map[string][][]netmap.NodeInfo{
+				addr.EncodeToString(): ns,
+			},
+		}
+
+		// both nodes store some of the objects
+		ids := generateIDs(20)
+
+		c1 := newTestStorage()
+		c1.addResult(id, ids[:15], nil)
+
+		c2 := newTestStorage()
+		c2.addResult(id, ids[5:], nil)
+
+		svc := newSvc(builder, &testClientCache{
+			clients: map[string]*testStorage{
+				as[0][0]: c1,
+				as[0][1]: c2,
+			},
+		})
+
+		expected := append([]oid.ID(nil), ids...)
+		sort.Slice(expected, func(i, j int) bool {
+			return bytes.Compare(expected[i][:], expected[j][:]) < 0
+		})
+
+		const limit = 7
+
+		var got []oid.ID
+		var cursor *oid.ID
+		for {
+			w := new(simpleIDWriter)
+
+			p := newPrm(id, w)
+			p.WithCursor(cursor)
+			p.WithLimit(limit)
+
+			err := svc.Search(ctx, p)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(w.ids), limit)
+
+			got = append(got, w.ids...)
+			if len(w.ids) < limit {
+				break
+			}
+			cursor = &w.ids[len(w.ids)-1]
+		}
+
+		require.Equal(t, expected, got)
+	})
 }
 
 func TestGetFromPastEpoch(t *testing.T) {
@@ -425,3 +486,26 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	assertContains(ids11, ids12, ids21, ids22)
 }
+
+func TestPageWriter(t *testing.T) {
+	ids := generateIDs(30)
+	sorted := append([]oid.ID(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i][:], sorted[j][:]) < 0
+	})
+
+	const limit = 5
+	cursor := sorted[10]
+
+	w := new(simpleIDWriter)
+	page := newPageWriter(w, &cursor, limit)
+
+	// remote nodes can return the same objects and ignore the cursor
+	for _, chunk := range [][]oid.ID{ids[:10], ids[5:20], ids[15:]} {
+		require.NoError(t, page.WriteIDs(chunk))
+		require.LessOrEqual(t, len(page.ids), limit)
+	}
+
+	require.NoError(t, page.flush())
+	require.Equal(t, sorted[11:11+limit], w.ids)
+}
@@ -1,6 +1,8 @@
 package searchsvc
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
@@ -21,6 +23,19 @@ type uniqueIDWriter struct {
 	writer IDListWriter
 }
 
+// pageWriter collects the identifiers to write a single sorted page of them.
+// Only the first identifiers after the cursor which fit into the page are kept,
+// so the memory does not depend on the number of the received identifiers.
+// It must not be used concurrently.
+type pageWriter struct {
+	cursor *oid.ID
+	limit  uint32
+
+	ids []oid.ID
+
+	writer IDListWriter
+}
+
 type clientConstructorWrapper struct {
 	constructor ClientConstructor
 }
@@ -66,6 +81,53 @@ func (w *uniqueIDWriter) WriteIDs(list []oid.ID) error {
 	return w.writer.WriteIDs(list)
 }
 
+func newPageWriter(w IDListWriter, cursor *oid.ID, limit uint32) *pageWriter {
+	return &pageWriter{
+		cursor: cursor,
+		limit:  limit,
+		writer: w,
+	}
+}
+
+func (w *pageWriter) WriteIDs(list []oid.ID) error {
+	for i := range list {
+		// remote nodes may ignore the cursor and the limit
+		if w.cursor != nil && bytes.Compare(list[i][:], w.cursor[:]) <= 0 {
+			continue
+		}
+
+		if w.limit == 0 {
+			// the whole tail is returned, it's sorted once on flush
+			w.ids = append(w.ids, list[i])
+			continue
+		}
+
+		ind := sort.Search(len(w.ids), func(j int) bool {
+			return bytes.Compare(w.ids[j][:], list[i][:]) >= 0
+		})
+		if ind == int(w.limit) || ind < len(w.ids) && w.ids[ind] == list[i] {
+			continue
+		}
+
+		if len(w.ids) < int(w.limit) {
+			w.ids = append(w.ids, oid.ID{})
+		}
+		copy(w.ids[ind+1:], w.ids[ind:])
+		w.ids[ind] = list[i]
+	}
+	return nil
+}
+
+// flush writes the first identifiers after the cursor in the ascending order.
+func (w *pageWriter) flush() error {
+	if w.limit == 0 {
+		sort.Slice(w.ids, func(i, j int) bool {
+			return bytes.Compare(w.ids[i][:], w.ids[j][:]) < 0
+		})
+	}
+	return w.writer.WriteIDs(w.ids)
+}
+
 func (c *clientConstructorWrapper) get(info client.NodeInfo) (searchClient, error) {
 	clt, err := c.constructor.Get(info)
 	if err != nil {
@@ -108,6 +170,8 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 	prm.SetNetmapEpoch(exec.curProcEpoch)
 	prm.SetContainerID(exec.containerID())
 	prm.SetFilters(exec.searchFilters())
+	prm.SetCursor(exec.prm.cursor)
+	prm.SetLimit(exec.prm.limit)
 
 	res, err := internalclient.SearchObjects(prm)
 	if err != nil {
@@ -121,6 +185,8 @@ func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
 	var selectPrm engine.SelectPrm
 	selectPrm.WithFilters(exec.searchFilters())
 	selectPrm.WithContainerID(exec.containerID())
+	selectPrm.WithCursor(exec.prm.cursor)
+	selectPrm.WithLimit(exec.prm.limit)
 
 	r, err := e.storage.Select(selectPrm)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"sync"
 
 	objectV2 "github.com/TrueCloudLab/frostfs-api-go/v2/object"
@@ -12,13 +13,13 @@ import (
 	"github.com/TrueCloudLab/frostfs-api-go/v2/session"
 	"github.com/TrueCloudLab/frostfs-api-go/v2/signature"
 	"github.com/TrueCloudLab/frostfs-node/pkg/core/client"
+	objectcore "github.com/TrueCloudLab/frostfs-node/pkg/core/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/network"
 	objectSvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/internal"
 	searchsvc "github.com/TrueCloudLab/frostfs-node/pkg/services/object/search"
 	"github.com/TrueCloudLab/frostfs-node/pkg/services/object/util"
 	cid "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
-	"github.com/TrueCloudLab/frostfs-sdk-go/object"
 	oid "github.com/TrueCloudLab/frostfs-sdk-go/object/id"
 )
 
@@ -134,11 +135,40 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 	}
 
 	p.WithContainerID(id)
-	p.WithSearchFilters(object.NewSearchFiltersFromV2(body.GetFilters()))
+	p.WithSearchFilters(objectcore.SearchFiltersFromV2(body.GetFilters()))
+
+	if err := readPagination(p, commonPrm.XHeaders()); err != nil {
+		return nil, err
+	}
 
 	return p, nil
 }
 
+// readPagination reads the search cursor and limit from the X-headers.
+// The X-headers remain in the list to be forwarded to the other nodes.
+func readPagination(p *searchsvc.Prm, xhdrs []string) error {
+	for i := 0; i+1 < len(xhdrs); i += 2 {
+		switch xhdrs[i] {
+		case objectcore.XHeaderSearchCursor:
+			var cursor oid.ID
+			if err := cursor.DecodeString(xhdrs[i+1]); err != nil {
+				return fmt.Errorf("invalid search cursor: %w", err)
+			}
+
+			p.WithCursor(&cursor)
+		case objectcore.XHeaderSearchLimit:
+			limit, err := strconv.ParseUint(xhdrs[i+1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid search limit: %w", err)
+			}
+
+			p.WithLimit(uint32(limit))
+		}
+	}
+
+	return nil
+}
+
 func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressClient, []byte) ([]oid.ID, error)) searchsvc.RequestForwarder {
 	return func(info client.NodeInfo, c client.MultiAddressClient) ([]oid.ID, error) {
 		var (
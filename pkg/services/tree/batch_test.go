@@ -0,0 +1,134 @@
+package tree
+
+import (
+	"testing"
+
+	containercore "github.com/TrueCloudLab/frostfs-node/pkg/core/container"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-sdk-go/container"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	netmapSDK "github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoToBatch(t *testing.T) {
+	meta := []*KeyValue{{Key: pilorama.AttributeFilename, Value: []byte("name")}}
+
+	ops, err := protoToBatch([]*BatchRequest_Operation{
+		{Type: BatchRequest_Operation_Add, ParentId: 1, NodeId: 2, Meta: meta},
+		{Type: BatchRequest_Operation_AddByPath, Path: []string{"a", "b"}, Meta: meta},
+		{Type: BatchRequest_Operation_Remove, ParentId: 1, NodeId: 2},
+		{Type: BatchRequest_Operation_Move, ParentId: 1, NodeId: 2, Meta: meta},
+	})
+	require.NoError(t, err)
+
+	items := protoToMeta(meta)
+	require.Equal(t, []pilorama.BatchOperation{
+		{Move: pilorama.Move{Parent: 1, Meta: pilorama.Meta{Items: items}}},
+		{Attr: pilorama.AttributeFilename, Path: []string{"a", "b"}, Move: pilorama.Move{Meta: pilorama.Meta{Items: items}}},
+		{Move: pilorama.Move{Parent: pilorama.TrashID, Child: 2}},
+		{Move: pilorama.Move{Parent: 1, Child: 2, Meta: pilorama.Meta{Items: items}}},
+	}, ops)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := protoToBatch(nil)
+		require.Error(t, err)
+
+		_, err = protoToBatch([]*BatchRequest_Operation{{Type: BatchRequest_Operation_Remove}})
+		require.Error(t, err)
+
+		_, err = protoToBatch([]*BatchRequest_Operation{{Type: BatchRequest_Operation_Move, ParentId: 1}})
+		require.Error(t, err)
+
+		_, err = protoToBatch([]*BatchRequest_Operation{{Type: 42}})
+		require.Error(t, err)
+	})
+}
+
+func TestNewApplyRequest(t *testing.T) {
+	ops := []*pilorama.Move{
+		{Parent: 1, Child: 2, Meta: pilorama.Meta{Time: 3}},
+		{Parent: 4, Child: 5, Meta: pilorama.Meta{Time: 6}},
+	}
+
+	req := newApplyRequest(cidtest.ID(), "tree", ops[:1])
+	require.Equal(t, uint64(2), req.GetBody().GetOperation().GetChildId())
+	require.Empty(t, req.GetBody().GetOperations())
+
+	req = newApplyRequest(cidtest.ID(), "tree", ops)
+	require.Equal(t, uint64(2), req.GetBody().GetOperation().GetChildId())
+	require.Equal(t, 1, len(req.GetBody().GetOperations()))
+	require.Equal(t, uint64(5), req.GetBody().GetOperations()[0].GetChildId())
+
+	var meta pilorama.Meta
+	require.NoError(t, meta.FromBytes(req.GetBody().GetOperations()[0].GetMeta()))
+	require.Equal(t, uint64(6), meta.Time)
+}
+
+func TestReplicateBatch(t *testing.T) {
+	localKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+	peerKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var nodes [2]netmapSDK.NodeInfo
+	nodes[0].SetPublicKey(localKey.PublicKey().Bytes())
+	nodes[1].SetPublicKey(peerKey.PublicKey().Bytes())
+
+	var nm netmapSDK.NetMap
+	nm.SetNodes(nodes[:])
+
+	var r netmapSDK.ReplicaDescriptor
+	r.SetNumberOfObjects(2)
+
+	var pp netmapSDK.PlacementPolicy
+	pp.AddReplicas(r)
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(pp)
+
+	cid := cidtest.ID()
+	s := New(
+		WithPrivateKey(&localKey.PrivateKey),
+		WithNetmapSource(testNetmapSource{nm: &nm}),
+		WithContainerSource(dummyContainerSource{cid.String(): &containercore.Container{Value: cnr}}),
+	)
+
+	ops := []*pilorama.Move{
+		{Parent: 1, Child: 2, Meta: pilorama.Meta{Time: 3}},
+		{Parent: 4, Child: 5, Meta: pilorama.Meta{Time: 6}},
+	}
+
+	replicate := func(ops []*pilorama.Move) replicationTask {
+		require.NoError(t, s.replicate(movePair{cid: cid, treeID: "tree", ops: ops}))
+		require.Equal(t, 1, len(s.replicationTasks))
+
+		task := <-s.replicationTasks
+		require.Equal(t, peerKey.PublicKey().Bytes(), task.n.PublicKey())
+		for i := range task.reqs {
+			require.NoError(t, verifyMessage(task.reqs[i]))
+		}
+		return task
+	}
+
+	// The peer is not known to support batches, so the operations are sent one by one.
+	task := replicate(ops)
+	require.Equal(t, 2, len(task.reqs))
+	for i := range task.reqs {
+		require.Equal(t, ops[i].Child, task.reqs[i].GetBody().GetOperation().GetChildId())
+		require.Empty(t, task.reqs[i].GetBody().GetOperations())
+	}
+
+	require.Equal(t, 1, len(replicate(ops[:1]).reqs))
+
+	s.setBatchSupported(peerKey.PublicKey().Bytes(), true)
+
+	task = replicate(ops)
+	require.Equal(t, 1, len(task.reqs))
+	require.Equal(t, uint64(2), task.reqs[0].GetBody().GetOperation().GetChildId())
+	require.Equal(t, 1, len(task.reqs[0].GetBody().GetOperations()))
+
+	s.setBatchSupported(peerKey.PublicKey().Bytes(), false)
+	require.Equal(t, 2, len(replicate(ops).reqs))
+}
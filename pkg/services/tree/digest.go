@@ -0,0 +1,275 @@
+package tree
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+)
+
+const (
+	// digestFanout is the amount of subranges a mismatched log range is split into.
+	digestFanout = 16
+	// digestLeafSize is the maximum amount of operations in a mismatched
+	// log range which is fetched as a whole instead of being split further.
+	digestLeafSize = 64
+	// maxDigestRanges is the maximum amount of ranges in a single GetLogDigest request.
+	maxDigestRanges = 256
+)
+
+// logRange represents the operations with timestamps in [start, end).
+type logRange struct {
+	start, end uint64
+}
+
+// GetLogDigest returns digests of the requested log ranges.
+func (s *Service) GetLogDigest(ctx context.Context, req *GetLogDigestRequest) (*GetLogDigestResponse, error) {
+	b := req.GetBody()
+
+	var cid cidSDK.ID
+	if err := cid.Decode(b.GetContainerId()); err != nil {
+		return nil, err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return nil, err
+	}
+	if pos < 0 {
+		var resp *GetLogDigestResponse
+		var outErr error
+		err = s.forEachNode(ctx, ns, func(c TreeServiceClient) bool {
+			resp, outErr = c.GetLogDigest(ctx, req)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp, outErr
+	}
+
+	ranges := b.GetRanges()
+	if len(ranges) > maxDigestRanges {
+		return nil, fmt.Errorf("too many ranges: %d > %d", len(ranges), maxDigestRanges)
+	}
+
+	digests := make([]*GetLogDigestResponse_Digest, len(ranges))
+	for i := range ranges {
+		d, err := s.forest.TreeLogDigest(cid, b.GetTreeId(), ranges[i].GetStart(), ranges[i].GetEnd())
+		if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+			return nil, err
+		}
+
+		digests[i] = &GetLogDigestResponse_Digest{
+			Count: d.Count,
+			Size:  d.Size,
+			Last:  d.Last,
+		}
+		if d.Count != 0 {
+			digests[i].Hash = d.Hash[:]
+		}
+	}
+
+	return &GetLogDigestResponse{
+		Body: &GetLogDigestResponse_Body{
+			Digests: digests,
+		},
+	}, nil
+}
+
+// synchronizeByDigest fetches only the operations missing locally by comparing
+// digests of the log ranges with the remote node, starting from the specified height.
+// Mismatched ranges are split until they are small enough to be fetched as a whole.
+// Returns the height next to the last operation of the remote node.
+func (s *Service) synchronizeByDigest(ctx context.Context, d pilorama.CIDDescriptor, treeID string,
+	height uint64, treeClient TreeServiceClient) (uint64, error) {
+	ranges := []logRange{{start: height, end: math.MaxUint64}}
+	remote, err := s.getLogDigests(ctx, d.CID, treeID, ranges, treeClient)
+	if err != nil {
+		return height, err
+	}
+	if remote[0].Count == 0 {
+		return height, nil
+	}
+
+	total := remote[0].Size
+	end := remote[0].Last + 1
+	ranges[0].end = end
+
+	var fetched uint64
+	defer func() {
+		s.metrics.AddTreeSyncFetchedBytes(fetched)
+		if fetched < total {
+			s.metrics.AddTreeSyncSavedBytes(total - fetched)
+		}
+	}()
+
+	for len(ranges) != 0 {
+		var next []logRange
+		for i := range ranges {
+			local, err := s.forest.TreeLogDigest(d.CID, treeID, ranges[i].start, ranges[i].end)
+			if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+				return height, err
+			}
+			if remote[i].Count == 0 || local == remote[i] {
+				continue
+			}
+
+			if remote[i].Count <= digestLeafSize || ranges[i].end-ranges[i].start <= digestFanout {
+				n, err := s.fetchOpLog(ctx, d, treeID, ranges[i].start, remote[i].Count, treeClient)
+				fetched += n
+				if err != nil {
+					return height, err
+				}
+				continue
+			}
+
+			next = append(next, splitRange(ranges[i])...)
+		}
+
+		ranges = next
+		if len(ranges) == 0 {
+			break
+		}
+		remote, err = s.getLogDigests(ctx, d.CID, treeID, ranges, treeClient)
+		if err != nil {
+			return height, err
+		}
+	}
+	return end, nil
+}
+
+// splitRange splits r into digestFanout subranges of the same size.
+func splitRange(r logRange) []logRange {
+	step := (r.end - r.start) / digestFanout
+	if (r.end-r.start)%digestFanout != 0 {
+		step++
+	}
+
+	res := make([]logRange, 0, digestFanout)
+	for start := r.start; ; start += step {
+		if r.end-start <= step {
+			return append(res, logRange{start: start, end: r.end})
+		}
+		res = append(res, logRange{start: start, end: start + step})
+	}
+}
+
+// getLogDigests requests digests of the log ranges from the remote node.
+func (s *Service) getLogDigests(ctx context.Context, cid cidSDK.ID, treeID string,
+	ranges []logRange, treeClient TreeServiceClient) ([]pilorama.LogDigest, error) {
+	rawCID := make([]byte, sha256.Size)
+	cid.Encode(rawCID)
+
+	res := make([]pilorama.LogDigest, 0, len(ranges))
+	for len(ranges) != 0 {
+		n := len(ranges)
+		if n > maxDigestRanges {
+			n = maxDigestRanges
+		}
+
+		req := &GetLogDigestRequest{
+			Body: &GetLogDigestRequest_Body{
+				ContainerId: rawCID,
+				TreeId:      treeID,
+				Ranges:      make([]*GetLogDigestRequest_Range, n),
+			},
+		}
+		for i := range ranges[:n] {
+			req.Body.Ranges[i] = &GetLogDigestRequest_Range{
+				Start: ranges[i].start,
+				End:   ranges[i].end,
+			}
+		}
+		if err := SignMessage(req, s.key); err != nil {
+			return nil, err
+		}
+
+		resp, err := treeClient.GetLogDigest(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		digests := resp.GetBody().GetDigests()
+		if len(digests) != n {
+			return nil, fmt.Errorf("invalid amount of digests: expected %d, got %d", n, len(digests))
+		}
+		for i := range digests {
+			d := pilorama.LogDigest{
+				Count: digests[i].GetCount(),
+				Size:  digests[i].GetSize(),
+				Last:  digests[i].GetLast(),
+			}
+			if d.Count != 0 && len(digests[i].GetHash()) != sha256.Size {
+				return nil, fmt.Errorf("invalid digest hash length: %d", len(digests[i].GetHash()))
+			}
+			copy(d.Hash[:], digests[i].GetHash())
+			res = append(res, d)
+		}
+
+		ranges = ranges[n:]
+	}
+	return res, nil
+}
+
+// fetchOpLog applies count operations from the remote log starting from height.
+// Returns the size of the fetched operations.
+func (s *Service) fetchOpLog(ctx context.Context, d pilorama.CIDDescriptor, treeID string,
+	height, count uint64, treeClient TreeServiceClient) (uint64, error) {
+	rawCID := make([]byte, sha256.Size)
+	d.CID.Encode(rawCID)
+
+	req := &GetOpLogRequest{
+		Body: &GetOpLogRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      treeID,
+			Height:      height,
+			Count:       count,
+		},
+	}
+	if err := SignMessage(req, s.key); err != nil {
+		return 0, err
+	}
+
+	c, err := treeClient.GetOpLog(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("can't initialize client: %w", err)
+	}
+
+	var size uint64
+	res, err := c.Recv()
+	for ; err == nil; res, err = c.Recv() {
+		lm := res.GetBody().GetOperation()
+		size += uint64(16 + len(lm.GetMeta()))
+
+		if _, err := s.applyLogMove(d, treeID, lm); err != nil {
+			return size, err
+		}
+	}
+	if !errors.Is(err, io.EOF) {
+		return size, err
+	}
+	return size, nil
+}
+
+// applyLogMove applies the operation fetched from the remote log.
+func (s *Service) applyLogMove(d pilorama.CIDDescriptor, treeID string, lm *LogMove) (*pilorama.Move, error) {
+	m := &pilorama.Move{
+		Parent: lm.GetParentId(),
+		Child:  lm.GetChildId(),
+	}
+	if err := m.Meta.FromBytes(lm.GetMeta()); err != nil {
+		return nil, err
+	}
+	newOps := s.newOperations(d.CID, treeID, []*pilorama.Move{m})
+	if err := s.forest.TreeApply(d, treeID, m, true); err != nil {
+		return nil, err
+	}
+	s.subs.notify(d.CID, treeID, newOps...)
+	return m, nil
+}
@@ -0,0 +1,35 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitRange(t *testing.T) {
+	t.Run("exact", func(t *testing.T) {
+		rs := splitRange(logRange{start: 10, end: 10 + 4*digestFanout})
+		require.Len(t, rs, digestFanout)
+		for i := range rs {
+			require.Equal(t, logRange{start: 10 + uint64(i)*4, end: 14 + uint64(i)*4}, rs[i])
+		}
+	})
+	t.Run("remainder", func(t *testing.T) {
+		r := logRange{start: 5, end: 5 + 3*digestFanout + 1}
+		rs := splitRange(r)
+		require.LessOrEqual(t, len(rs), digestFanout)
+		require.Equal(t, r.start, rs[0].start)
+		require.Equal(t, r.end, rs[len(rs)-1].end)
+		for i := 1; i < len(rs); i++ {
+			require.Equal(t, rs[i-1].end, rs[i].start)
+			require.Less(t, rs[i].start, rs[i].end)
+		}
+	})
+	t.Run("huge", func(t *testing.T) {
+		r := logRange{start: 1, end: 1<<64 - 1}
+		rs := splitRange(r)
+		require.Len(t, rs, digestFanout)
+		require.Equal(t, r.start, rs[0].start)
+		require.Equal(t, r.end, rs[len(rs)-1].end)
+	})
+}
@@ -2,6 +2,7 @@ package tree
 
 import (
 	"errors"
+	"strconv"
 	"testing"
 
 	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
@@ -118,6 +119,91 @@ func TestGetSubTree(t *testing.T) {
 	})
 }
 
+func TestGetSubTreeOrderAsc(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+	p := pilorama.NewMemoryForest()
+
+	tree := []struct {
+		name   string
+		parent int
+		id     uint64
+	}{
+		{name: "dir2", parent: -1},
+		{name: "dir1", parent: -1},
+		{name: "dir3", parent: -1},
+		{name: "sub2", parent: 1},
+		{name: "sub1", parent: 1},
+		{name: "sub1", parent: 2},
+	}
+
+	for i := range tree {
+		var parent pilorama.Node
+		if tree[i].parent >= 0 {
+			parent = tree[tree[i].parent].id
+		}
+		meta := []pilorama.KeyValue{
+			{Key: pilorama.AttributeFilename, Value: []byte(tree[i].name)},
+			{Key: "Parity", Value: []byte(strconv.Itoa(i % 2))}}
+
+		m, err := p.TreeMove(d, treeID, &pilorama.Move{
+			Parent: parent,
+			Child:  pilorama.RootID,
+			Meta:   pilorama.Meta{Items: meta},
+		})
+		require.NoError(t, err)
+
+		tree[i].id = m.Child
+	}
+
+	testGetSubTree := func(t *testing.T, b *GetSubTreeRequest_Body) []uint64 {
+		b.TreeId = treeID
+		b.OrderBy = &GetSubTreeRequest_Order{Direction: GetSubTreeRequest_Order_Asc}
+
+		acc := subTreeAcc{errIndex: -1}
+		require.NoError(t, getSubTree(&acc, d.CID, b, p))
+
+		ids := make([]uint64, len(acc.seen))
+		for i := range acc.seen {
+			ids[i] = acc.seen[i].Body.NodeId
+		}
+		return ids
+	}
+
+	t.Run("full tree", func(t *testing.T) {
+		actual := testGetSubTree(t, &GetSubTreeRequest_Body{})
+		require.Equal(t, []uint64{0,
+			tree[1].id, tree[4].id, tree[3].id, // dir1, dir1/sub1, dir1/sub2
+			tree[0].id,             // dir2
+			tree[2].id, tree[5].id, // dir3, dir3/sub1
+		}, actual)
+	})
+	t.Run("start after and limit", func(t *testing.T) {
+		actual := testGetSubTree(t, &GetSubTreeRequest_Body{Depth: 2, Limit: 1})
+		require.Equal(t, []uint64{0, tree[1].id}, actual)
+
+		actual = testGetSubTree(t, &GetSubTreeRequest_Body{Depth: 2, StartAfter: "dir1", Limit: 1})
+		require.Equal(t, []uint64{0, tree[0].id}, actual)
+
+		actual = testGetSubTree(t, &GetSubTreeRequest_Body{Depth: 2, StartAfter: "dir2", Limit: 1})
+		require.Equal(t, []uint64{0, tree[2].id}, actual)
+
+		actual = testGetSubTree(t, &GetSubTreeRequest_Body{Depth: 2, StartAfter: "dir3"})
+		require.Equal(t, []uint64{0}, actual)
+	})
+	t.Run("filters", func(t *testing.T) {
+		actual := testGetSubTree(t, &GetSubTreeRequest_Body{
+			Filters: []*KeyValue{{Key: "Parity", Value: []byte("1")}},
+		})
+		require.Equal(t, []uint64{tree[1].id, tree[3].id, tree[5].id}, actual)
+	})
+	t.Run("start after without ordering", func(t *testing.T) {
+		acc := subTreeAcc{errIndex: -1}
+		err := getSubTree(&acc, d.CID, &GetSubTreeRequest_Body{TreeId: treeID, StartAfter: "dir1"}, p)
+		require.Error(t, err)
+	})
+}
+
 var errSubTreeSend = errors.New("test error")
 
 type subTreeAcc struct {
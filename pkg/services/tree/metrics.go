@@ -0,0 +1,16 @@
+package tree
+
+// MetricsRegister is an interface of the tree service metrics.
+type MetricsRegister interface {
+	// AddTreeSyncFetchedBytes adds the size of the operations fetched
+	// during the digest-based synchronization.
+	AddTreeSyncFetchedBytes(n uint64)
+	// AddTreeSyncSavedBytes adds the size of the operations which have not been
+	// fetched during the digest-based synchronization because they are already present.
+	AddTreeSyncSavedBytes(n uint64)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) AddTreeSyncFetchedBytes(uint64) {}
+func (noopMetrics) AddTreeSyncSavedBytes(uint64)   {}
@@ -33,6 +33,8 @@ type cfg struct {
 	replicatorWorkerCount     int
 	replicatorTimeout         time.Duration
 	containerCacheSize        int
+	compactionInterval        time.Duration
+	metrics                   MetricsRegister
 }
 
 // Option represents configuration option for a tree service.
@@ -116,3 +118,18 @@ func WithReplicationTimeout(t time.Duration) Option {
 		}
 	}
 }
+
+// WithCompactionInterval sets the interval between tree log compactions.
+// Compaction is disabled if the interval is not positive.
+func WithCompactionInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		c.compactionInterval = d
+	}
+}
+
+// WithMetrics sets the metrics register of the tree service.
+func WithMetrics(m MetricsRegister) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
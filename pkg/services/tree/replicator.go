@@ -17,18 +17,21 @@ import (
 type movePair struct {
 	cid    cidSDK.ID
 	treeID string
-	op     *pilorama.Move
+	// ops are replicated as a single unit.
+	ops []*pilorama.Move
 }
 
 type replicationTask struct {
-	n   netmapSDK.NodeInfo
-	req *ApplyRequest
+	n netmapSDK.NodeInfo
+	// reqs are sent in order, every request must succeed.
+	reqs []*ApplyRequest
 }
 
 type applyOp struct {
 	treeID string
 	pilorama.CIDDescriptor
-	pilorama.Move
+	// ops must be applied atomically.
+	ops []*pilorama.Move
 }
 
 const (
@@ -43,10 +46,24 @@ func (s *Service) localReplicationWorker() {
 		case <-s.closeCh:
 			return
 		case op := <-s.replicateLocalCh:
-			err := s.forest.TreeApply(op.CIDDescriptor, op.treeID, &op.Move, false)
-			if err != nil {
+			newOps := s.newOperations(op.CID, op.treeID, op.ops)
+
+			var err error
+			if len(op.ops) == 1 {
+				err = s.forest.TreeApply(op.CIDDescriptor, op.treeID, op.ops[0], false)
+			} else {
+				err = s.forest.TreeApplyBatch(op.CIDDescriptor, op.treeID, op.ops)
+			}
+			if errors.Is(err, pilorama.ErrBelowSnapshot) {
+				s.log.Error("replicated operation is below the tree snapshot height, resynchronize",
+					zap.Stringer("cid", op.CID),
+					zap.String("tree", op.treeID))
+				s.resetSyncHeight(op.CID, op.treeID)
+			} else if err != nil {
 				s.log.Error("failed to apply replicated operation",
 					zap.String("err", err.Error()))
+			} else {
+				s.subs.notify(op.CID, op.treeID, newOps...)
 			}
 		}
 	}
@@ -60,6 +77,7 @@ func (s *Service) replicationWorker() {
 		case task := <-s.replicationTasks:
 			var lastErr error
 			var lastAddr string
+			var sent int
 
 			task.n.IterateNetworkEndpoints(func(addr string) bool {
 				lastAddr = addr
@@ -70,11 +88,21 @@ func (s *Service) replicationWorker() {
 					return false
 				}
 
-				ctx, cancel := context.WithTimeout(context.Background(), s.replicatorTimeout)
-				_, lastErr = c.Apply(ctx, task.req)
-				cancel()
+				for ; sent < len(task.reqs); sent++ {
+					var resp *ApplyResponse
+
+					ctx, cancel := context.WithTimeout(context.Background(), s.replicatorTimeout)
+					resp, lastErr = c.Apply(ctx, task.reqs[sent])
+					cancel()
+
+					if lastErr != nil {
+						return false
+					}
 
-				return lastErr == nil
+					s.setBatchSupported(task.n.PublicKey(), resp.GetBody().GetBatchSupported())
+				}
+
+				return true
 			})
 
 			if lastErr != nil {
@@ -122,49 +150,117 @@ func (s *Service) replicateLoop(ctx context.Context) {
 }
 
 func (s *Service) replicate(op movePair) error {
-	req := newApplyRequest(&op)
-	err := SignMessage(req, s.key)
-	if err != nil {
-		return fmt.Errorf("can't sign data: %w", err)
-	}
-
 	nodes, localIndex, err := s.getContainerNodes(op.cid)
 	if err != nil {
 		return fmt.Errorf("can't get container nodes: %w", err)
 	}
 
+	// Requests are created lazily: the batch one is needed for the nodes
+	// supporting batches, the per-operation ones for the other nodes.
+	var batchReq []*ApplyRequest
+	var singleReqs []*ApplyRequest
+
 	for i := range nodes {
-		if i != localIndex {
-			s.replicationTasks <- replicationTask{nodes[i], req}
+		if i == localIndex {
+			continue
+		}
+
+		var reqs []*ApplyRequest
+		if len(op.ops) == 1 || s.batchSupported(nodes[i].PublicKey()) {
+			if batchReq == nil {
+				req, err := s.newSignedApplyRequest(op.cid, op.treeID, op.ops)
+				if err != nil {
+					return err
+				}
+				batchReq = []*ApplyRequest{req}
+			}
+			reqs = batchReq
+		} else {
+			if singleReqs == nil {
+				singleReqs = make([]*ApplyRequest, len(op.ops))
+				for j := range op.ops {
+					singleReqs[j], err = s.newSignedApplyRequest(op.cid, op.treeID, op.ops[j:j+1])
+					if err != nil {
+						return err
+					}
+				}
+			}
+			reqs = singleReqs
 		}
+
+		s.replicationTasks <- replicationTask{nodes[i], reqs}
 	}
 	return nil
 }
 
+func (s *Service) newSignedApplyRequest(cid cidSDK.ID, treeID string, ops []*pilorama.Move) (*ApplyRequest, error) {
+	req := newApplyRequest(cid, treeID, ops)
+	if err := SignMessage(req, s.key); err != nil {
+		return nil, fmt.Errorf("can't sign data: %w", err)
+	}
+	return req, nil
+}
+
+// batchSupported returns true if the node with the given public key
+// reported that it applies batches atomically.
+func (s *Service) batchSupported(key []byte) bool {
+	s.batchNodesMtx.RLock()
+	defer s.batchNodesMtx.RUnlock()
+
+	return s.batchNodes[string(key)]
+}
+
+// setBatchSupported remembers the batch support reported by the node
+// in the last response.
+func (s *Service) setBatchSupported(key []byte, supported bool) {
+	s.batchNodesMtx.Lock()
+	defer s.batchNodesMtx.Unlock()
+
+	if supported {
+		s.batchNodes[string(key)] = true
+	} else {
+		delete(s.batchNodes, string(key))
+	}
+}
+
 func (s *Service) pushToQueue(cid cidSDK.ID, treeID string, op *pilorama.Move) {
+	s.pushBatchToQueue(cid, treeID, []*pilorama.Move{op})
+}
+
+// pushBatchToQueue pushes operations which must be replicated as a single unit.
+func (s *Service) pushBatchToQueue(cid cidSDK.ID, treeID string, ops []*pilorama.Move) {
 	select {
 	case s.replicateCh <- movePair{
 		cid:    cid,
 		treeID: treeID,
-		op:     op,
+		ops:    ops,
 	}:
 	default:
 	}
 }
 
-func newApplyRequest(op *movePair) *ApplyRequest {
+// newApplyRequest returns the request to apply ops. The first operation is put
+// in a separate field, the rest ones are applied only by the nodes supporting
+// batches, so the request with multiple operations must be sent to such nodes only.
+func newApplyRequest(cid cidSDK.ID, treeID string, ops []*pilorama.Move) *ApplyRequest {
 	rawCID := make([]byte, sha256.Size)
-	op.cid.Encode(rawCID)
+	cid.Encode(rawCID)
+
+	logs := make([]*LogMove, len(ops))
+	for i := range ops {
+		logs[i] = &LogMove{
+			ParentId: ops[i].Parent,
+			Meta:     ops[i].Meta.Bytes(),
+			ChildId:  ops[i].Child,
+		}
+	}
 
 	return &ApplyRequest{
 		Body: &ApplyRequest_Body{
 			ContainerId: rawCID,
-			TreeId:      op.treeID,
-			Operation: &LogMove{
-				ParentId: op.op.Parent,
-				Meta:     op.op.Meta.Bytes(),
-				ChildId:  op.op.Child,
-			},
+			TreeId:      treeID,
+			Operation:   logs[0],
+			Operations:  logs[1:],
 		},
 	}
 }
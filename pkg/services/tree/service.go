@@ -27,6 +27,7 @@ type Service struct {
 	replicationTasks chan replicationTask
 	closeCh          chan struct{}
 	containerCache   containerCache
+	subs             subscriptions
 
 	syncChan chan struct{}
 	syncPool *ants.Pool
@@ -37,6 +38,12 @@ type Service struct {
 	cnrMap map[cidSDK.ID]map[string]uint64
 	// cnrMapMtx protects cnrMap
 	cnrMapMtx sync.Mutex
+
+	// batchNodes contains public keys of the nodes which reported
+	// that they apply batches of the replicated operations atomically.
+	batchNodes map[string]bool
+	// batchNodesMtx protects batchNodes
+	batchNodesMtx sync.RWMutex
 }
 
 var _ TreeServiceServer = (*Service)(nil)
@@ -56,6 +63,9 @@ func New(opts ...Option) *Service {
 	if s.log == nil {
 		s.log = &logger.Logger{Logger: zap.NewNop()}
 	}
+	if s.metrics == nil {
+		s.metrics = noopMetrics{}
+	}
 
 	s.cache.init()
 	s.closeCh = make(chan struct{})
@@ -63,7 +73,9 @@ func New(opts ...Option) *Service {
 	s.replicateLocalCh = make(chan applyOp)
 	s.replicationTasks = make(chan replicationTask, s.replicatorWorkerCount)
 	s.containerCache.init(s.containerCacheSize)
+	s.subs.init()
 	s.cnrMap = make(map[cidSDK.ID]map[string]uint64)
+	s.batchNodes = make(map[string]bool)
 	s.syncChan = make(chan struct{})
 	s.syncPool, _ = ants.NewPool(defaultSyncWorkerCount)
 
@@ -74,6 +86,9 @@ func New(opts ...Option) *Service {
 func (s *Service) Start(ctx context.Context) {
 	go s.replicateLoop(ctx)
 	go s.syncLoop(ctx)
+	if s.compactionInterval > 0 {
+		go s.compactionLoop(ctx)
+	}
 
 	select {
 	case <-s.closeCh:
@@ -130,6 +145,7 @@ func (s *Service) Add(ctx context.Context, req *AddRequest) (*AddResponse, error
 		return nil, err
 	}
 
+	s.subs.notify(cid, b.GetTreeId(), log)
 	s.pushToQueue(cid, b.GetTreeId(), log)
 	return &AddResponse{
 		Body: &AddResponse_Body{
@@ -182,6 +198,7 @@ func (s *Service) AddByPath(ctx context.Context, req *AddByPathRequest) (*AddByP
 	}
 
 	for i := range logs {
+		s.subs.notify(cid, b.GetTreeId(), &logs[i])
 		s.pushToQueue(cid, b.GetTreeId(), &logs[i])
 	}
 
@@ -241,6 +258,7 @@ func (s *Service) Remove(ctx context.Context, req *RemoveRequest) (*RemoveRespon
 		return nil, err
 	}
 
+	s.subs.notify(cid, b.GetTreeId(), log)
 	s.pushToQueue(cid, b.GetTreeId(), log)
 	return new(RemoveResponse), nil
 }
@@ -291,10 +309,122 @@ func (s *Service) Move(ctx context.Context, req *MoveRequest) (*MoveResponse, er
 		return nil, err
 	}
 
+	s.subs.notify(cid, b.GetTreeId(), log)
 	s.pushToQueue(cid, b.GetTreeId(), log)
 	return new(MoveResponse), nil
 }
 
+// Batch applies multiple client operations to the specified tree atomically
+// and pushes them in queue for replication as a single unit.
+func (s *Service) Batch(ctx context.Context, req *BatchRequest) (*BatchResponse, error) {
+	b := req.GetBody()
+
+	var cid cidSDK.ID
+	if err := cid.Decode(b.GetContainerId()); err != nil {
+		return nil, err
+	}
+
+	err := s.verifyClient(req, cid, b.GetBearerToken(), acl.OpObjectPut)
+	if err != nil {
+		return nil, err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return nil, err
+	}
+	if pos < 0 {
+		var resp *BatchResponse
+		var outErr error
+		err = s.forEachNode(ctx, ns, func(c TreeServiceClient) bool {
+			resp, outErr = c.Batch(ctx, req)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp, outErr
+	}
+
+	ops, err := protoToBatch(b.GetOperations())
+	if err != nil {
+		return nil, err
+	}
+
+	d := pilorama.CIDDescriptor{CID: cid, Position: pos, Size: len(ns)}
+	logs, err := s.forest.TreeBatch(d, b.GetTreeId(), ops)
+	if err != nil {
+		return nil, err
+	}
+
+	var batch []*pilorama.Move
+	results := make([]*BatchResponse_Result, len(logs))
+	for i := range logs {
+		for j := range logs[i] {
+			batch = append(batch, &logs[i][j])
+		}
+
+		results[i] = new(BatchResponse_Result)
+		switch {
+		case ops[i].Attr != "":
+			nodes := make([]uint64, len(logs[i]))
+			nodes[0] = logs[i][len(logs[i])-1].Child
+			for j, l := range logs[i][:len(logs[i])-1] {
+				nodes[j+1] = l.Child
+			}
+			results[i].Nodes = nodes
+		case ops[i].Child == pilorama.RootID:
+			results[i].Nodes = []uint64{logs[i][0].Child}
+		}
+	}
+
+	s.subs.notify(cid, b.GetTreeId(), batch...)
+	s.pushBatchToQueue(cid, b.GetTreeId(), batch)
+	return &BatchResponse{
+		Body: &BatchResponse_Body{
+			Results: results,
+		},
+	}, nil
+}
+
+func protoToBatch(ops []*BatchRequest_Operation) ([]pilorama.BatchOperation, error) {
+	if len(ops) == 0 {
+		return nil, errors.New("batch must contain at least one operation")
+	}
+
+	res := make([]pilorama.BatchOperation, len(ops))
+	for i, op := range ops {
+		switch op.GetType() {
+		case BatchRequest_Operation_Add:
+			res[i].Parent = op.GetParentId()
+			res[i].Meta.Items = protoToMeta(op.GetMeta())
+		case BatchRequest_Operation_AddByPath:
+			res[i].Attr = op.GetPathAttribute()
+			if len(res[i].Attr) == 0 {
+				res[i].Attr = pilorama.AttributeFilename
+			}
+			res[i].Path = op.GetPath()
+			res[i].Meta.Items = protoToMeta(op.GetMeta())
+		case BatchRequest_Operation_Remove:
+			if op.GetNodeId() == pilorama.RootID {
+				return nil, fmt.Errorf("node with ID %d is root and can't be removed", op.GetNodeId())
+			}
+			res[i].Parent = pilorama.TrashID
+			res[i].Child = op.GetNodeId()
+		case BatchRequest_Operation_Move:
+			if op.GetNodeId() == pilorama.RootID {
+				return nil, fmt.Errorf("node with ID %d is root and can't be moved", op.GetNodeId())
+			}
+			res[i].Parent = op.GetParentId()
+			res[i].Child = op.GetNodeId()
+			res[i].Meta.Items = protoToMeta(op.GetMeta())
+		default:
+			return nil, fmt.Errorf("unknown operation type: %d", op.GetType())
+		}
+	}
+	return res, nil
+}
+
 func (s *Service) GetNodeByPath(ctx context.Context, req *GetNodeByPathRequest) (*GetNodeByPathResponse, error) {
 	b := req.GetBody()
 
@@ -412,11 +542,17 @@ func (s *Service) GetSubTree(req *GetSubTreeRequest, srv TreeService_GetSubTreeS
 }
 
 func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRequest_Body, forest pilorama.Forest) error {
+	ordered := b.GetOrderBy().GetDirection() == GetSubTreeRequest_Order_Asc
+	if !ordered && b.GetStartAfter() != "" {
+		return errors.New("start_after can only be used with ordering")
+	}
+
 	// Traverse the tree in a DFS manner. Because we need to support arbitrary depth,
 	// recursive implementation is not suitable here, so we maintain explicit stack.
-	stack := [][]uint64{{b.GetRootId()}}
+	// Meta is fetched lazily, unless it was returned with the ordered children.
+	stack := [][]pilorama.NodeInfo{{{ID: b.GetRootId()}}}
 
-	for {
+	for root := true; ; root = false {
 		if len(stack) == 0 {
 			break
 		} else if len(stack[len(stack)-1]) == 0 {
@@ -424,27 +560,41 @@ func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRe
 			continue
 		}
 
-		nodeID := stack[len(stack)-1][0]
+		node := stack[len(stack)-1][0]
 		stack[len(stack)-1] = stack[len(stack)-1][1:]
 
-		m, p, err := forest.TreeGetMeta(cid, b.GetTreeId(), nodeID)
-		if err != nil {
-			return err
+		if root || !ordered {
+			m, p, err := forest.TreeGetMeta(cid, b.GetTreeId(), node.ID)
+			if err != nil {
+				return err
+			}
+			node.Meta = m
+			node.ParentID = p
 		}
-		err = srv.Send(&GetSubTreeResponse{
-			Body: &GetSubTreeResponse_Body{
-				NodeId:    nodeID,
-				ParentId:  p,
-				Timestamp: m.Time,
-				Meta:      metaToProto(m.Items),
-			},
-		})
-		if err != nil {
-			return err
+
+		if matchFilters(node.Meta, b.GetFilters()) {
+			err := srv.Send(&GetSubTreeResponse{
+				Body: &GetSubTreeResponse_Body{
+					NodeId:    node.ID,
+					ParentId:  node.ParentID,
+					Timestamp: node.Meta.Time,
+					Meta:      metaToProto(node.Meta.Items),
+				},
+			})
+			if err != nil {
+				return err
+			}
 		}
 
 		if b.GetDepth() == 0 || uint32(len(stack)) < b.GetDepth() {
-			children, err := forest.TreeGetChildren(cid, b.GetTreeId(), nodeID)
+			var start string
+			var limit int
+			if root {
+				start = b.GetStartAfter()
+				limit = int(b.GetLimit())
+			}
+
+			children, err := getChildren(forest, cid, b.GetTreeId(), node.ID, ordered, start, limit)
 			if err != nil {
 				return err
 			}
@@ -456,6 +606,43 @@ func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRe
 	return nil
 }
 
+// getChildren returns children of the node, sorted by FileName if ordered is true.
+// For the unordered children only IDs are filled.
+func getChildren(forest pilorama.Forest, cid cidSDK.ID, treeID string, nodeID pilorama.Node,
+	ordered bool, start string, limit int) ([]pilorama.NodeInfo, error) {
+	if ordered {
+		return forest.TreeSortedByFilename(cid, treeID, nodeID, start, limit)
+	}
+
+	ids, err := forest.TreeGetChildren(cid, treeID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	if 0 < limit && limit < len(ids) {
+		ids = ids[:limit]
+	}
+
+	children := make([]pilorama.NodeInfo, len(ids))
+	for i := range ids {
+		children[i].ID = ids[i]
+	}
+	return children, nil
+}
+
+// matchFilters checks whether meta contains all the filter key-value pairs.
+func matchFilters(m pilorama.Meta, filters []*KeyValue) bool {
+loop:
+	for _, f := range filters {
+		for _, kv := range m.Items {
+			if kv.Key == f.GetKey() && bytes.Equal(kv.Value, f.GetValue()) {
+				continue loop
+			}
+		}
+		return false
+	}
+	return true
+}
+
 // Apply locally applies operation from the remote node to the tree.
 func (s *Service) Apply(_ context.Context, req *ApplyRequest) (*ApplyResponse, error) {
 	err := verifyMessage(req)
@@ -478,26 +665,27 @@ func (s *Service) Apply(_ context.Context, req *ApplyRequest) (*ApplyResponse, e
 		return nil, errors.New("`Apply` request must be signed by a container node")
 	}
 
-	op := req.GetBody().GetOperation()
-
-	var meta pilorama.Meta
-	if err := meta.FromBytes(op.GetMeta()); err != nil {
-		return nil, fmt.Errorf("can't parse meta-information: %w", err)
+	logs := append([]*LogMove{req.GetBody().GetOperation()}, req.GetBody().GetOperations()...)
+	ops := make([]*pilorama.Move, len(logs))
+	for i := range logs {
+		ops[i] = &pilorama.Move{
+			Parent: logs[i].GetParentId(),
+			Child:  logs[i].GetChildId(),
+		}
+		if err := ops[i].Meta.FromBytes(logs[i].GetMeta()); err != nil {
+			return nil, fmt.Errorf("can't parse meta-information: %w", err)
+		}
 	}
 
 	select {
 	case s.replicateLocalCh <- applyOp{
 		treeID:        req.GetBody().GetTreeId(),
 		CIDDescriptor: pilorama.CIDDescriptor{CID: cid, Position: pos, Size: size},
-		Move: pilorama.Move{
-			Parent: op.GetParentId(),
-			Child:  op.GetChildId(),
-			Meta:   meta,
-		},
+		ops:           ops,
 	}:
 	default:
 	}
-	return &ApplyResponse{Body: &ApplyResponse_Body{}, Signature: &Signature{}}, nil
+	return &ApplyResponse{Body: &ApplyResponse_Body{BatchSupported: true}, Signature: &Signature{}}, nil
 }
 
 func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer) error {
@@ -533,7 +721,7 @@ func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer)
 	}
 
 	h := b.GetHeight()
-	for {
+	for sent := uint64(0); b.GetCount() == 0 || sent < b.GetCount(); sent++ {
 		lm, err := s.forest.TreeGetOpLog(cid, b.GetTreeId(), h)
 		if err != nil || lm.Time == 0 {
 			return err
@@ -554,6 +742,7 @@ func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer)
 
 		h = lm.Time + 1
 	}
+	return nil
 }
 
 func (s *Service) TreeList(ctx context.Context, req *TreeListRequest) (*TreeListResponse, error) {
@@ -39,6 +39,7 @@ func eACLErr(op eacl.Operation, err error) error {
 var errBearerWrongOwner = errors.New("bearer token must be signed by the container owner")
 var errBearerWrongContainer = errors.New("bearer token is created for another container")
 var errBearerSignature = errors.New("invalid bearer token signature")
+var errNotContainerNode = errors.New("request must be signed by a container node")
 
 // verifyClient verifies if the request for a client operation
 // was signed by a key allowed by (e)ACL rules.
@@ -118,6 +119,23 @@ func (s *Service) verifyClient(req message, cid cidSDK.ID, rawBearer []byte, op
 	return checkEACL(tb, req.GetSignature().GetKey(), eACLRole(role), eaclOp)
 }
 
+// verifyContainerNode verifies the request signature and checks
+// that the request is signed by one of the container nodes.
+func (s *Service) verifyContainerNode(req message, cid cidSDK.ID) error {
+	if err := verifyMessage(req); err != nil {
+		return err
+	}
+
+	_, pos, _, err := s.getContainerInfo(cid, req.GetSignature().GetKey())
+	if err != nil {
+		return err
+	}
+	if pos < 0 {
+		return errNotContainerNode
+	}
+	return nil
+}
+
 func verifyMessage(m message) error {
 	binBody, err := m.ReadSignedData(nil)
 	if err != nil {
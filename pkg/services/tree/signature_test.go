@@ -201,6 +201,77 @@ func TestMessageSign(t *testing.T) {
 	})
 }
 
+type testNetmapSource struct {
+	netmap.Source
+	nm *netmapSDK.NetMap
+}
+
+func (s testNetmapSource) GetNetMap(uint64) (*netmapSDK.NetMap, error) {
+	return s.nm, nil
+}
+
+func TestVerifyContainerNode(t *testing.T) {
+	privs := make([]*keys.PrivateKey, 2)
+	for i := range privs {
+		p, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+		privs[i] = p
+	}
+
+	var node netmapSDK.NodeInfo
+	node.SetPublicKey(privs[0].PublicKey().Bytes())
+
+	var nm netmapSDK.NetMap
+	nm.SetNodes([]netmapSDK.NodeInfo{node})
+
+	cnrID := cidtest.ID()
+
+	var ownerID user.ID
+	user.IDFromKey(&ownerID, (ecdsa.PublicKey)(*privs[1].PublicKey()))
+
+	s := &Service{
+		cfg: cfg{
+			log:      &logger.Logger{Logger: zaptest.NewLogger(t)},
+			nmSource: testNetmapSource{nm: &nm},
+			cnrSource: dummyContainerSource{
+				cnrID.String(): &containercore.Container{Value: testContainer(ownerID)},
+			},
+		},
+	}
+	s.containerCache.init(defaultContainerCacheSize)
+
+	rawCID := make([]byte, sha256.Size)
+	cnrID.Encode(rawCID)
+
+	req := &GetSnapshotRequest{
+		Body: &GetSnapshotRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      "tree",
+		},
+	}
+
+	t.Run("missing signature", func(t *testing.T) {
+		require.Error(t, s.verifyContainerNode(req, cnrID))
+	})
+
+	t.Run("container owner", func(t *testing.T) {
+		require.NoError(t, SignMessage(req, &privs[1].PrivateKey))
+		require.ErrorIs(t, s.verifyContainerNode(req, cnrID), errNotContainerNode)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		require.NoError(t, SignMessage(req, &privs[0].PrivateKey))
+		req.Body.TreeId = "another"
+		require.Error(t, s.verifyContainerNode(req, cnrID))
+		req.Body.TreeId = "tree"
+	})
+
+	t.Run("container node", func(t *testing.T) {
+		require.NoError(t, SignMessage(req, &privs[0].PrivateKey))
+		require.NoError(t, s.verifyContainerNode(req, cnrID))
+	})
+}
+
 func testBearerToken(cid cid.ID, forPutGet, forGet *keys.PublicKey) bearer.Token {
 	tgtGet := eaclSDK.NewTarget()
 	tgtGet.SetRole(eaclSDK.RoleUnknown)
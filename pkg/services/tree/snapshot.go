@@ -0,0 +1,380 @@
+package tree
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/network"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+	netmapSDK "github.com/TrueCloudLab/frostfs-sdk-go/netmap"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// snapshotBatchSize is the amount of snapshot nodes read from or written to the storage at once.
+	snapshotBatchSize = 1000
+	// compactionMargin is the amount of operations per container node the tree
+	// is kept uncompacted below the height confirmed by all the container nodes.
+	// It covers operations which are still being replicated while the heights are collected.
+	compactionMargin = 1024
+)
+
+// errSnapshotChanged is returned when the tree is compacted while its snapshot is being sent.
+var errSnapshotChanged = errors.New("snapshot has been changed during transfer")
+
+// GetSnapshot streams the nodes of the last snapshot of the tree.
+// Nothing is sent if the tree has never been compacted.
+// The request must be signed by a container node.
+func (s *Service) GetSnapshot(req *GetSnapshotRequest, srv TreeService_GetSnapshotServer) error {
+	b := req.GetBody()
+
+	var cid cidSDK.ID
+	if err := cid.Decode(b.GetContainerId()); err != nil {
+		return err
+	}
+
+	if err := s.verifyContainerNode(req, cid); err != nil {
+		return err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return err
+	}
+	if pos < 0 {
+		var cli TreeService_GetSnapshotClient
+		var outErr error
+		err := s.forEachNode(srv.Context(), ns, func(c TreeServiceClient) bool {
+			cli, outErr = c.GetSnapshot(srv.Context(), req)
+			return true
+		})
+		if err != nil {
+			return err
+		} else if outErr != nil {
+			return outErr
+		}
+		resp, err := cli.Recv()
+		for ; err == nil; resp, err = cli.Recv() {
+			if err := srv.Send(resp); err != nil {
+				return err
+			}
+		}
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+		return nil
+	}
+
+	var height uint64
+	var start pilorama.Node
+	for first := true; ; first = false {
+		h, nodes, err := s.forest.TreeGetSnapshot(cid, b.GetTreeId(), start, snapshotBatchSize)
+		if err != nil {
+			return err
+		}
+		if first {
+			height = h
+		} else if h != height {
+			return errSnapshotChanged
+		}
+
+		for i := range nodes {
+			err := srv.Send(&GetSnapshotResponse{
+				Body: &GetSnapshotResponse_Body{
+					Height:    height,
+					NodeId:    nodes[i].ID,
+					ParentId:  nodes[i].Parent,
+					Timestamp: nodes[i].Timestamp,
+					Meta:      nodes[i].Meta.Bytes(),
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(nodes) < snapshotBatchSize || nodes[len(nodes)-1].ID == math.MaxUint64 {
+			return nil
+		}
+		start = nodes[len(nodes)-1].ID + 1
+	}
+}
+
+// bootstrapTree fetches the tree snapshot from one of the container nodes
+// if the local tree has no operations. Returns the snapshot height to synchronize
+// the operation log from, 0 if no snapshot has been applied.
+func (s *Service) bootstrapTree(ctx context.Context, d pilorama.CIDDescriptor, treeID string, nodes []netmapSDK.NodeInfo) uint64 {
+	lm, err := s.forest.TreeGetOpLog(d.CID, treeID, 0)
+	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) || lm.Time != 0 {
+		return 0
+	}
+
+	for _, n := range nodes {
+		var height uint64
+		n.IterateNetworkEndpoints(func(addr string) bool {
+			var a network.Address
+			if err := a.FromString(addr); err != nil {
+				return false
+			}
+
+			cc, err := grpc.DialContext(ctx, a.URIAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				// Failed to connect, try the next address.
+				return false
+			}
+			defer cc.Close()
+
+			height, err = s.fetchSnapshot(ctx, d, treeID, NewTreeServiceClient(cc))
+			if err != nil {
+				s.log.Warn("failed to fetch tree snapshot",
+					zap.Stringer("cid", d.CID),
+					zap.String("tree", treeID),
+					zap.String("address", addr),
+					zap.Error(err))
+				return false
+			}
+			return true
+		})
+		if height != 0 {
+			s.log.Debug("tree has been bootstrapped from snapshot",
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID),
+				zap.Uint64("height", height))
+			return height
+		}
+	}
+	return 0
+}
+
+func (s *Service) fetchSnapshot(ctx context.Context, d pilorama.CIDDescriptor, treeID string, treeClient TreeServiceClient) (uint64, error) {
+	rawCID := make([]byte, sha256.Size)
+	d.CID.Encode(rawCID)
+
+	req := &GetSnapshotRequest{
+		Body: &GetSnapshotRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      treeID,
+		},
+	}
+	if err := SignMessage(req, s.key); err != nil {
+		return 0, err
+	}
+
+	c, err := treeClient.GetSnapshot(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("can't initialize client: %w", err)
+	}
+
+	var height uint64
+	nodes := make([]pilorama.SnapshotNode, 0, snapshotBatchSize)
+
+	res, err := c.Recv()
+	for ; err == nil; res, err = c.Recv() {
+		b := res.GetBody()
+		if height == 0 {
+			height = b.GetHeight()
+		} else if b.GetHeight() != height {
+			return 0, errSnapshotChanged
+		}
+
+		n := pilorama.SnapshotNode{
+			ID:        b.GetNodeId(),
+			Parent:    b.GetParentId(),
+			Timestamp: b.GetTimestamp(),
+		}
+		if err := n.Meta.FromBytes(b.GetMeta()); err != nil {
+			return 0, err
+		}
+
+		nodes = append(nodes, n)
+		if len(nodes) == snapshotBatchSize {
+			if err := s.forest.TreeApplySnapshot(d, treeID, height, nodes); err != nil {
+				return 0, err
+			}
+			nodes = nodes[:0]
+		}
+	}
+	if !errors.Is(err, io.EOF) {
+		return 0, err
+	}
+	if height == 0 {
+		return 0, nil
+	}
+	return height, s.forest.TreeApplySnapshot(d, treeID, height, nodes)
+}
+
+func (s *Service) compactionLoop(ctx context.Context) {
+	tick := time.NewTicker(s.compactionInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-s.closeCh:
+			return
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+			s.compactTrees(ctx)
+		}
+	}
+}
+
+// GetSyncHeight returns the height up to which the tree has been synchronized
+// with all the other container nodes. The request must be signed by a container node.
+func (s *Service) GetSyncHeight(_ context.Context, req *GetSyncHeightRequest) (*GetSyncHeightResponse, error) {
+	b := req.GetBody()
+
+	var cid cidSDK.ID
+	if err := cid.Decode(b.GetContainerId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyContainerNode(req, cid); err != nil {
+		return nil, err
+	}
+
+	return &GetSyncHeightResponse{
+		Body: &GetSyncHeightResponse_Body{
+			Height: s.syncHeight(cid, b.GetTreeId()),
+		},
+	}, nil
+}
+
+// syncHeight returns the height up to which the tree has been synchronized
+// with all the other container nodes, 0 if it has not been synchronized yet.
+func (s *Service) syncHeight(cid cidSDK.ID, treeID string) uint64 {
+	s.cnrMapMtx.Lock()
+	defer s.cnrMapMtx.Unlock()
+
+	return s.cnrMap[cid][treeID]
+}
+
+// resetSyncHeight forgets the synchronized height of the tree, so it is not compacted
+// until the next successful synchronization, and forces synchronization of all the trees.
+// It is called when the operation below the tree snapshot height is received.
+func (s *Service) resetSyncHeight(cid cidSDK.ID, treeID string) {
+	s.cnrMapMtx.Lock()
+	if trees, ok := s.cnrMap[cid]; ok {
+		// The inner map is read-only, see Service.cnrMap.
+		newTrees := make(map[string]uint64, len(trees))
+		for tid, h := range trees {
+			newTrees[tid] = h
+		}
+		newTrees[treeID] = 0
+		s.cnrMap[cid] = newTrees
+	}
+	s.cnrMapMtx.Unlock()
+
+	if err := s.SynchronizeAll(); err != nil && !errors.Is(err, ErrAlreadySyncing) {
+		s.log.Warn("could not force tree synchronization", zap.Error(err))
+	}
+}
+
+// confirmedHeight returns the minimum height up to which the tree has been synchronized
+// by each of the other container nodes. An error is returned if any of the nodes
+// can't be asked, because its height is unknown.
+func (s *Service) confirmedHeight(ctx context.Context, cid cidSDK.ID, treeID string, nodes []netmapSDK.NodeInfo) (uint64, error) {
+	rawCID := make([]byte, sha256.Size)
+	cid.Encode(rawCID)
+
+	req := &GetSyncHeightRequest{
+		Body: &GetSyncHeightRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      treeID,
+		},
+	}
+	if err := SignMessage(req, s.key); err != nil {
+		return 0, err
+	}
+
+	height := uint64(math.MaxUint64)
+	for _, n := range nodes {
+		if bytes.Equal(n.PublicKey(), s.rawPub) {
+			continue
+		}
+
+		var (
+			resp *GetSyncHeightResponse
+			err  = errNoSuitableNode
+		)
+		n.IterateNetworkEndpoints(func(endpoint string) bool {
+			var c TreeServiceClient
+			c, err = s.cache.get(ctx, endpoint)
+			if err != nil {
+				return false
+			}
+
+			resp, err = c.GetSyncHeight(ctx, req)
+			return err == nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("could not get sync height of node %s: %w", hex.EncodeToString(n.PublicKey()), err)
+		}
+
+		if h := resp.GetBody().GetHeight(); h < height {
+			height = h
+		}
+	}
+	return height, nil
+}
+
+// compactTrees compacts all the synchronized trees up to the height which
+// has been confirmed by all the container nodes, minus compactionMargin operations.
+func (s *Service) compactTrees(ctx context.Context) {
+	s.cnrMapMtx.Lock()
+	cnrMap := make(map[cidSDK.ID]map[string]uint64, len(s.cnrMap))
+	for cid, trees := range s.cnrMap {
+		// The inner map is read-only, see Service.cnrMap.
+		cnrMap[cid] = trees
+	}
+	s.cnrMapMtx.Unlock()
+
+	for cid, trees := range cnrMap {
+		nodes, pos, err := s.getContainerNodes(cid)
+		if err != nil || pos < 0 {
+			continue
+		}
+
+		margin := uint64(compactionMargin * len(nodes))
+		for treeID, height := range trees {
+			if height <= margin {
+				continue
+			}
+
+			confirmed, err := s.confirmedHeight(ctx, cid, treeID, nodes)
+			if err != nil {
+				s.log.Debug("skip tree compaction",
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID),
+					zap.Error(err))
+				continue
+			}
+			if confirmed < height {
+				height = confirmed
+			}
+			if height <= margin {
+				continue
+			}
+			height -= margin
+
+			err = s.forest.TreeCompact(cid, treeID, height)
+			if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+				s.log.Error("could not compact tree",
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID),
+					zap.Uint64("height", height),
+					zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package tree
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-sdk-go/container/acl"
+	cidSDK "github.com/TrueCloudLab/frostfs-sdk-go/container/id"
+)
+
+type subscriptionKey struct {
+	cid    cidSDK.ID
+	treeID string
+}
+
+// maxPendingOperations is the maximum amount of applied operations
+// which are queued for a single subscriber. The subscription is closed
+// with errSubscriberOverflow when the subscriber doesn't keep up.
+const maxPendingOperations = 4096
+
+// errSubscriberOverflow is returned when the subscriber can't receive
+// the operations as fast as they are applied.
+var errSubscriberOverflow = errors.New("too many pending operations, subscribe again")
+
+// subscriber collects the operations applied to the local tree
+// which have not been sent to the Subscribe stream yet.
+type subscriber struct {
+	// ch is signaled when new operations are queued.
+	ch chan struct{}
+
+	mtx        sync.Mutex
+	ops        []*pilorama.Move
+	overflowed bool
+}
+
+// pop returns all the queued operations.
+func (s *subscriber) pop() ([]*pilorama.Move, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.overflowed {
+		return nil, errSubscriberOverflow
+	}
+
+	ops := s.ops
+	s.ops = nil
+	return ops, nil
+}
+
+func (s *subscriber) push(ops []*pilorama.Move) {
+	s.mtx.Lock()
+	if len(s.ops)+len(ops) > maxPendingOperations {
+		s.overflowed = true
+		s.ops = nil
+	} else {
+		s.ops = append(s.ops, ops...)
+	}
+	s.mtx.Unlock()
+
+	select {
+	case s.ch <- struct{}{}:
+	default:
+	}
+}
+
+// subscriptions delivers the operations applied to the local tree, both local
+// and replicated ones, to the Subscribe streams.
+type subscriptions struct {
+	mtx sync.Mutex
+	m   map[subscriptionKey]map[*subscriber]struct{}
+}
+
+func (s *subscriptions) init() {
+	s.m = make(map[subscriptionKey]map[*subscriber]struct{})
+}
+
+// subscribe registers new subscriber of the tree.
+// It must be removed with unsubscribe.
+func (s *subscriptions) subscribe(cid cidSDK.ID, treeID string) *subscriber {
+	key := subscriptionKey{cid: cid, treeID: treeID}
+	sub := &subscriber{ch: make(chan struct{}, 1)}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	subs, ok := s.m[key]
+	if !ok {
+		subs = make(map[*subscriber]struct{})
+		s.m[key] = subs
+	}
+	subs[sub] = struct{}{}
+	return sub
+}
+
+func (s *subscriptions) unsubscribe(cid cidSDK.ID, treeID string, sub *subscriber) {
+	key := subscriptionKey{cid: cid, treeID: treeID}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	delete(s.m[key], sub)
+	if len(s.m[key]) == 0 {
+		delete(s.m, key)
+	}
+}
+
+// notify queues the operations newly applied to the tree for all its subscribers.
+// The operations must not be modified afterwards.
+func (s *subscriptions) notify(cid cidSDK.ID, treeID string, ops ...*pilorama.Move) {
+	if len(ops) == 0 {
+		return
+	}
+
+	key := subscriptionKey{cid: cid, treeID: treeID}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for sub := range s.m[key] {
+		sub.push(ops)
+	}
+}
+
+// Subscribe streams operations from the log starting from the requested height
+// and then every operation applied to the local tree, including the replicated
+// ones with a timestamp lower than the last sent one, until the stream or the service
+// is closed. An operation applied concurrently with reading the log can be sent twice,
+// the height identifies it.
+func (s *Service) Subscribe(req *SubscribeRequest, srv TreeService_SubscribeServer) error {
+	b := req.GetBody()
+
+	var cid cidSDK.ID
+	if err := cid.Decode(b.GetContainerId()); err != nil {
+		return err
+	}
+
+	err := s.verifyClient(req, cid, b.GetBearerToken(), acl.OpObjectGet)
+	if err != nil {
+		return err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return err
+	}
+	if pos < 0 {
+		var cli TreeService_SubscribeClient
+		var outErr error
+		err = s.forEachNode(srv.Context(), ns, func(c TreeServiceClient) bool {
+			cli, outErr = c.Subscribe(srv.Context(), req)
+			return true
+		})
+		if err != nil {
+			return err
+		} else if outErr != nil {
+			return outErr
+		}
+		resp, err := cli.Recv()
+		for ; err == nil; resp, err = cli.Recv() {
+			if err := srv.Send(resp); err != nil {
+				return err
+			}
+		}
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+		return nil
+	}
+
+	treeID := b.GetTreeId()
+
+	// Subscribe before reading the log, so that no
+	// operation applied in between is missed.
+	sub := s.subs.subscribe(cid, treeID)
+	defer s.subs.unsubscribe(cid, treeID, sub)
+
+	send := func(lm *pilorama.Move) error {
+		ok, err := s.inSubtree(cid, treeID, b.GetRootId(), lm)
+		if err != nil || !ok {
+			return err
+		}
+		return srv.Send(&SubscribeResponse{
+			Body: &SubscribeResponse_Body{
+				Operation: &LogMove{
+					ParentId: lm.Parent,
+					Meta:     lm.Meta.Bytes(),
+					ChildId:  lm.Child,
+				},
+				Height: lm.Time,
+			},
+		})
+	}
+
+	for h := b.GetHeight(); ; {
+		lm, err := s.forest.TreeGetOpLog(cid, treeID, h)
+		if err != nil {
+			return err
+		} else if lm.Time == 0 {
+			break
+		}
+
+		if err := send(&lm); err != nil {
+			return err
+		}
+		h = lm.Time + 1
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-s.closeCh:
+			return nil
+		case <-sub.ch:
+		}
+
+		ops, err := sub.pop()
+		if err != nil {
+			return err
+		}
+		for i := range ops {
+			if err := send(ops[i]); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// newOperations returns the operations which are not in the tree log yet.
+// It must be called before the operations are applied to notify
+// the subscribers about the new operations only. The operations are computed
+// even if the tree has no subscribers, because one can subscribe and read
+// the log before the operations are applied.
+func (s *Service) newOperations(cid cidSDK.ID, treeID string, ops []*pilorama.Move) []*pilorama.Move {
+	res := make([]*pilorama.Move, 0, len(ops))
+	for _, op := range ops {
+		lm, err := s.forest.TreeGetOpLog(cid, treeID, op.Time)
+		if err != nil || lm.Time != op.Time {
+			res = append(res, op)
+		}
+	}
+	return res
+}
+
+// inSubtree checks whether the operation must be sent to the subscriber with the
+// specified subtree root. Removals are always sent, because the previous parent
+// of the removed node is not known.
+func (s *Service) inSubtree(cid cidSDK.ID, treeID string, root pilorama.Node, m *pilorama.Move) (bool, error) {
+	if root == pilorama.RootID || m.Parent == pilorama.TrashID || m.Child == root {
+		return true, nil
+	}
+
+	node := m.Parent
+	for node != pilorama.RootID {
+		if node == root {
+			return true, nil
+		}
+
+		_, parent, err := s.forest.TreeGetMeta(cid, treeID, node)
+		if err != nil {
+			return false, err
+		}
+		node = parent
+	}
+	return false, nil
+}
@@ -0,0 +1,169 @@
+package tree
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"testing"
+
+	containercore "github.com/TrueCloudLab/frostfs-node/pkg/core/container"
+	"github.com/TrueCloudLab/frostfs-node/pkg/local_object_storage/pilorama"
+	"github.com/TrueCloudLab/frostfs-node/pkg/util/logger"
+	"github.com/TrueCloudLab/frostfs-sdk-go/container/acl"
+	cidtest "github.com/TrueCloudLab/frostfs-sdk-go/container/id/test"
+	"github.com/TrueCloudLab/frostfs-sdk-go/user"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSubscriptions(t *testing.T) {
+	var s subscriptions
+	s.init()
+
+	cid := cidtest.ID()
+	sub1 := s.subscribe(cid, "tree1")
+	sub2 := s.subscribe(cid, "tree2")
+
+	// A replicated operation with a lower timestamp must be delivered too.
+	op1 := &pilorama.Move{Meta: pilorama.Meta{Time: 10}}
+	op2 := &pilorama.Move{Meta: pilorama.Meta{Time: 5}}
+	s.notify(cid, "tree1", op1)
+	s.notify(cid, "tree1", op2)
+
+	select {
+	case <-sub1.ch:
+	default:
+		t.Fatal("subscriber must be notified")
+	}
+
+	ops, err := sub1.pop()
+	require.NoError(t, err)
+	require.Equal(t, []*pilorama.Move{op1, op2}, ops)
+
+	select {
+	case <-sub2.ch:
+		t.Fatal("unrelated subscriber must not be notified")
+	default:
+	}
+	ops, err = sub2.pop()
+	require.NoError(t, err)
+	require.Empty(t, ops)
+
+	t.Run("overflow", func(t *testing.T) {
+		sub := s.subscribe(cid, "tree3")
+		defer s.unsubscribe(cid, "tree3", sub)
+
+		s.notify(cid, "tree3", make([]*pilorama.Move, maxPendingOperations)...)
+		_, err := sub.pop()
+		require.NoError(t, err)
+
+		s.notify(cid, "tree3", make([]*pilorama.Move, maxPendingOperations+1)...)
+		_, err = sub.pop()
+		require.ErrorIs(t, err, errSubscriberOverflow)
+	})
+
+	s.unsubscribe(cid, "tree1", sub1)
+	s.unsubscribe(cid, "tree2", sub2)
+	require.Empty(t, s.m)
+
+	// Must not panic on a tree without subscribers.
+	s.notify(cidtest.ID(), "tree1", op1)
+}
+
+func TestNewOperations(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+	p := pilorama.NewMemoryForest()
+	s := &Service{cfg: cfg{forest: p}}
+	s.subs.init()
+
+	op := &pilorama.Move{Parent: pilorama.RootID, Child: 1, Meta: pilorama.Meta{Time: 1}}
+
+	// Operations are computed without subscribers, because
+	// a subscriber can appear before they are applied.
+	newOps := s.newOperations(d.CID, treeID, []*pilorama.Move{op})
+	require.Equal(t, []*pilorama.Move{op}, newOps)
+
+	// The subscriber has read the log before the operation is applied.
+	sub := s.subs.subscribe(d.CID, treeID)
+	defer s.subs.unsubscribe(d.CID, treeID, sub)
+
+	require.NoError(t, p.TreeApply(d, treeID, op, false))
+	s.subs.notify(d.CID, treeID, newOps...)
+
+	ops, err := sub.pop()
+	require.NoError(t, err)
+	require.Equal(t, []*pilorama.Move{op}, ops)
+
+	require.Empty(t, s.newOperations(d.CID, treeID, []*pilorama.Move{op}))
+}
+
+func TestInSubtree(t *testing.T) {
+	d := pilorama.CIDDescriptor{CID: cidtest.ID(), Size: 1}
+	treeID := "sometree"
+	p := pilorama.NewMemoryForest()
+	s := &Service{cfg: cfg{forest: p}}
+
+	add := func(path ...string) *pilorama.Move {
+		meta := []pilorama.KeyValue{
+			{Key: pilorama.AttributeFilename, Value: []byte(path[len(path)-1])}}
+		lm, err := p.TreeAddByPath(d, treeID, pilorama.AttributeFilename, path[:len(path)-1], meta)
+		require.NoError(t, err)
+		return &lm[len(lm)-1]
+	}
+
+	dir1 := add("dir1")
+	dir2 := add("dir2")
+	sub := add("dir1", "sub1", "subsub1")
+
+	check := func(root pilorama.Node, m *pilorama.Move, expected bool) {
+		ok, err := s.inSubtree(d.CID, treeID, root, m)
+		require.NoError(t, err)
+		require.Equal(t, expected, ok)
+	}
+
+	check(pilorama.RootID, dir2, true)
+	check(dir1.Child, dir1, true)
+	check(dir1.Child, sub, true)
+	check(dir1.Child, dir2, false)
+	check(dir2.Child, sub, false)
+	check(dir2.Child, &pilorama.Move{Parent: pilorama.TrashID, Child: sub.Child}, true)
+}
+
+func TestSubscribeVerifiesClient(t *testing.T) {
+	owner, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+	other, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var ownerID user.ID
+	user.IDFromKey(&ownerID, (ecdsa.PublicKey)(*owner.PublicKey()))
+
+	cnr := &containercore.Container{Value: testContainer(ownerID)}
+	cnr.Value.SetBasicACL(acl.Private)
+
+	cid := cidtest.ID()
+	s := &Service{
+		cfg: cfg{
+			log:       &logger.Logger{Logger: zaptest.NewLogger(t)},
+			nmSource:  dummyNetmapSource{},
+			cnrSource: dummyContainerSource{cid.String(): cnr},
+		},
+	}
+
+	rawCID := make([]byte, sha256.Size)
+	cid.Encode(rawCID)
+
+	req := &SubscribeRequest{
+		Body: &SubscribeRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      "sometree",
+		},
+	}
+
+	// The request is rejected before the stream is used.
+	require.Error(t, s.Subscribe(req, nil))
+
+	require.NoError(t, SignMessage(req, &other.PrivateKey))
+	require.Error(t, s.Subscribe(req, nil))
+}
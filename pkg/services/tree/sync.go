@@ -101,8 +101,13 @@ func (s *Service) synchronizeAllTrees(ctx context.Context, cid cid.ID) error {
 	}
 
 	for _, tid := range treesToSync {
-		h := s.synchronizeTree(ctx, d, syncStatus[tid], tid, nodes)
-		if syncStatus[tid] < h {
+		from := syncStatus[tid]
+		if from == 0 {
+			from = s.bootstrapTree(ctx, d, tid, nodes)
+		}
+
+		h := s.synchronizeTree(ctx, d, from, tid, nodes)
+		if h == 0 || syncStatus[tid] < h {
 			syncStatus[tid] = h
 		}
 	}
@@ -135,17 +140,28 @@ func (s *Service) SynchronizeTree(ctx context.Context, cid cid.ID, treeID string
 		return nil
 	}
 
-	s.synchronizeTree(ctx, d, 0, treeID, nodes)
+	from := s.bootstrapTree(ctx, d, treeID, nodes)
+	s.synchronizeTree(ctx, d, from, treeID, nodes)
 	return nil
 }
 
+// synchronizeTree fetches the operations of the tree from all the nodes starting
+// from the specified height. Returns the minimum height fetched from each node,
+// 0 if some operation could not be applied because it is below the local snapshot height.
 func (s *Service) synchronizeTree(ctx context.Context, d pilorama.CIDDescriptor, from uint64,
 	treeID string, nodes []netmapSDK.NodeInfo) uint64 {
+	// Operations below the snapshot height are compacted, they are known
+	// to all the container nodes and must not be fetched again.
+	if h, _, err := s.forest.TreeGetSnapshot(d.CID, treeID, 0, 0); err == nil && from < h {
+		from = h
+	}
+
 	s.log.Debug("synchronize tree",
 		zap.Stringer("cid", d.CID),
 		zap.String("tree", treeID),
 		zap.Uint64("from", from))
 
+	var belowSnapshot bool
 	newHeight := uint64(math.MaxUint64)
 	for _, n := range nodes {
 		height := from
@@ -163,11 +179,28 @@ func (s *Service) synchronizeTree(ctx context.Context, d pilorama.CIDDescriptor,
 			defer cc.Close()
 
 			treeClient := NewTreeServiceClient(cc)
+
+			h, err := s.synchronizeByDigest(ctx, d, treeID, height, treeClient)
+			if err == nil {
+				if height < h {
+					height = h
+				}
+				return true
+			}
+			s.log.Debug("failed to synchronize tree by digest, fetching the whole log",
+				zap.Stringer("cid", d.CID),
+				zap.String("tree", treeID),
+				zap.String("address", addr),
+				zap.Error(err))
+
 			for {
 				h, err := s.synchronizeSingle(ctx, d, treeID, height, treeClient)
 				if height < h {
 					height = h
 				}
+				if errors.Is(err, pilorama.ErrBelowSnapshot) {
+					belowSnapshot = true
+				}
 				if err != nil || h <= height {
 					// Error with the response, try the next node.
 					return true
@@ -180,6 +213,12 @@ func (s *Service) synchronizeTree(ctx context.Context, d pilorama.CIDDescriptor,
 			newHeight = height
 		}
 	}
+	if belowSnapshot {
+		s.log.Error("tree has operations below the snapshot height, compaction is disabled until resynchronization",
+			zap.Stringer("cid", d.CID),
+			zap.String("tree", treeID))
+		return 0
+	}
 	if newHeight == math.MaxUint64 {
 		newHeight = from
 	}
@@ -210,15 +249,8 @@ func (s *Service) synchronizeSingle(ctx context.Context, d pilorama.CIDDescripto
 
 		res, err := c.Recv()
 		for ; err == nil; res, err = c.Recv() {
-			lm := res.GetBody().GetOperation()
-			m := &pilorama.Move{
-				Parent: lm.ParentId,
-				Child:  lm.ChildId,
-			}
-			if err := m.Meta.FromBytes(lm.Meta); err != nil {
-				return newHeight, err
-			}
-			if err := s.forest.TreeApply(d, treeID, m, true); err != nil {
+			m, err := s.applyLogMove(d, treeID, res.GetBody().GetOperation())
+			if err != nil {
 				return newHeight, err
 			}
 			if m.Time > newHeight {